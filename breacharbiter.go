@@ -38,6 +38,14 @@ var (
 	// errBrarShuttingDown is an error returned if the breacharbiter has
 	// been signalled to exit.
 	errBrarShuttingDown = errors.New("breacharbiter shutting down")
+
+	// defaultJusticeFeePreference is the fee preference used for justice
+	// transactions if none has been configured. We aim for inclusion
+	// within the next two blocks, as we'd like to sweep these funds back
+	// into our wallet ASAP.
+	defaultJusticeFeePreference = lnwallet.FeePreference{
+		ConfTarget: 2,
+	}
 )
 
 // ContractBreachEvent is an event the breachArbiter will receive in case a
@@ -78,6 +86,10 @@ type BreachConfig struct {
 	// transactions.
 	Estimator lnwallet.FeeEstimator
 
+	// JusticeFeePreference is the fee preference used for justice
+	// transactions. If unset, a default confirmation target is used.
+	JusticeFeePreference lnwallet.FeePreference
+
 	// GenSweepScript generates the receiving scripts for swept outputs.
 	GenSweepScript func() ([]byte, error)
 
@@ -747,37 +759,14 @@ func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	go b.exactRetribution(cfChan, retInfo)
 }
 
-// SpendableOutput an interface which can be used by the breach arbiter to
-// construct a transaction spending from outputs we control.
-type SpendableOutput interface {
-	// Amount returns the number of satoshis contained within the output.
-	Amount() btcutil.Amount
-
-	// Outpoint returns the reference to the output being spent, used to
-	// construct the corresponding transaction input.
-	OutPoint() *wire.OutPoint
-
-	// WitnessType returns an enum specifying the type of witness that must
-	// be generated in order to spend this output.
-	WitnessType() lnwallet.WitnessType
-
-	// SignDesc returns a reference to a spendable output's sign descriptor,
-	// which is used during signing to compute a valid witness that spends
-	// this output.
-	SignDesc() *lnwallet.SignDescriptor
-
-	// BuildWitness returns a valid witness allowing this output to be
-	// spent, the witness should be attached to the transaction at the
-	// location determined by the given `txinIdx`.
-	BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
-		hashCache *txscript.TxSigHashes,
-		txinIdx int) ([][]byte, error)
-}
-
 // breachedOutput contains all the information needed to sweep a breached
 // output. A breached output is an output that we are now entitled to due to a
 // revoked commitment transaction being broadcast.
 type breachedOutput struct {
+	// Breached outputs have no deadline of their own, the breach arbiter
+	// sweeps them using its justice fee preference.
+	lnwallet.NoDeadline
+
 	amt         btcutil.Amount
 	outpoint    wire.OutPoint
 	witnessType lnwallet.WitnessType
@@ -849,9 +838,49 @@ func (bo *breachedOutput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
 	return bo.witnessFunc(txn, hashCache, txinIdx)
 }
 
+// RequiredSequence returns zero, as breached outputs can be swept by us
+// without any relative timelock.
+//
+// NOTE: Part of the lnwallet.SpendableOutput interface.
+func (bo *breachedOutput) RequiredSequence() uint32 {
+	return 0
+}
+
+// RequiredLockTime returns false, as breached outputs have no absolute
+// timelock on our path.
+//
+// NOTE: Part of the lnwallet.SpendableOutput interface.
+func (bo *breachedOutput) RequiredLockTime() (uint32, bool) {
+	return 0, false
+}
+
+// Add compile-time constraint ensuring breachedOutput implements
+// lnwallet.SpendableOutput.
+var _ lnwallet.SpendableOutput = (*breachedOutput)(nil)
+
+// OutputType returns the tag identifying breached outputs within the
+// lnwallet output registry.
+//
+// NOTE: Part of the lnwallet.SerializableOutput interface.
+func (bo *breachedOutput) OutputType() lnwallet.OutputType {
+	return lnwallet.OutputTypeBreached
+}
+
 // Add compile-time constraint ensuring breachedOutput implements
-// SpendableOutput.
-var _ SpendableOutput = (*breachedOutput)(nil)
+// lnwallet.SerializableOutput.
+var _ lnwallet.SerializableOutput = (*breachedOutput)(nil)
+
+func init() {
+	err := lnwallet.RegisterOutputType(
+		lnwallet.OutputTypeBreached,
+		func() lnwallet.SerializableOutput {
+			return &breachedOutput{}
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
 
 // retributionInfo encapsulates all the data needed to sweep all the contested
 // funds within a channel whose contract has been breached by the prior
@@ -962,13 +991,13 @@ func (b *breachArbiter) createJusticeTx(
 	// outputs, while simultaneously computing the estimated weight of the
 	// transaction.
 	var (
-		spendableOutputs []SpendableOutput
+		spendableOutputs []lnwallet.SpendableOutput
 		weightEstimate   lnwallet.TxWeightEstimator
 	)
 
 	// Allocate enough space to potentially hold each of the breached
 	// outputs in the retribution info.
-	spendableOutputs = make([]SpendableOutput, 0, len(r.breachedOutputs))
+	spendableOutputs = make([]lnwallet.SpendableOutput, 0, len(r.breachedOutputs))
 
 	// The justice transaction we construct will be a segwit transaction
 	// that pays to a p2wkh output. Components such as the version,
@@ -1022,7 +1051,7 @@ func (b *breachArbiter) createJusticeTx(
 // sweepSpendableOutputsTxn creates a signed transaction from a sequence of
 // spendable outputs by sweeping the funds into a single p2wkh output.
 func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
-	inputs ...SpendableOutput) (*wire.MsgTx, error) {
+	inputs ...lnwallet.SpendableOutput) (*wire.MsgTx, error) {
 
 	// First, we obtain a new public key script from the wallet which we'll
 	// sweep the funds to.
@@ -1039,13 +1068,19 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 		totalAmt += input.Amount()
 	}
 
-	// We'll actually attempt to target inclusion within the next two
-	// blocks as we'd like to sweep these funds back into our wallet ASAP.
-	feePerKw, err := b.cfg.Estimator.EstimateFeePerKW(2)
+	feePref := b.cfg.JusticeFeePreference
+	if feePref == (lnwallet.FeePreference{}) {
+		feePref = defaultJusticeFeePreference
+	}
+	feePref = feePref.ForOutputs(inputs...)
+	feePerKw, err := lnwallet.DetermineFeePerKw(b.cfg.Estimator, feePref, 0)
 	if err != nil {
 		return nil, err
 	}
 	txFee := feePerKw.FeeForWeight(txWeight)
+	if err := feePref.CheckFee(txFee); err != nil {
+		return nil, err
+	}
 
 	// TODO(roasbeef): already start to siphon their funds into fees
 	sweepAmt := int64(totalAmt - txFee)
@@ -1065,6 +1100,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	for _, input := range inputs {
 		txn.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.RequiredSequence(),
 		})
 	}
 
@@ -1084,7 +1120,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	// witness, and attaching it to the transaction. This function accepts
 	// an integer index representing the intended txin index, and the
 	// breached output from which it will spend.
-	addWitness := func(idx int, so SpendableOutput) error {
+	addWitness := func(idx int, so lnwallet.SpendableOutput) error {
 		// First, we construct a valid witness for this outpoint and
 		// transaction using the SpendableOutput's witness generation
 		// function.
@@ -1419,9 +1455,13 @@ func (ret *retributionInfo) Decode(r io.Reader) error {
 
 	ret.breachedOutputs = make([]breachedOutput, nOutputs)
 	for i := range ret.breachedOutputs {
-		if err := ret.breachedOutputs[i].Decode(r); err != nil {
+		output, err := lnwallet.DecodeSpendableOutput(
+			lnwallet.OutputTypeBreached, r,
+		)
+		if err != nil {
 			return err
 		}
+		ret.breachedOutputs[i] = *output.(*breachedOutput)
 	}
 
 	return nil
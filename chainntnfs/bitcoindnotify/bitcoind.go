@@ -72,6 +72,8 @@ type BitcoindNotifier struct {
 
 	txConfNotifier *chainntnfs.TxConfNotifier
 
+	mempoolNotifier *chainntnfs.TxMempoolNotifier
+
 	blockEpochClients map[uint64]*blockEpochRegistration
 
 	bestBlock chainntnfs.BlockEpoch
@@ -94,6 +96,14 @@ type BitcoindNotifier struct {
 // time.
 var _ chainntnfs.ChainNotifier = (*BitcoindNotifier)(nil)
 
+// Ensure BitcoindNotifier implements the BatchConfNotifier interface at
+// compile time.
+var _ chainntnfs.BatchConfNotifier = (*BitcoindNotifier)(nil)
+
+// Ensure BitcoindNotifier implements the MempoolNotifier interface at compile
+// time.
+var _ chainntnfs.MempoolNotifier = (*BitcoindNotifier)(nil)
+
 // New returns a new BitcoindNotifier instance. This function assumes the
 // bitcoind node  detailed in the passed configuration is already running, and
 // willing to accept RPC requests and new zmq clients.
@@ -108,6 +118,8 @@ func New(chainConn *chain.BitcoindConn, spendHintCache chainntnfs.SpendHintCache
 
 		spendNotifications: make(map[wire.OutPoint]map[uint64]*spendNotification),
 
+		mempoolNotifier: chainntnfs.NewTxMempoolNotifier(),
+
 		spendHintCache:   spendHintCache,
 		confirmHintCache: confirmHintCache,
 
@@ -258,35 +270,27 @@ out:
 					"subscription: txid=%v, numconfs=%v",
 					msg.TxID, msg.NumConfirmations)
 
-				currentHeight := uint32(b.bestBlock.Height)
-
 				// Look up whether the transaction is already
 				// included in the active chain. We'll do this
 				// in a goroutine to prevent blocking
 				// potentially long rescans.
 				b.wg.Add(1)
-				go func() {
-					defer b.wg.Done()
+				go b.dispatchHistoricalConfs(
+					confirmationBatch{msg},
+					uint32(b.bestBlock.Height),
+				)
 
-					confDetails, _, err := b.historicalConfDetails(
-						msg.TxID, msg.heightHint,
-						currentHeight,
-					)
-					if err != nil {
-						chainntnfs.Log.Error(err)
-						return
-					}
+			case confirmationBatch:
+				chainntnfs.Log.Infof("New batch of %v "+
+					"confirmation subscriptions", len(msg))
 
-					if confDetails != nil {
-						err := b.txConfNotifier.UpdateConfDetails(
-							*msg.TxID, msg.ConfID,
-							confDetails,
-						)
-						if err != nil {
-							chainntnfs.Log.Error(err)
-						}
-					}
-				}()
+				// The transactions of the batch are looked up
+				// within a single goroutine, rather than one
+				// per transaction.
+				b.wg.Add(1)
+				go b.dispatchHistoricalConfs(
+					msg, uint32(b.bestBlock.Height),
+				)
 
 			case *blockEpochRegistration:
 				chainntnfs.Log.Infof("New block epoch subscription")
@@ -401,8 +405,10 @@ func (b *BitcoindNotifier) handleRelevantTx(tx chain.RelevantTx, bestHeight int3
 
 	// We only care about notifying on confirmed spends, so in case this is
 	// a mempool spend, we can continue, and wait for the spend to appear
-	// in chain.
+	// in chain. Clients awaiting the transaction itself within the
+	// mempool are notified though.
 	if tx.Block == nil {
+		b.mempoolNotifier.NotifyMempoolTx(msgTx.TxHash())
 		return
 	}
 
@@ -947,12 +953,73 @@ type confirmationNotification struct {
 	heightHint uint32
 }
 
+// confirmationBatch is a batch of confirmation notifications registered at
+// once through RegisterConfirmationsNtfnBatch.
+type confirmationBatch []*confirmationNotification
+
 // RegisterConfirmationsNtfn registers a notification with BitcoindNotifier
 // which will be triggered once the txid reaches numConfs number of
 // confirmations.
 func (b *BitcoindNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	// Construct a notification request for the transaction and send it to
+	// the main event loop.
+	ntfn := b.newConfirmationNotification(txid, numConfs, heightHint)
+	if err := b.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
+		return nil, err
+	}
+
+	select {
+	case b.notificationRegistry <- ntfn:
+		return ntfn.Event, nil
+	case <-b.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// RegisterConfirmationsNtfnBatch registers a notification with
+// BitcoindNotifier for each of the passed requests, which are handed to the
+// main event loop as a single batch.
+//
+// NOTE: This is part of the chainntnfs.BatchConfNotifier interface.
+func (b *BitcoindNotifier) RegisterConfirmationsNtfnBatch(
+	reqs []chainntnfs.ConfRequest) ([]*chainntnfs.ConfirmationEvent, error) {
+
+	batch := make(confirmationBatch, 0, len(reqs))
+	confNtfns := make([]*chainntnfs.ConfNtfn, 0, len(reqs))
+	events := make([]*chainntnfs.ConfirmationEvent, 0, len(reqs))
+	for _, req := range reqs {
+		ntfn := b.newConfirmationNotification(
+			req.TxID, req.NumConfs, req.HeightHint,
+		)
+
+		batch = append(batch, ntfn)
+		confNtfns = append(confNtfns, &ntfn.ConfNtfn)
+		events = append(events, ntfn.Event)
+	}
+
+	// With all the requests constructed, we'll register them with the
+	// TxConfNotifier at once, such that none are left registered should
+	// the batch be rejected.
+	if err := b.txConfNotifier.RegisterBatch(confNtfns); err != nil {
+		return nil, err
+	}
+
+	select {
+	case b.notificationRegistry <- batch:
+		return events, nil
+	case <-b.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// newConfirmationNotification constructs a notification request for the
+// passed transaction, which still needs to be registered with the
+// TxConfNotifier.
+func (b *BitcoindNotifier) newConfirmationNotification(txid *chainhash.Hash,
+	numConfs, heightHint uint32) *confirmationNotification {
+
 	// Before proceeding to register the notification, we'll query our
 	// height hint cache to determine whether a better one exists.
 	if hint, err := b.confirmHintCache.QueryConfirmHint(*txid); err == nil {
@@ -963,9 +1030,7 @@ func (b *BitcoindNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 		}
 	}
 
-	// Construct a notification request for the transaction and send it to
-	// the main event loop.
-	ntfn := &confirmationNotification{
+	return &confirmationNotification{
 		ConfNtfn: chainntnfs.ConfNtfn{
 			ConfID:           atomic.AddUint64(&b.confClientCounter, 1),
 			TxID:             txid,
@@ -974,17 +1039,76 @@ func (b *BitcoindNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 		},
 		heightHint: heightHint,
 	}
+}
 
-	if err := b.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
+// dispatchHistoricalConfs looks up whether the transactions of the passed
+// notifications are already included in the active chain, and if so, hands
+// their confirmation details to the TxConfNotifier. The lookups are run in
+// parallel.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BitcoindNotifier) dispatchHistoricalConfs(ntfns confirmationBatch,
+	bestHeight uint32) {
+
+	defer b.wg.Done()
+
+	chainntnfs.DispatchHistoricalConfs(len(ntfns), func(i int) {
+		b.dispatchHistoricalConf(ntfns[i], bestHeight)
+	}, b.quit)
+}
+
+// dispatchHistoricalConf looks up whether the transaction of the passed
+// notification is already included in the active chain, and if so, hands its
+// confirmation details to the TxConfNotifier.
+func (b *BitcoindNotifier) dispatchHistoricalConf(ntfn *confirmationNotification,
+	bestHeight uint32) {
+
+	confDetails, _, err := b.historicalConfDetails(
+		ntfn.TxID, ntfn.heightHint, bestHeight,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
+		return
+	}
+
+	if confDetails == nil {
+		return
+	}
+
+	err = b.txConfNotifier.UpdateConfDetails(
+		*ntfn.TxID, ntfn.ConfID, confDetails,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
+	}
+}
+
+// RegisterMempoolNtfn registers an intent to be notified once the target txid
+// is seen within bitcoind's mempool.
+//
+// NOTE: This is part of the chainntnfs.MempoolNotifier interface.
+func (b *BitcoindNotifier) RegisterMempoolNtfn(
+	txid *chainhash.Hash) (*chainntnfs.MempoolEvent, error) {
+
+	event := b.mempoolNotifier.Register(*txid)
+
+	// Instruct bitcoind to watch for the transaction, such that it's
+	// delivered to us as a relevant transaction once it enters the
+	// mempool.
+	if err := b.chainConn.NotifyTx([]chainhash.Hash{*txid}); err != nil {
+		event.Cancel()
 		return nil, err
 	}
 
-	select {
-	case b.notificationRegistry <- ntfn:
-		return ntfn.Event, nil
-	case <-b.quit:
-		return nil, ErrChainNotifierShuttingDown
+	// The transaction may have been accepted before we registered, so
+	// we'll check whether it's already within the mempool. Any error
+	// means it isn't known to bitcoind at all.
+	rawTx, err := b.chainConn.GetRawTransactionVerbose(txid)
+	if err == nil && rawTx.BlockHash == "" {
+		b.mempoolNotifier.NotifyMempoolTx(*txid)
 	}
+
+	return event, nil
 }
 
 // blockEpochRegistration represents a client's intent to receive a
@@ -68,6 +68,13 @@ type BtcdNotifier struct {
 	started int32 // To be used atomically.
 	stopped int32 // To be used atomically.
 
+	// mempoolTxsRequested is set once btcd has been successfully
+	// requested to notify us of the transactions accepted into its
+	// mempool. It's guarded by mempoolMtx, which is held throughout the
+	// request, such that concurrent registrations wait for its result.
+	mempoolTxsRequested bool
+	mempoolMtx          sync.Mutex
+
 	chainConn *rpcclient.Client
 
 	notificationCancels  chan interface{}
@@ -77,6 +84,8 @@ type BtcdNotifier struct {
 
 	txConfNotifier *chainntnfs.TxConfNotifier
 
+	mempoolNotifier *chainntnfs.TxMempoolNotifier
+
 	blockEpochClients map[uint64]*blockEpochRegistration
 
 	bestBlock chainntnfs.BlockEpoch
@@ -101,6 +110,14 @@ type BtcdNotifier struct {
 // Ensure BtcdNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*BtcdNotifier)(nil)
 
+// Ensure BtcdNotifier implements the BatchConfNotifier interface at compile
+// time.
+var _ chainntnfs.BatchConfNotifier = (*BtcdNotifier)(nil)
+
+// Ensure BtcdNotifier implements the MempoolNotifier interface at compile
+// time.
+var _ chainntnfs.MempoolNotifier = (*BtcdNotifier)(nil)
+
 // New returns a new BtcdNotifier instance. This function assumes the btcd node
 // detailed in the passed configuration is already running, and willing to
 // accept new websockets clients.
@@ -115,6 +132,8 @@ func New(config *rpcclient.ConnConfig, spendHintCache chainntnfs.SpendHintCache,
 
 		spendNotifications: make(map[wire.OutPoint]map[uint64]*spendNotification),
 
+		mempoolNotifier: chainntnfs.NewTxMempoolNotifier(),
+
 		chainUpdates: chainntnfs.NewConcurrentQueue(10),
 		txUpdates:    chainntnfs.NewConcurrentQueue(10),
 
@@ -128,6 +147,7 @@ func New(config *rpcclient.ConnConfig, spendHintCache chainntnfs.SpendHintCache,
 		OnBlockConnected:    notifier.onBlockConnected,
 		OnBlockDisconnected: notifier.onBlockDisconnected,
 		OnRedeemingTx:       notifier.onRedeemingTx,
+		OnTxAccepted:        notifier.onTxAccepted,
 	}
 
 	// Disable connecting to btcd within the rpcclient.New method. We
@@ -259,6 +279,11 @@ func (b *BtcdNotifier) onBlockDisconnected(hash *chainhash.Hash, height int32, t
 	}
 }
 
+// onTxAccepted implements on OnTxAccepted callback for rpcclient.
+func (b *BtcdNotifier) onTxAccepted(hash *chainhash.Hash, _ btcutil.Amount) {
+	b.mempoolNotifier.NotifyMempoolTx(*hash)
+}
+
 // onRedeemingTx implements on OnRedeemingTx callback for rpcclient.
 func (b *BtcdNotifier) onRedeemingTx(tx *btcutil.Tx, details *btcjson.BlockDetails) {
 	// Append this new transaction update to the end of the queue of new
@@ -328,35 +353,27 @@ out:
 					"subscription: txid=%v, numconfs=%v",
 					msg.TxID, msg.NumConfirmations)
 
-				bestHeight := uint32(b.bestBlock.Height)
-
 				// Look up whether the transaction is already
 				// included in the active chain. We'll do this
 				// in a goroutine to prevent blocking
 				// potentially long rescans.
 				b.wg.Add(1)
-				go func() {
-					defer b.wg.Done()
-
-					confDetails, _, err := b.historicalConfDetails(
-						msg.TxID, msg.heightHint,
-						bestHeight,
-					)
-					if err != nil {
-						chainntnfs.Log.Error(err)
-						return
-					}
-
-					if confDetails != nil {
-						err = b.txConfNotifier.UpdateConfDetails(
-							*msg.TxID, msg.ConfID,
-							confDetails,
-						)
-						if err != nil {
-							chainntnfs.Log.Error(err)
-						}
-					}
-				}()
+				go b.dispatchHistoricalConfs(
+					confirmationBatch{msg},
+					uint32(b.bestBlock.Height),
+				)
+
+			case confirmationBatch:
+				chainntnfs.Log.Infof("New batch of %v "+
+					"confirmation subscriptions", len(msg))
+
+				// The transactions of the batch are looked up
+				// within a single goroutine, rather than one
+				// per transaction.
+				b.wg.Add(1)
+				go b.dispatchHistoricalConfs(
+					msg, uint32(b.bestBlock.Height),
+				)
 
 			case *blockEpochRegistration:
 				chainntnfs.Log.Infof("New block epoch subscription")
@@ -993,12 +1010,73 @@ type confirmationNotification struct {
 	heightHint uint32
 }
 
+// confirmationBatch is a batch of confirmation notifications registered at
+// once through RegisterConfirmationsNtfnBatch.
+type confirmationBatch []*confirmationNotification
+
 // RegisterConfirmationsNtfn registers a notification with BtcdNotifier
 // which will be triggered once the txid reaches numConfs number of
 // confirmations.
 func (b *BtcdNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte,
 	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	// Construct a notification request for the transaction and send it to
+	// the main event loop.
+	ntfn := b.newConfirmationNotification(txid, numConfs, heightHint)
+	if err := b.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
+		return nil, err
+	}
+
+	select {
+	case b.notificationRegistry <- ntfn:
+		return ntfn.Event, nil
+	case <-b.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// RegisterConfirmationsNtfnBatch registers a notification with BtcdNotifier
+// for each of the passed requests, which are handed to the main event loop as
+// a single batch.
+//
+// NOTE: This is part of the chainntnfs.BatchConfNotifier interface.
+func (b *BtcdNotifier) RegisterConfirmationsNtfnBatch(
+	reqs []chainntnfs.ConfRequest) ([]*chainntnfs.ConfirmationEvent, error) {
+
+	batch := make(confirmationBatch, 0, len(reqs))
+	confNtfns := make([]*chainntnfs.ConfNtfn, 0, len(reqs))
+	events := make([]*chainntnfs.ConfirmationEvent, 0, len(reqs))
+	for _, req := range reqs {
+		ntfn := b.newConfirmationNotification(
+			req.TxID, req.NumConfs, req.HeightHint,
+		)
+
+		batch = append(batch, ntfn)
+		confNtfns = append(confNtfns, &ntfn.ConfNtfn)
+		events = append(events, ntfn.Event)
+	}
+
+	// With all the requests constructed, we'll register them with the
+	// TxConfNotifier at once, such that none are left registered should
+	// the batch be rejected.
+	if err := b.txConfNotifier.RegisterBatch(confNtfns); err != nil {
+		return nil, err
+	}
+
+	select {
+	case b.notificationRegistry <- batch:
+		return events, nil
+	case <-b.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// newConfirmationNotification constructs a notification request for the
+// passed transaction, which still needs to be registered with the
+// TxConfNotifier.
+func (b *BtcdNotifier) newConfirmationNotification(txid *chainhash.Hash,
+	numConfs, heightHint uint32) *confirmationNotification {
+
 	// Before proceeding to register the notification, we'll query our
 	// height hint cache to determine whether a better one exists.
 	if hint, err := b.confirmHintCache.QueryConfirmHint(*txid); err == nil {
@@ -1009,9 +1087,7 @@ func (b *BtcdNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte,
 		}
 	}
 
-	// Construct a notification request for the transaction and send it to
-	// the main event loop.
-	ntfn := &confirmationNotification{
+	return &confirmationNotification{
 		ConfNtfn: chainntnfs.ConfNtfn{
 			ConfID:           atomic.AddUint64(&b.confClientCounter, 1),
 			TxID:             txid,
@@ -1020,17 +1096,101 @@ func (b *BtcdNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte,
 		},
 		heightHint: heightHint,
 	}
+}
 
-	if err := b.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
+// dispatchHistoricalConfs looks up whether the transactions of the passed
+// notifications are already included in the active chain, and if so, hands
+// their confirmation details to the TxConfNotifier. The lookups are run in
+// parallel.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BtcdNotifier) dispatchHistoricalConfs(ntfns confirmationBatch,
+	bestHeight uint32) {
+
+	defer b.wg.Done()
+
+	chainntnfs.DispatchHistoricalConfs(len(ntfns), func(i int) {
+		b.dispatchHistoricalConf(ntfns[i], bestHeight)
+	}, b.quit)
+}
+
+// dispatchHistoricalConf looks up whether the transaction of the passed
+// notification is already included in the active chain, and if so, hands its
+// confirmation details to the TxConfNotifier.
+func (b *BtcdNotifier) dispatchHistoricalConf(ntfn *confirmationNotification,
+	bestHeight uint32) {
+
+	confDetails, _, err := b.historicalConfDetails(
+		ntfn.TxID, ntfn.heightHint, bestHeight,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
+		return
+	}
+
+	if confDetails == nil {
+		return
+	}
+
+	err = b.txConfNotifier.UpdateConfDetails(
+		*ntfn.TxID, ntfn.ConfID, confDetails,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
+	}
+}
+
+// RegisterMempoolNtfn registers an intent to be notified once the target txid
+// is seen within btcd's mempool.
+//
+// NOTE: btcd is unable to notify us of specific transactions entering its
+// mempool, so upon the first registration, we subscribe to every transaction
+// accepted into it. As btcd offers no way to unsubscribe, the subscription,
+// and the cost of receiving each of those transactions, lasts for the
+// lifetime of the notifier, even once all clients have canceled.
+//
+// NOTE: This is part of the chainntnfs.MempoolNotifier interface.
+func (b *BtcdNotifier) RegisterMempoolNtfn(
+	txid *chainhash.Hash) (*chainntnfs.MempoolEvent, error) {
+
+	event := b.mempoolNotifier.Register(*txid)
+
+	// btcd can only notify us of all transactions accepted into its
+	// mempool, so we'll only request it once the first client registers,
+	// and keep the subscription from then on.
+	if err := b.requestMempoolTxs(); err != nil {
+		event.Cancel()
 		return nil, err
 	}
 
-	select {
-	case b.notificationRegistry <- ntfn:
-		return ntfn.Event, nil
-	case <-b.quit:
-		return nil, ErrChainNotifierShuttingDown
+	// The transaction may have been accepted before we registered, so
+	// we'll check whether it's already within the mempool. Any error
+	// means it isn't known to btcd at all.
+	rawTx, err := b.chainConn.GetRawTransactionVerbose(txid)
+	if err == nil && rawTx.BlockHash == "" {
+		b.mempoolNotifier.NotifyMempoolTx(*txid)
 	}
+
+	return event, nil
+}
+
+// requestMempoolTxs requests btcd to notify us of every transaction accepted
+// into its mempool, unless it has already been successfully requested. A
+// failed request is retried by the next caller.
+func (b *BtcdNotifier) requestMempoolTxs() error {
+	b.mempoolMtx.Lock()
+	defer b.mempoolMtx.Unlock()
+
+	if b.mempoolTxsRequested {
+		return nil
+	}
+
+	if err := b.chainConn.NotifyNewTransactions(false); err != nil {
+		return err
+	}
+	b.mempoolTxsRequested = true
+
+	return nil
 }
 
 // blockEpochRegistration represents a client's intent to receive a
@@ -126,6 +126,108 @@ type ChainNotifier interface {
 	Stop() error
 }
 
+// ConfRequest is a request to be notified once a transaction reaches a number
+// of confirmations. Its fields match the parameters of
+// RegisterConfirmationsNtfn.
+type ConfRequest struct {
+	// TxID is the hash of the transaction to watch.
+	TxID *chainhash.Hash
+
+	// PkScript is a script created by the transaction, which light
+	// clients match on.
+	PkScript []byte
+
+	// NumConfs is the number of confirmations the transaction needs to
+	// reach.
+	NumConfs uint32
+
+	// HeightHint is the earliest height at which the transaction could
+	// have been included in the chain.
+	HeightHint uint32
+}
+
+// BatchConfNotifier is implemented by ChainNotifiers that are able to register
+// many confirmation notifications at once, at a lower cost than registering
+// each of them through RegisterConfirmationsNtfn.
+type BatchConfNotifier interface {
+	// RegisterConfirmationsNtfnBatch registers a confirmation notification
+	// for each of the passed requests, returning their ConfirmationEvents
+	// in the same order. The events behave exactly as those returned by
+	// RegisterConfirmationsNtfn.
+	RegisterConfirmationsNtfnBatch(
+		reqs []ConfRequest) ([]*ConfirmationEvent, error)
+}
+
+// RegisterConfirmationsBatch registers a confirmation notification for each of
+// the passed requests with the notifier, returning their ConfirmationEvents in
+// the same order. If the notifier implements BatchConfNotifier, the requests
+// are registered as a single batch, otherwise they're registered one at a
+// time. In the latter case, should a registration fail, those preceding it
+// remain registered, as confirmation notifications can't be canceled.
+func RegisterConfirmationsBatch(notifier ChainNotifier,
+	reqs []ConfRequest) ([]*ConfirmationEvent, error) {
+
+	if batchNotifier, ok := notifier.(BatchConfNotifier); ok {
+		return batchNotifier.RegisterConfirmationsNtfnBatch(reqs)
+	}
+
+	events := make([]*ConfirmationEvent, 0, len(reqs))
+	for _, req := range reqs {
+		event, err := notifier.RegisterConfirmationsNtfn(
+			req.TxID, req.PkScript, req.NumConfs, req.HeightHint,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// MaxHistoricalConfDispatches is the number of historical confirmation
+// lookups of a batch of confirmation notifications that a ChainNotifier runs
+// in parallel.
+const MaxHistoricalConfDispatches = 8
+
+// DispatchHistoricalConfs calls dispatch with the index of each of the numNtfns
+// notifications of a batch, running up to MaxHistoricalConfDispatches of the
+// calls in parallel. Once quit is closed, no further calls are started. It
+// returns once all calls started have returned.
+func DispatchHistoricalConfs(numNtfns int, dispatch func(int),
+	quit <-chan struct{}) {
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, MaxHistoricalConfDispatches)
+	)
+	defer wg.Wait()
+
+	for i := 0; i < numNtfns; i++ {
+		select {
+		case <-quit:
+			return
+		default:
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-quit:
+			return
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			dispatch(i)
+		}(i)
+	}
+}
+
 // TxConfirmation carries some additional block-level details of the exact
 // block that specified transactions was confirmed within.
 type TxConfirmation struct {
@@ -153,8 +255,10 @@ type TxConfirmation struct {
 // channel will be sent upon fulfilling the notification.
 //
 // If the event that the original transaction becomes re-org'd out of the main
-// chain, the 'NegativeConf' will be sent upon with a value representing the
-// depth of the re-org.
+// chain after its confirmation was dispatched, the 'NegativeConf' will be sent
+// upon with a value representing the depth of the re-org. The notification
+// stays registered, so 'Confirmed' will be sent upon once more if the
+// transaction is included in the new chain.
 type ConfirmationEvent struct {
 	// Confirmed is a channel that will be sent upon once the transaction
 	// has been fully confirmed. The struct sent will contain all the
@@ -166,11 +270,12 @@ type ConfirmationEvent struct {
 	// transaction as fully confirmed.
 	Updates chan uint32 // MUST be buffered.
 
-	// TODO(roasbeef): all goroutines on ln channel updates should also
-	// have a struct chan that's closed if funding gets re-org out. Need
-	// to sync, to request another confirmation event ntfn, then re-open
-	// channel after confs.
-
+	// NegativeConf is a channel that will be sent upon if the transaction
+	// is re-org'd out of the main chain after its confirmation has been
+	// dispatched, with the number of blocks that were disconnected in
+	// succession. Only the deepest undelivered re-org is buffered, so a
+	// slow client only misses the shallower ones, and never stalls the
+	// notifier.
 	NegativeConf chan int32 // MUST be buffered.
 }
 
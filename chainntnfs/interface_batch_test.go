@@ -0,0 +1,159 @@
+package chainntnfs_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// mockConfNotifier is a ChainNotifier that records the confirmation
+// notifications registered with it.
+type mockConfNotifier struct {
+	chainntnfs.ChainNotifier
+
+	registered []chainhash.Hash
+}
+
+func (m *mockConfNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	_ []byte, numConfs, _ uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	m.registered = append(m.registered, *txid)
+	return chainntnfs.NewConfirmationEvent(numConfs), nil
+}
+
+// mockBatchConfNotifier is a mockConfNotifier that's also able to register
+// confirmation notifications as a batch.
+type mockBatchConfNotifier struct {
+	mockConfNotifier
+
+	batches int
+}
+
+func (m *mockBatchConfNotifier) RegisterConfirmationsNtfnBatch(
+	reqs []chainntnfs.ConfRequest) ([]*chainntnfs.ConfirmationEvent, error) {
+
+	m.batches++
+
+	events := make([]*chainntnfs.ConfirmationEvent, 0, len(reqs))
+	for _, req := range reqs {
+		m.registered = append(m.registered, *req.TxID)
+		events = append(
+			events, chainntnfs.NewConfirmationEvent(req.NumConfs),
+		)
+	}
+
+	return events, nil
+}
+
+// TestRegisterConfirmationsBatch tests that a batch of confirmation requests
+// is registered at once with notifiers that support it, and one at a time
+// with those that don't.
+func TestRegisterConfirmationsBatch(t *testing.T) {
+	t.Parallel()
+
+	reqs := []chainntnfs.ConfRequest{
+		{TxID: &chainhash.Hash{0x01}, NumConfs: 1},
+		{TxID: &chainhash.Hash{0x02}, NumConfs: 3},
+		{TxID: &chainhash.Hash{0x03}, NumConfs: 6},
+	}
+
+	assertRegistered := func(notifier *mockConfNotifier,
+		events []*chainntnfs.ConfirmationEvent) {
+
+		t.Helper()
+
+		if len(events) != len(reqs) {
+			t.Fatalf("expected %v events, got %v", len(reqs),
+				len(events))
+		}
+		if len(notifier.registered) != len(reqs) {
+			t.Fatalf("expected %v registrations, got %v",
+				len(reqs), len(notifier.registered))
+		}
+
+		// The registrations and their events should be in the order
+		// of the requests.
+		for i, req := range reqs {
+			if notifier.registered[i] != *req.TxID {
+				t.Fatalf("expected registration %v for %v, "+
+					"got %v", i, req.TxID,
+					notifier.registered[i])
+			}
+			if cap(events[i].Updates) != int(req.NumConfs) {
+				t.Fatalf("event %v doesn't match request", i)
+			}
+		}
+	}
+
+	notifier := &mockConfNotifier{}
+	events, err := chainntnfs.RegisterConfirmationsBatch(notifier, reqs)
+	if err != nil {
+		t.Fatalf("unable to register batch: %v", err)
+	}
+	assertRegistered(notifier, events)
+
+	batchNotifier := &mockBatchConfNotifier{}
+	events, err = chainntnfs.RegisterConfirmationsBatch(batchNotifier, reqs)
+	if err != nil {
+		t.Fatalf("unable to register batch: %v", err)
+	}
+	assertRegistered(&batchNotifier.mockConfNotifier, events)
+	if batchNotifier.batches != 1 {
+		t.Fatalf("expected a single batch, got %v",
+			batchNotifier.batches)
+	}
+}
+
+// TestDispatchHistoricalConfs tests that historical confirmation lookups are
+// dispatched for each notification of a batch in parallel, without exceeding
+// the maximum number of parallel lookups, and that no lookups are started
+// once the notifier is quitting.
+func TestDispatchHistoricalConfs(t *testing.T) {
+	t.Parallel()
+
+	const numNtfns = 3 * chainntnfs.MaxHistoricalConfDispatches
+
+	var (
+		mtx        sync.Mutex
+		dispatched = make(map[int]struct{})
+		active     int
+		maxActive  int
+	)
+	chainntnfs.DispatchHistoricalConfs(numNtfns, func(i int) {
+		mtx.Lock()
+		dispatched[i] = struct{}{}
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mtx.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mtx.Lock()
+		active--
+		mtx.Unlock()
+	}, nil)
+
+	if len(dispatched) != numNtfns {
+		t.Fatalf("expected %v lookups, got %v", numNtfns,
+			len(dispatched))
+	}
+	if maxActive > chainntnfs.MaxHistoricalConfDispatches {
+		t.Fatalf("expected at most %v parallel lookups, got %v",
+			chainntnfs.MaxHistoricalConfDispatches, maxActive)
+	}
+	if maxActive < 2 {
+		t.Fatalf("expected lookups to run in parallel")
+	}
+
+	quit := make(chan struct{})
+	close(quit)
+
+	chainntnfs.DispatchHistoricalConfs(numNtfns, func(int) {
+		t.Fatalf("lookup dispatched while quitting")
+	}, quit)
+}
@@ -0,0 +1,121 @@
+package chainntnfs
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// MempoolEvent encapsulates a notification of a transaction being accepted
+// into the mempool of the backend node. This allows a caller to tell a
+// transaction that has been accepted for relay apart from one that has been
+// confirmed.
+type MempoolEvent struct {
+	// Seen is a channel that is sent upon once the transaction is seen
+	// within the mempool of the backend node. As the transaction may
+	// re-enter the mempool, e.g. after being reorged out of the chain or
+	// rebroadcast after eviction, this may be sent upon more than once.
+	// Notifications the caller has yet to consume are coalesced.
+	Seen chan struct{}
+
+	// Cancel is a closure that should be executed by the caller in the
+	// case that they wish to prematurely abandon their registered mempool
+	// notification.
+	Cancel func()
+}
+
+// MempoolNotifier is implemented by ChainNotifiers whose backend exposes the
+// transactions accepted into its mempool. Light clients have no view of the
+// mempool, so callers MUST NOT rely on all notifiers implementing it.
+type MempoolNotifier interface {
+	// RegisterMempoolNtfn registers an intent to be notified once the
+	// target txid is seen within the mempool of the backend node. If the
+	// transaction is already within the mempool, the notification is
+	// dispatched immediately.
+	RegisterMempoolNtfn(txid *chainhash.Hash) (*MempoolEvent, error)
+}
+
+// TxMempoolNotifier keeps track of the mempool notifications registered for
+// transactions, and dispatches them as the backend reports the transactions
+// entering its mempool. It's meant to be shared among the implementations of
+// the MempoolNotifier interface, which feed it the transactions seen by their
+// backend.
+type TxMempoolNotifier struct {
+	// clientCounter is used to uniquely identify each registered client.
+	clientCounter uint64
+
+	// clients is a map of the transactions watched for, to the events of
+	// their registered clients.
+	clients map[chainhash.Hash]map[uint64]*MempoolEvent
+
+	sync.Mutex
+}
+
+// NewTxMempoolNotifier creates a new TxMempoolNotifier without any registered
+// clients.
+func NewTxMempoolNotifier() *TxMempoolNotifier {
+	return &TxMempoolNotifier{
+		clients: make(map[chainhash.Hash]map[uint64]*MempoolEvent),
+	}
+}
+
+// Register registers a new client to be notified once the target txid is seen
+// within the mempool.
+func (n *TxMempoolNotifier) Register(txid chainhash.Hash) *MempoolEvent {
+	n.Lock()
+	defer n.Unlock()
+
+	n.clientCounter++
+	clientID := n.clientCounter
+
+	event := &MempoolEvent{
+		Seen: make(chan struct{}, 1),
+		Cancel: func() {
+			n.cancel(txid, clientID)
+		},
+	}
+
+	if _, ok := n.clients[txid]; !ok {
+		n.clients[txid] = make(map[uint64]*MempoolEvent)
+	}
+	n.clients[txid][clientID] = event
+
+	return event
+}
+
+// cancel removes the client with the given ID from the clients of txid.
+func (n *TxMempoolNotifier) cancel(txid chainhash.Hash, clientID uint64) {
+	n.Lock()
+	defer n.Unlock()
+
+	clients, ok := n.clients[txid]
+	if !ok {
+		return
+	}
+
+	delete(clients, clientID)
+	if len(clients) == 0 {
+		delete(n.clients, txid)
+	}
+}
+
+// NotifyMempoolTx notifies the clients registered for the given txid that the
+// transaction has been seen within the mempool. Transactions without any
+// registered clients are ignored.
+func (n *TxMempoolNotifier) NotifyMempoolTx(txid chainhash.Hash) {
+	n.Lock()
+	defer n.Unlock()
+
+	for _, event := range n.clients[txid] {
+		Log.Debugf("Dispatching mempool notification for txid=%v",
+			txid)
+
+		// The channel is buffered, so if it's already full, the
+		// client has yet to consume a previous notification, which
+		// will suffice.
+		select {
+		case event.Seen <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package chainntnfs_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// TestTxMempoolNotifier tests that mempool notifications are only dispatched
+// to the clients registered for the transaction seen, and no longer once
+// they've been canceled.
+func TestTxMempoolNotifier(t *testing.T) {
+	t.Parallel()
+
+	txid1 := chainhash.Hash{0x01}
+	txid2 := chainhash.Hash{0x02}
+
+	n := chainntnfs.NewTxMempoolNotifier()
+	event1 := n.Register(txid1)
+	event2 := n.Register(txid1)
+	event3 := n.Register(txid2)
+
+	assertSeen := func(event *chainntnfs.MempoolEvent, seen bool) {
+		t.Helper()
+
+		select {
+		case <-event.Seen:
+			if !seen {
+				t.Fatalf("unexpected mempool notification")
+			}
+		default:
+			if seen {
+				t.Fatalf("expected mempool notification")
+			}
+		}
+	}
+
+	// Seeing the first transaction should only notify its clients.
+	n.NotifyMempoolTx(txid1)
+	assertSeen(event1, true)
+	assertSeen(event2, true)
+	assertSeen(event3, false)
+
+	// Seeing a transaction more than once before the client consumes the
+	// notification shouldn't block, and results in a single notification.
+	n.NotifyMempoolTx(txid2)
+	n.NotifyMempoolTx(txid2)
+	assertSeen(event3, true)
+	assertSeen(event3, false)
+
+	// Once canceled, a client should no longer be notified, while the
+	// other clients of the transaction still are.
+	event1.Cancel()
+	n.NotifyMempoolTx(txid1)
+	assertSeen(event1, false)
+	assertSeen(event2, true)
+
+	// Canceling twice is a no-op.
+	event1.Cancel()
+	event2.Cancel()
+	event3.Cancel()
+	n.NotifyMempoolTx(txid1)
+	n.NotifyMempoolTx(txid2)
+	assertSeen(event2, false)
+	assertSeen(event3, false)
+}
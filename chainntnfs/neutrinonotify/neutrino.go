@@ -94,6 +94,10 @@ type NeutrinoNotifier struct {
 // Ensure NeutrinoNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*NeutrinoNotifier)(nil)
 
+// Ensure NeutrinoNotifier implements the BatchConfNotifier interface at
+// compile time.
+var _ chainntnfs.BatchConfNotifier = (*NeutrinoNotifier)(nil)
+
 // New creates a new instance of the NeutrinoNotifier concrete implementation
 // of the ChainNotifier interface.
 //
@@ -333,56 +337,23 @@ out:
 				// in a goroutine to prevent blocking
 				// potentially long rescans.
 				n.wg.Add(1)
-				go func() {
-					defer n.wg.Done()
-
-					confDetails, err := n.historicalConfDetails(
-						msg.TxID, msg.pkScript, currentHeight, msg.heightHint,
-					)
-					if err != nil {
-						chainntnfs.Log.Error(err)
-					}
-
-					// We'll map the script into an address
-					// type so we can instruct neutrino to
-					// match if the transaction containing
-					// the script is found in a block.
-					params := n.p2pNode.ChainParams()
-					_, addrs, _, err := txscript.ExtractPkScriptAddrs(
-						msg.pkScript, &params,
-					)
-					if err != nil {
-						chainntnfs.Log.Error(err)
-					}
+				go n.dispatchHistoricalConfs(
+					confirmationsBatch{msg}, currentHeight,
+				)
 
-					if confDetails != nil {
-						err := n.txConfNotifier.UpdateConfDetails(
-							*msg.TxID, msg.ConfID,
-							confDetails,
-						)
-						if err != nil {
-							chainntnfs.Log.Error(err)
-						}
-						return
-					}
+			case confirmationsBatch:
+				chainntnfs.Log.Infof("New batch of %v "+
+					"confirmations subscriptions", len(msg))
 
-					// If we can't fully dispatch
-					// confirmation, then we'll update our
-					// filter so we can be notified of its
-					// future initial confirmation.
-					rescanUpdate := []neutrino.UpdateOption{
-						neutrino.AddAddrs(addrs...),
-						neutrino.Rewind(currentHeight),
-						neutrino.DisableDisconnectedNtfns(true),
-					}
-					err = n.chainView.Update(rescanUpdate...)
-					if err != nil {
-						chainntnfs.Log.Errorf("Unable "+
-							"to update rescan: %v",
-							err)
-					}
+				n.heightMtx.RLock()
+				currentHeight := n.bestHeight
+				n.heightMtx.RUnlock()
 
-				}()
+				// The transactions of the batch are looked up
+				// within a single goroutine, which updates
+				// the rescan filter only once for all of them.
+				n.wg.Add(1)
+				go n.dispatchHistoricalConfs(msg, currentHeight)
 
 			case *blockEpochRegistration:
 				chainntnfs.Log.Infof("New block epoch subscription")
@@ -905,6 +876,10 @@ type confirmationsNotification struct {
 	pkScript   []byte
 }
 
+// confirmationsBatch is a batch of confirmations notifications registered at
+// once through RegisterConfirmationsNtfnBatch.
+type confirmationsBatch []*confirmationsNotification
+
 // RegisterConfirmationsNtfn registers a notification with NeutrinoNotifier
 // which will be triggered once the txid reaches numConfs number of
 // confirmations.
@@ -912,6 +887,67 @@ func (n *NeutrinoNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	pkScript []byte,
 	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	// Construct a notification request for the transaction and send it to
+	// the main event loop.
+	ntfn := n.newConfirmationsNotification(
+		txid, pkScript, numConfs, heightHint,
+	)
+	if err := n.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
+		return nil, err
+	}
+
+	select {
+	case n.notificationRegistry <- ntfn:
+		return ntfn.Event, nil
+	case <-n.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// RegisterConfirmationsNtfnBatch registers a notification with
+// NeutrinoNotifier for each of the passed requests, which are handed to the
+// main event loop as a single batch. This allows the rescan filter to be
+// updated once for the entire batch, rather than once per transaction.
+//
+// NOTE: This is part of the chainntnfs.BatchConfNotifier interface.
+func (n *NeutrinoNotifier) RegisterConfirmationsNtfnBatch(
+	reqs []chainntnfs.ConfRequest) ([]*chainntnfs.ConfirmationEvent, error) {
+
+	batch := make(confirmationsBatch, 0, len(reqs))
+	confNtfns := make([]*chainntnfs.ConfNtfn, 0, len(reqs))
+	events := make([]*chainntnfs.ConfirmationEvent, 0, len(reqs))
+	for _, req := range reqs {
+		ntfn := n.newConfirmationsNotification(
+			req.TxID, req.PkScript, req.NumConfs, req.HeightHint,
+		)
+
+		batch = append(batch, ntfn)
+		confNtfns = append(confNtfns, &ntfn.ConfNtfn)
+		events = append(events, ntfn.Event)
+	}
+
+	// With all the requests constructed, we'll register them with the
+	// TxConfNotifier at once, such that none are left registered should
+	// the batch be rejected.
+	if err := n.txConfNotifier.RegisterBatch(confNtfns); err != nil {
+		return nil, err
+	}
+
+	select {
+	case n.notificationRegistry <- batch:
+		return events, nil
+	case <-n.quit:
+		return nil, ErrChainNotifierShuttingDown
+	}
+}
+
+// newConfirmationsNotification constructs a notification request for the
+// passed transaction, which still needs to be registered with the
+// TxConfNotifier.
+func (n *NeutrinoNotifier) newConfirmationsNotification(txid *chainhash.Hash,
+	pkScript []byte,
+	numConfs, heightHint uint32) *confirmationsNotification {
+
 	// Before proceeding to register the notification, we'll query our
 	// height hint cache to determine whether a better one exists.
 	if hint, err := n.confirmHintCache.QueryConfirmHint(*txid); err == nil {
@@ -922,9 +958,7 @@ func (n *NeutrinoNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 		}
 	}
 
-	// Construct a notification request for the transaction and send it to
-	// the main event loop.
-	ntfn := &confirmationsNotification{
+	return &confirmationsNotification{
 		ConfNtfn: chainntnfs.ConfNtfn{
 			ConfID:           atomic.AddUint64(&n.confClientCounter, 1),
 			TxID:             txid,
@@ -934,17 +968,86 @@ func (n *NeutrinoNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 		heightHint: heightHint,
 		pkScript:   pkScript,
 	}
+}
 
-	if err := n.txConfNotifier.Register(&ntfn.ConfNtfn); err != nil {
-		return nil, err
+// dispatchHistoricalConfs looks up whether the transactions of the passed
+// notifications are already included in the active chain, and if so, hands
+// their confirmation details to the TxConfNotifier. The lookups are run in
+// parallel. Once all have completed, the scripts of the transactions that
+// aren't are added to the rescan filter with a single update, such that we're
+// notified of their future initial confirmation.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *NeutrinoNotifier) dispatchHistoricalConfs(ntfns confirmationsBatch,
+	currentHeight uint32) {
+
+	defer n.wg.Done()
+
+	var (
+		unconfirmedAddrs []btcutil.Address
+		mtx              sync.Mutex
+	)
+	chainntnfs.DispatchHistoricalConfs(len(ntfns), func(i int) {
+		addrs := n.dispatchHistoricalConf(ntfns[i], currentHeight)
+
+		mtx.Lock()
+		unconfirmedAddrs = append(unconfirmedAddrs, addrs...)
+		mtx.Unlock()
+	}, n.quit)
+
+	if len(unconfirmedAddrs) == 0 {
+		return
 	}
 
-	select {
-	case n.notificationRegistry <- ntfn:
-		return ntfn.Event, nil
-	case <-n.quit:
-		return nil, ErrChainNotifierShuttingDown
+	// If we can't fully dispatch confirmation, then we'll update our
+	// filter so we can be notified of its future initial confirmation.
+	rescanUpdate := []neutrino.UpdateOption{
+		neutrino.AddAddrs(unconfirmedAddrs...),
+		neutrino.Rewind(currentHeight),
+		neutrino.DisableDisconnectedNtfns(true),
+	}
+	if err := n.chainView.Update(rescanUpdate...); err != nil {
+		chainntnfs.Log.Errorf("Unable to update rescan: %v", err)
+	}
+}
+
+// dispatchHistoricalConf looks up whether the transaction of the passed
+// notification is already included in the active chain, and if so, hands its
+// confirmation details to the TxConfNotifier. Otherwise, the addresses of its
+// script that the rescan filter should match on are returned.
+func (n *NeutrinoNotifier) dispatchHistoricalConf(
+	ntfn *confirmationsNotification,
+	currentHeight uint32) []btcutil.Address {
+
+	confDetails, err := n.historicalConfDetails(
+		ntfn.TxID, ntfn.pkScript, currentHeight, ntfn.heightHint,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
 	}
+
+	if confDetails != nil {
+		err := n.txConfNotifier.UpdateConfDetails(
+			*ntfn.TxID, ntfn.ConfID, confDetails,
+		)
+		if err != nil {
+			chainntnfs.Log.Error(err)
+		}
+		return nil
+	}
+
+	// We'll map the script into an address type so we can instruct
+	// neutrino to match if the transaction containing the script is found
+	// in a block.
+	params := n.p2pNode.ChainParams()
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		ntfn.pkScript, &params,
+	)
+	if err != nil {
+		chainntnfs.Log.Error(err)
+	}
+
+	return addrs
 }
 
 // blockEpochRegistration represents a client's intent to receive a
@@ -134,6 +134,36 @@ func (tcn *TxConfNotifier) Register(ntfn *ConfNtfn) error {
 	tcn.Lock()
 	defer tcn.Unlock()
 
+	tcn.register(ntfn)
+
+	return nil
+}
+
+// RegisterBatch handles a batch of new notification requests, as Register
+// does for each of them. The batch is registered atomically: should the
+// notifier be exiting, none of the requests are registered.
+func (tcn *TxConfNotifier) RegisterBatch(ntfns []*ConfNtfn) error {
+	select {
+	case <-tcn.quit:
+		return ErrTxConfNotifierExiting
+	default:
+	}
+
+	tcn.Lock()
+	defer tcn.Unlock()
+
+	for _, ntfn := range ntfns {
+		tcn.register(ntfn)
+	}
+
+	return nil
+}
+
+// register adds the notification request to the index of watched
+// transactions.
+//
+// NOTE: This method must be called with the TxConfNotifier's lock held.
+func (tcn *TxConfNotifier) register(ntfn *ConfNtfn) {
 	ntfns, ok := tcn.confNotifications[*ntfn.TxID]
 	if !ok {
 		ntfns = make(map[uint64]*ConfNtfn)
@@ -151,8 +181,6 @@ func (tcn *TxConfNotifier) Register(ntfn *ConfNtfn) error {
 	}
 
 	ntfns[ntfn.ConfID] = ntfn
-
-	return nil
 }
 
 // UpdateConfDetails attempts to update the confirmation details for an active
@@ -489,6 +517,16 @@ func (tcn *TxConfNotifier) DisconnectTip(blockHeight uint32) error {
 
 						ntfn.dispatched = false
 
+						// Likewise, we'll drain a negative
+						// confirmation notification the client
+						// hasn't received yet from a prior
+						// re-org, as the current one supersedes
+						// it.
+						select {
+						case <-ntfn.Event.NegativeConf:
+						default:
+						}
+
 						// Send a negative confirmation notification to the
 						// client indicating how many blocks have been
 						// disconnected successively.
@@ -3,6 +3,7 @@ package chainntnfs_test
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -935,3 +936,135 @@ func assertEqualTxConf(t *testing.T,
 			"expected %d, got %d", expectedConf.TxIndex, actualConf.TxIndex)
 	}
 }
+
+// TestTxConfRepeatedReorg tests that the TxConfNotifier doesn't stall when a
+// transaction is re-org'd out of the chain more than once, and the client
+// hasn't received the negative confirmation of the first re-org.
+func TestTxConfRepeatedReorg(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.MsgTx{Version: 1}
+	hintCache := newMockHintCache()
+	txConfNotifier := chainntnfs.NewTxConfNotifier(7, 100, hintCache)
+
+	txHash := tx.TxHash()
+	ntfn := chainntnfs.ConfNtfn{
+		TxID:             &txHash,
+		NumConfirmations: 1,
+		Event:            chainntnfs.NewConfirmationEvent(1),
+	}
+	if err := txConfNotifier.Register(&ntfn); err != nil {
+		t.Fatalf("unable to register ntfn: %v", err)
+	}
+
+	// Confirm the transaction and re-org it out of the chain twice,
+	// without receiving any of the notifications.
+	block := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{&tx},
+	})
+	reorgDone := make(chan error, 1)
+	go func() {
+		for i := 0; i < 2; i++ {
+			err := txConfNotifier.ConnectTip(
+				block.Hash(), 8, block.Transactions(),
+			)
+			if err != nil {
+				reorgDone <- err
+				return
+			}
+
+			if err := txConfNotifier.DisconnectTip(8); err != nil {
+				reorgDone <- err
+				return
+			}
+		}
+
+		reorgDone <- nil
+	}()
+
+	select {
+	case err := <-reorgDone:
+		if err != nil {
+			t.Fatalf("unable to reorg chain: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("notifier stalled on repeated reorg")
+	}
+
+	// Only the latest re-org should be pending delivery, while the
+	// confirmation should've been withdrawn.
+	select {
+	case reorgDepth := <-ntfn.Event.NegativeConf:
+		if reorgDepth != 1 {
+			t.Fatalf("expected reorg depth of 1, got %v",
+				reorgDepth)
+		}
+	default:
+		t.Fatalf("expected negative confirmation")
+	}
+	select {
+	case <-ntfn.Event.NegativeConf:
+		t.Fatalf("received unexpected negative confirmation")
+	case <-ntfn.Event.Confirmed:
+		t.Fatalf("received unexpected confirmation")
+	default:
+	}
+
+	txConfNotifier.TearDown()
+}
+
+// TestTxConfRegisterBatch tests that a batch of notification requests is
+// registered as a whole, and that none of them are registered once the
+// TxConfNotifier is exiting.
+func TestTxConfRegisterBatch(t *testing.T) {
+	t.Parallel()
+
+	hintCache := newMockHintCache()
+	txConfNotifier := chainntnfs.NewTxConfNotifier(10, 100, hintCache)
+
+	newBatch := func(versions ...int32) []*chainntnfs.ConfNtfn {
+		var ntfns []*chainntnfs.ConfNtfn
+		for i, version := range versions {
+			txid := (&wire.MsgTx{Version: version}).TxHash()
+			ntfns = append(ntfns, &chainntnfs.ConfNtfn{
+				ConfID:           uint64(i),
+				TxID:             &txid,
+				NumConfirmations: 1,
+				Event:            chainntnfs.NewConfirmationEvent(1),
+			})
+		}
+		return ntfns
+	}
+
+	// Each of the registered transactions should be watched from the
+	// notifier's current height.
+	batch := newBatch(1, 2)
+	if err := txConfNotifier.RegisterBatch(batch); err != nil {
+		t.Fatalf("unable to register batch: %v", err)
+	}
+	for _, ntfn := range batch {
+		hint, err := hintCache.QueryConfirmHint(*ntfn.TxID)
+		if err != nil {
+			t.Fatalf("unable to query hint: %v", err)
+		}
+		if hint != 10 {
+			t.Fatalf("expected hint 10, got %v", hint)
+		}
+	}
+
+	// Once the notifier is exiting, a batch should be rejected without
+	// registering any of its requests.
+	txConfNotifier.TearDown()
+
+	batch = newBatch(3, 4)
+	err := txConfNotifier.RegisterBatch(batch)
+	if err != chainntnfs.ErrTxConfNotifierExiting {
+		t.Fatalf("expected ErrTxConfNotifierExiting, got %v", err)
+	}
+	for _, ntfn := range batch {
+		_, err := hintCache.QueryConfirmHint(*ntfn.TxID)
+		if err != chainntnfs.ErrConfirmHintNotFound {
+			t.Fatalf("expected ErrConfirmHintNotFound, got %v", err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -24,6 +25,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs/btcdnotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/neutrinonotify"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/heighthint"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -52,6 +54,24 @@ const (
 	// expressed in sat/kw.
 	defaultLitecoinStaticFeePerKW = lnwallet.SatPerKWeight(50000)
 
+	// webAPIFeeTimeout is the timeout of requests to the fee API used by
+	// neutrino nodes.
+	webAPIFeeTimeout = 10 * time.Second
+
+	// webAPIFeeCacheTimeout is the duration fee rates returned by the fee
+	// API are cached for.
+	webAPIFeeCacheTimeout = 10 * time.Minute
+
+	// webAPIFeeMaxCacheAge is the longest fee rates returned by the fee
+	// API are used for while it's unavailable, before falling back to the
+	// static fee rate.
+	webAPIFeeMaxCacheAge = time.Hour
+
+	// webAPIMaxFeePerKW is the fee rate of 250 sat/vbyte expressed in
+	// sat/kw. Any higher fee rate returned by the fee API is deemed
+	// faulty, and capped at this value.
+	webAPIMaxFeePerKW = lnwallet.SatPerKWeight(62500)
+
 	// btcToLtcConversionRate is a fixed ratio used in order to scale up
 	// payments when running on the Litecoin chain.
 	btcToLtcConversionRate = 60
@@ -113,6 +133,11 @@ type chainControl struct {
 
 	chainView chainview.FilteredChainView
 
+	// hintCache persists the best-known height hints of the transactions
+	// watched by the subsystems resolving closed channels, such that they
+	// can resume watching after a restart without rescanning.
+	hintCache *heighthint.Cache
+
 	wallet *lnwallet.LightningWallet
 
 	routingPolicy htlcswitch.ForwardingPolicy
@@ -188,6 +213,17 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			"cache: %v", err)
 	}
 
+	// The notifiers commit the height they've scanned up to as the hint of
+	// each transaction they watch, even while their historical rescan is
+	// still underway, so their cache remains disabled above. The
+	// subsystems resolving closed channels instead share a cache of the
+	// hints they know to be safe.
+	cc.hintCache, err = heighthint.New(chanDB)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to initialize height hint "+
+			"cache: %v", err)
+	}
+
 	// If spv mode is active, then we'll be using a distinct set of
 	// chainControl interfaces that interface directly with the p2p network
 	// of the selected chain.
@@ -273,6 +309,36 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			svc.Stop()
 			nodeDatabase.Close()
 		}
+
+		// As neutrino can't provide us with fee estimates, we'll use
+		// an external fee API if one was configured, falling back to
+		// the static fee rate whenever it's unavailable.
+		if cfg.NeutrinoMode.FeeURL != "" {
+			ltndLog.Infof("Initializing web API fee estimator using "+
+				"%v", cfg.NeutrinoMode.FeeURL)
+
+			cc.feeEstimator, err = lnwallet.NewWebAPIFeeEstimator(
+				lnwallet.WebAPIConfig{
+					URL: cfg.NeutrinoMode.FeeURL,
+					Client: &http.Client{
+						Transport: &http.Transport{
+							Dial: cfg.net.Dial,
+						},
+						Timeout: webAPIFeeTimeout,
+					},
+					CacheTimeout: webAPIFeeCacheTimeout,
+					MaxCacheAge:  webAPIFeeMaxCacheAge,
+					MaxFeePerKW:  webAPIMaxFeePerKW,
+					Fallback:     cc.feeEstimator,
+				},
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := cc.feeEstimator.Start(); err != nil {
+				return nil, nil, err
+			}
+		}
 	case "bitcoind", "litecoind":
 		var bitcoindMode *bitcoindConfig
 		switch {
@@ -356,9 +422,16 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// use live fee estimates, rather than a statically
 			// coded value.
 			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
-			cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
-				*rpcConfig, fallBackFeeRate.FeePerKWeight(),
-			)
+			if bitcoindMode.MempoolFees {
+				cc.feeEstimator, err = lnwallet.NewMempoolFeeEstimator(
+					*rpcConfig, fallBackFeeRate.FeePerKWeight(),
+				)
+			} else {
+				cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
+					*rpcConfig, bitcoindMode.EstimateMode,
+					fallBackFeeRate.FeePerKWeight(),
+				)
+			}
 			if err != nil {
 				return nil, nil, err
 			}
@@ -373,9 +446,16 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// use live fee estimates, rather than a statically
 			// coded value.
 			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
-			cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
-				*rpcConfig, fallBackFeeRate.FeePerKWeight(),
-			)
+			if bitcoindMode.MempoolFees {
+				cc.feeEstimator, err = lnwallet.NewMempoolFeeEstimator(
+					*rpcConfig, fallBackFeeRate.FeePerKWeight(),
+				)
+			} else {
+				cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
+					*rpcConfig, bitcoindMode.EstimateMode,
+					fallBackFeeRate.FeePerKWeight(),
+				)
+			}
 			if err != nil {
 				return nil, nil, err
 			}
@@ -1645,6 +1645,79 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var exportRecoveryPackCommand = cli.Command{
+	Name:      "exportrecoverypack",
+	Category:  "Channels",
+	Usage:     "Export unsigned sweeps of all pending outputs of closed channels.",
+	ArgsUsage: "addr",
+	Description: `
+	Export an unsigned sweep of every output of a closed channel that the
+	node has yet to sweep, paying to the BASE58 encoded bitcoin address addr.
+
+	Each sweep is encoded as a PSBT, along with the key derivation, witness
+	script, timelocks and any preimage or second-level transaction needed to
+	sign and broadcast it using external tools. This allows funds to be
+	recovered manually should the node be abandoned.
+
+	Fees used when crafting the sweeps can be specified via the
+	--conf_target, or --sat_per_byte optional flags.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the BASE58 encoded bitcoin address to sweep to",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"sweeps *should* confirm in, will be used " +
+				"for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the sweeps",
+		},
+	},
+	Action: actionDecorator(exportRecoveryPack),
+}
+
+func exportRecoveryPack(ctx *cli.Context) error {
+	var addr string
+	switch {
+	case ctx.IsSet("addr"):
+		addr = ctx.String("addr")
+	case ctx.Args().Present():
+		addr = ctx.Args().First()
+	default:
+		return fmt.Errorf("Address argument missing")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.RecoveryPackRequest{
+		Addr:       addr,
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+	resp, err := client.ExportRecoveryPack(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var sendPaymentCommand = cli.Command{
 	Name:     "sendpayment",
 	Category: "Payments",
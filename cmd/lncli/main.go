@@ -277,6 +277,7 @@ func main() {
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
+		exportRecoveryPackCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
@@ -23,6 +23,7 @@ import (
 	flags "github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/tor"
@@ -61,10 +62,21 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	// defaultBitcoindEstimateMode is the fee estimate mode used when
+	// querying bitcoind or litecoind for fee estimates.
+	defaultBitcoindEstimateMode = lnwallet.EstimateModeConservative
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
 
+	// minIncomingBroadcastDelta is the minimum number of blocks before an
+	// incoming HTLC we know the preimage for expires that we'll allow
+	// going on-chain to claim it. Any later than we go on-chain for our
+	// outgoing HTLCs, and the commitment and HTLC success transactions may
+	// not confirm before the remote party can claim the HTLC back.
+	minIncomingBroadcastDelta = defaultBroadcastDelta
+
 	defaultAlias = ""
 	defaultColor = "#3399FF"
 )
@@ -117,6 +129,7 @@ type neutrinoConfig struct {
 	MaxPeers     int           `long:"maxpeers" description:"Max number of inbound and outbound peers"`
 	BanDuration  time.Duration `long:"banduration" description:"How long to ban misbehaving peers.  Valid time units are {s, m, h}.  Minimum 1 second"`
 	BanThreshold uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
+	FeeURL       string        `long:"feeurl" description:"Optional HTTPS URL of a fee estimation API. If not set, static fee rates are used, as neutrino has no local source of fee estimates."`
 }
 
 type btcdConfig struct {
@@ -135,6 +148,8 @@ type bitcoindConfig struct {
 	RPCPass        string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	ZMQPubRawBlock string `long:"zmqpubrawblock" description:"The address listening for ZMQ connections to deliver raw block notifications"`
 	ZMQPubRawTx    string `long:"zmqpubrawtx" description:"The address listening for ZMQ connections to deliver raw transaction notifications"`
+	EstimateMode   string `long:"estimatemode" description:"The fee estimate mode used when querying the daemon for fee estimates. Must be either ECONOMICAL or CONSERVATIVE."`
+	MempoolFees    bool   `long:"mempoolfees" description:"Estimate fees from the fee rates of the transactions in the daemon's mempool, rather than the fee rates of past blocks."`
 }
 
 type autoPilotConfig struct {
@@ -232,6 +247,21 @@ type config struct {
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
 
+	MinChainHtlc int64 `long:"minchainhtlc" description:"The smallest HTLC value (in satoshis) that we'll force close a channel to claim on-chain. Smaller HTLCs are still resolved if the channel is closed on-chain by other means"`
+
+	MaxHtlcSweepFee      int64  `long:"maxhtlcsweepfee" description:"The largest fee (in satoshis) we'll pay to sweep a single HTLC output on-chain. Sweeps that would exceed it are retried each block"`
+	MaxHtlcResolveBlocks uint32 `long:"maxhtlcresolveblocks" description:"The number of blocks after each HTLC of a channel closed on-chain becomes spendable by us that we'll keep trying to resolve it, after which it's abandoned"`
+
+	SweepConfTarget uint32 `long:"sweepconftarget" description:"The number of blocks within which we'll target the sweeps of outputs of channels closed on-chain to confirm. If unset, each sweeping subsystem uses its own default"`
+	SweepFeeRate    int64  `long:"sweepfeerate" description:"If set, the fee rate (in sat/vbyte) we'll pay to sweep outputs of channels closed on-chain, overriding sweepconftarget"`
+
+	MaxSweepFeeRate int64 `long:"maxsweepfeerate" description:"The highest fee rate (in sat/vbyte) we'll pay to sweep outputs on-chain. Higher fee estimates are capped at this rate, and sweeps are refused while the network's minimum relay fee rate exceeds it"`
+
+	SweepViaNursery bool     `long:"sweepvianursery" description:"If set, all outputs of channels closed on-chain are swept by the utxo nursery, rather than some being swept directly by the contract court"`
+	NurseryChan     []string `long:"nurserychan" description:"Sweep the outputs of the channel with the given channel point (txid:index) using the utxo nursery only, as if sweepvianursery was set for it. Can be specified multiple times"`
+
+	IncomingBroadcastDelta uint32 `long:"incomingbroadcastdelta" description:"The number of blocks before an incoming HTLC we know the preimage for expires that we'll force close the channel to claim it on-chain. A larger value is safer, a smaller one avoids closing channels whose HTLCs may still be settled off-chain. Defaults to twice the outgoing broadcast delta, and must be at least the outgoing broadcast delta if set"`
+
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
 	net tor.Net
@@ -271,8 +301,9 @@ func loadConfig() (*config, error) {
 			RPCCert: defaultBtcdRPCCertFile,
 		},
 		BitcoindMode: &bitcoindConfig{
-			Dir:     defaultBitcoindDir,
-			RPCHost: defaultRPCHost,
+			Dir:          defaultBitcoindDir,
+			RPCHost:      defaultRPCHost,
+			EstimateMode: defaultBitcoindEstimateMode,
 		},
 		Litecoin: &chainConfig{
 			MinHTLC:       defaultLitecoinMinHTLCMSat,
@@ -287,8 +318,9 @@ func loadConfig() (*config, error) {
 			RPCCert: defaultLtcdRPCCertFile,
 		},
 		LitecoindMode: &bitcoindConfig{
-			Dir:     defaultLitecoindDir,
-			RPCHost: defaultRPCHost,
+			Dir:          defaultLitecoindDir,
+			RPCHost:      defaultRPCHost,
+			EstimateMode: defaultBitcoindEstimateMode,
 		},
 		MaxPendingChannels: defaultMaxPendingChannels,
 		NoSeedBackup:       defaultNoSeedBackup,
@@ -771,6 +803,17 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Ensure that we'll go on-chain early enough to claim incoming HTLCs
+	// we know the preimage for. A zero delta selects the default.
+	if cfg.IncomingBroadcastDelta != 0 &&
+		cfg.IncomingBroadcastDelta < minIncomingBroadcastDelta {
+
+		str := "%s: incomingbroadcastdelta must be at least %v"
+		err := fmt.Errorf(str, funcName, minIncomingBroadcastDelta)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Validate profile port number.
 	if cfg.Profile != "" {
 		profilePort, err := strconv.Atoi(cfg.Profile)
@@ -67,6 +67,10 @@ type ArbitratorLog interface {
 	// resolved, it produces another contract that needs to be resolved.
 	SwapContract(old ContractResolver, new ContractResolver) error
 
+	// FetchResolvedContracts returns the final state of all contracts
+	// that have been marked as fully resolved within the log.
+	FetchResolvedContracts() ([]ContractResolver, error)
+
 	// ResolveContract marks a contract as fully resolved. Once a contract
 	// has been fully resolved, it is deleted from persistent storage.
 	ResolveContract(ContractResolver) error
@@ -96,6 +100,16 @@ type ArbitratorLog interface {
 	// fully resolved, and the channel closure if finalized. This method
 	// will delete all on-disk state within the persistent log.
 	WipeHistory() error
+
+	// LogEvent appends an event to the append-only arbitration event log
+	// of the channel. Unlike the rest of the log, the events are retained
+	// after WipeHistory, so the resolution of a channel can be inspected
+	// after the fact.
+	LogEvent(*ArbitratorEvent) error
+
+	// FetchEvents returns all events that have been logged for the
+	// channel, in the order they were logged.
+	FetchEvents() ([]*ArbitratorEvent, error)
 }
 
 // ArbitratorState is an enum that details the current state of the
@@ -196,6 +210,31 @@ const (
 	resolverUnilateralSweep = 4
 )
 
+const (
+	// resolverRecordMarker is the leading byte of every versioned resolver
+	// record. Records written before versioning was introduced begin
+	// directly with their resolverType, so as this value is larger than any
+	// resolverType, it allows us to tell the two formats apart.
+	resolverRecordMarker = 0xff
+
+	// resolverRecordVersion is the current version of the on-disk resolver
+	// record. Legacy, un-versioned records are treated as version 0. This
+	// MUST be bumped each time the encoding of any resolver changes.
+	//
+	// Version 2 records carry the resolver's policy between the header
+	// and the resolver body. The bodies of success and commit sweep
+	// resolvers additionally carry the fee they paid to sweep their
+	// output, those of success resolvers the expiry of the HTLC, and those
+	// of commit sweep resolvers whether the output was handed to the
+	// nursery.
+	resolverRecordVersion = 2
+
+	// legacyResolverRecordVersion is the last resolver record version
+	// whose records consist of only the type of the resolver and its
+	// body.
+	legacyResolverRecordVersion = 1
+)
+
 // resolverIDLen is the size of the resolver ID key. This is 36 bytes as we get
 // 32 bytes from the hash of the prev tx, and 4 bytes for the output index.
 const resolverIDLen = 36
@@ -231,11 +270,7 @@ func newLogScope(chain chainhash.Hash, op wire.OutPoint) (*logScope, error) {
 	if _, err := b.Write(chain[:]); err != nil {
 		return nil, err
 	}
-	if _, err := b.Write(op.Hash[:]); err != nil {
-		return nil, err
-	}
-
-	if err := binary.Write(b, endian, op.Index); err != nil {
+	if err := lnwallet.WriteOutPoint(b, &op); err != nil {
 		return nil, err
 	}
 
@@ -258,6 +293,11 @@ var (
 	// actionsBucketKey is the key under the logScope that we'll use to
 	// store all chain actions once they're determined.
 	actionsBucketKey = []byte("chain-actions")
+
+	// resolvedContractsBucketKey is the bucket within the logScope that
+	// stores the final state of each contract once it has been resolved,
+	// such that it can still be reported on.
+	resolvedContractsBucketKey = []byte("resolved-contracts")
 )
 
 var (
@@ -276,6 +316,11 @@ var (
 	// errNoActions is retuned when the log doesn't contain any stored
 	// chain actions.
 	errNoActions = fmt.Errorf("no chain actions exist")
+
+	// ErrUnknownResolverVersion is returned when we attempt to decode a
+	// resolver record written by a newer version of the software than we
+	// know how to read.
+	ErrUnknownResolverVersion = fmt.Errorf("unknown resolver record version")
 )
 
 // boltArbitratorLog is an implementation of the ArbitratorLog interface backed
@@ -344,8 +389,9 @@ func fetchContractWriteBucket(tx *bolt.Tx, scopeKey []byte) (*bolt.Bucket, error
 func (b *boltArbitratorLog) writeResolver(contractBucket *bolt.Bucket,
 	res ContractResolver) error {
 
-	// First, we'll write to the buffer the type of this resolver. Using
-	// this byte, we can later properly deserialize the resolver properly.
+	// First, we'll write out the record marker and version, followed by the
+	// type of this resolver. Using these bytes, we can later properly
+	// deserialize the resolver, even if its encoding has since changed.
 	var (
 		buf   bytes.Buffer
 		rType uint8
@@ -362,7 +408,17 @@ func (b *boltArbitratorLog) writeResolver(contractBucket *bolt.Bucket,
 	case *commitSweepResolver:
 		rType = resolverUnilateralSweep
 	}
-	if _, err := buf.Write([]byte{byte(rType)}); err != nil {
+	header := []byte{resolverRecordMarker, resolverRecordVersion, rType}
+	if _, err := buf.Write(header); err != nil {
+		return err
+	}
+
+	// Next, we'll write out the policy of the resolver, if it has one.
+	var policy resolverPolicy
+	if policyRes, ok := res.(policyContractResolver); ok {
+		policy = *policyRes.resolverPolicy()
+	}
+	if err := encodeResolverPolicy(&buf, &policy); err != nil {
 		return err
 	}
 
@@ -377,6 +433,114 @@ func (b *boltArbitratorLog) writeResolver(contractBucket *bolt.Bucket,
 	return contractBucket.Put(resKey, buf.Bytes())
 }
 
+// parseResolverHeader splits a raw resolver record into its version, type,
+// and encoded resolver body. Legacy records that predate versioning are
+// reported as version 0.
+func parseResolverHeader(resBytes []byte) (uint8, uint8, []byte, error) {
+	if len(resBytes) == 0 {
+		return 0, 0, nil, fmt.Errorf("empty resolver record")
+	}
+
+	// If the record doesn't start with our marker, then this is a legacy
+	// record that consists of only the type byte followed by the body.
+	if resBytes[0] != resolverRecordMarker {
+		return 0, resBytes[0], resBytes[1:], nil
+	}
+
+	if len(resBytes) < 3 {
+		return 0, 0, nil, fmt.Errorf("resolver record truncated")
+	}
+
+	version := resBytes[1]
+	if version > resolverRecordVersion {
+		return 0, 0, nil, ErrUnknownResolverVersion
+	}
+
+	return version, resBytes[2], resBytes[3:], nil
+}
+
+// versionedContractResolver is a ContractResolver whose body encoding differs
+// between resolver record versions.
+type versionedContractResolver interface {
+	ContractResolver
+
+	// decodeVersion decodes the resolver from the body of a resolver
+	// record of the passed version.
+	decodeVersion(r io.Reader, version uint8) error
+}
+
+// A compile time assertion to ensure each resolver whose encoding changed
+// meets the versionedContractResolver interface.
+var (
+	_ versionedContractResolver = (*htlcSuccessResolver)(nil)
+	_ versionedContractResolver = (*htlcIncomingContestResolver)(nil)
+	_ versionedContractResolver = (*commitSweepResolver)(nil)
+)
+
+// decodeResolver decodes a raw resolver record, as written by writeResolver,
+// into the concrete ContractResolver it represents. The returned resolver
+// still needs to have its ResolverKit attached.
+func decodeResolver(resBytes []byte) (ContractResolver, error) {
+	version, resType, body, err := parseResolverHeader(resBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Records newer than legacyResolverRecordVersion carry the policy of
+	// the resolver ahead of its body. Older records have no policy, so the
+	// resolver isn't bound by any limits.
+	var policy resolverPolicy
+	r := bytes.NewReader(body)
+	if version > legacyResolverRecordVersion {
+		if err := decodeResolverPolicy(r, &policy); err != nil {
+			return nil, err
+		}
+	}
+
+	var res ContractResolver
+	switch resType {
+	case resolverTimeout:
+		res = &htlcTimeoutResolver{}
+
+	case resolverSuccess:
+		res = &htlcSuccessResolver{}
+
+	case resolverOutgoingContest:
+		res = &htlcOutgoingContestResolver{
+			htlcTimeoutResolver: htlcTimeoutResolver{},
+		}
+
+	case resolverIncomingContest:
+		res = &htlcIncomingContestResolver{
+			htlcSuccessResolver: htlcSuccessResolver{},
+		}
+
+	case resolverUnilateralSweep:
+		res = &commitSweepResolver{}
+
+	default:
+		return nil, fmt.Errorf("unknown resolver type: %v", resType)
+	}
+
+	// Resolvers whose body encoding changed since the legacy records are
+	// decoded according to the version of the record, while the others
+	// share the same encoding across all versions we know of.
+	if versionedRes, ok := res.(versionedContractResolver); ok {
+		err = versionedRes.decodeVersion(r, version)
+	} else {
+		err = res.Decode(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if policyRes, ok := res.(policyContractResolver); ok {
+		*policyRes.resolverPolicy() = policy
+	}
+
+	return res, nil
+}
+
 // CurrentState returns the current state of the ChannelArbitrator.
 //
 // NOTE: Part of the ContractResolver interface.
@@ -438,65 +602,9 @@ func (b *boltArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 				return nil
 			}
 
-			var res ContractResolver
-
-			// We'll snip off the first byte of the raw resolver
-			// bytes in order to extract what type of resolver
-			// we're about to encode.
-			resType := resBytes[0]
-
-			// Then we'll create a reader using the remaining
-			// bytes.
-			resReader := bytes.NewReader(resBytes[1:])
-
-			switch resType {
-			case resolverTimeout:
-				timeoutRes := &htlcTimeoutResolver{}
-				if err := timeoutRes.Decode(resReader); err != nil {
-					return err
-				}
-				timeoutRes.AttachResolverKit(resKit)
-
-				res = timeoutRes
-
-			case resolverSuccess:
-				successRes := &htlcSuccessResolver{}
-				if err := successRes.Decode(resReader); err != nil {
-					return err
-				}
-
-				res = successRes
-
-			case resolverOutgoingContest:
-				outContestRes := &htlcOutgoingContestResolver{
-					htlcTimeoutResolver: htlcTimeoutResolver{},
-				}
-				if err := outContestRes.Decode(resReader); err != nil {
-					return err
-				}
-
-				res = outContestRes
-
-			case resolverIncomingContest:
-				inContestRes := &htlcIncomingContestResolver{
-					htlcSuccessResolver: htlcSuccessResolver{},
-				}
-				if err := inContestRes.Decode(resReader); err != nil {
-					return err
-				}
-
-				res = inContestRes
-
-			case resolverUnilateralSweep:
-				sweepRes := &commitSweepResolver{}
-				if err := sweepRes.Decode(resReader); err != nil {
-					return err
-				}
-
-				res = sweepRes
-
-			default:
-				return fmt.Errorf("unknown resolver type: %v", resType)
+			res, err := decodeResolver(resBytes)
+			if err != nil {
+				return err
 			}
 
 			resKit.Quit = make(chan struct{})
@@ -557,7 +665,8 @@ func (b *boltArbitratorLog) SwapContract(oldContract, newContract ContractResolv
 }
 
 // ResolveContract marks a contract as fully resolved. Once a contract has been
-// fully resolved, it is deleted from persistent storage.
+// fully resolved, it is deleted from the set of unresolved contracts, and its
+// final state is moved to the set of resolved contracts.
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) ResolveContract(res ContractResolver) error {
@@ -568,10 +677,60 @@ func (b *boltArbitratorLog) ResolveContract(res ContractResolver) error {
 		}
 
 		resKey := res.ResolverKey()
-		return contractBucket.Delete(resKey)
+		if err := contractBucket.Delete(resKey); err != nil {
+			return err
+		}
+
+		scopeBucket := tx.Bucket(b.scopeKey[:])
+		resolvedBucket, err := scopeBucket.CreateBucketIfNotExists(
+			resolvedContractsBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return b.writeResolver(resolvedBucket, res)
 	})
 }
 
+// FetchResolvedContracts returns the final state of all contracts that have
+// been marked as fully resolved within the log.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) FetchResolvedContracts() ([]ContractResolver, error) {
+	var contracts []ContractResolver
+	err := b.db.View(func(tx *bolt.Tx) error {
+		scopeBucket := tx.Bucket(b.scopeKey[:])
+		if scopeBucket == nil {
+			return errScopeBucketNoExist
+		}
+
+		resolvedBucket := scopeBucket.Bucket(resolvedContractsBucketKey)
+		if resolvedBucket == nil {
+			return errNoContracts
+		}
+
+		return resolvedBucket.ForEach(func(resKey, resBytes []byte) error {
+			if len(resKey) != resolverIDLen {
+				return nil
+			}
+
+			res, err := decodeResolver(resBytes)
+			if err != nil {
+				return err
+			}
+
+			contracts = append(contracts, res)
+			return nil
+		})
+	})
+	if err != nil && err != errScopeBucketNoExist && err != errNoContracts {
+		return nil, err
+	}
+
+	return contracts, nil
+}
+
 // LogContractResolutions stores a set of chain actions which are derived from
 // our set of active contracts, and the on-chain state. We'll write this et of
 // cations when: we decide to go on-chain to resolve a contract, or we detect
@@ -838,6 +997,14 @@ func (b *boltArbitratorLog) WipeHistory() error {
 			return err
 		}
 
+		// The final state of the resolved contracts can go as well.
+		if scopeBucket.Bucket(resolvedContractsBucketKey) != nil {
+			err := scopeBucket.DeleteBucket(resolvedContractsBucketKey)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Next, we'll delete storage of any lingering contract
 		// resolutions.
 		if err := scopeBucket.Delete(resolutionsKey); err != nil {
@@ -902,10 +1069,7 @@ func encodeIncomingResolution(w io.Writer, i *lnwallet.IncomingHtlcResolution) e
 	if err := binary.Write(w, endian, i.CsvDelay); err != nil {
 		return err
 	}
-	if _, err := w.Write(i.ClaimOutpoint.Hash[:]); err != nil {
-		return err
-	}
-	if err := binary.Write(w, endian, i.ClaimOutpoint.Index); err != nil {
+	if err := lnwallet.WriteOutPoint(w, &i.ClaimOutpoint); err != nil {
 		return err
 	}
 	err := lnwallet.WriteSignDescriptor(w, &i.SweepSignDesc)
@@ -936,11 +1100,7 @@ func decodeIncomingResolution(r io.Reader, h *lnwallet.IncomingHtlcResolution) e
 	if err != nil {
 		return err
 	}
-	_, err = io.ReadFull(r, h.ClaimOutpoint.Hash[:])
-	if err != nil {
-		return err
-	}
-	err = binary.Read(r, endian, &h.ClaimOutpoint.Index)
+	err = lnwallet.ReadOutPoint(r, &h.ClaimOutpoint)
 	if err != nil {
 		return err
 	}
@@ -970,10 +1130,7 @@ func encodeOutgoingResolution(w io.Writer, o *lnwallet.OutgoingHtlcResolution) e
 	if err := binary.Write(w, endian, o.CsvDelay); err != nil {
 		return nil
 	}
-	if _, err := w.Write(o.ClaimOutpoint.Hash[:]); err != nil {
-		return err
-	}
-	if err := binary.Write(w, endian, o.ClaimOutpoint.Index); err != nil {
+	if err := lnwallet.WriteOutPoint(w, &o.ClaimOutpoint); err != nil {
 		return err
 	}
 
@@ -1001,11 +1158,7 @@ func decodeOutgoingResolution(r io.Reader, o *lnwallet.OutgoingHtlcResolution) e
 	if err != nil {
 		return err
 	}
-	_, err = io.ReadFull(r, o.ClaimOutpoint.Hash[:])
-	if err != nil {
-		return err
-	}
-	err = binary.Read(r, endian, &o.ClaimOutpoint.Index)
+	err = lnwallet.ReadOutPoint(r, &o.ClaimOutpoint)
 	if err != nil {
 		return err
 	}
@@ -1016,10 +1169,7 @@ func decodeOutgoingResolution(r io.Reader, o *lnwallet.OutgoingHtlcResolution) e
 func encodeCommitResolution(w io.Writer,
 	c *lnwallet.CommitOutputResolution) error {
 
-	if _, err := w.Write(c.SelfOutPoint.Hash[:]); err != nil {
-		return err
-	}
-	err := binary.Write(w, endian, c.SelfOutPoint.Index)
+	err := lnwallet.WriteOutPoint(w, &c.SelfOutPoint)
 	if err != nil {
 		return err
 	}
@@ -1035,11 +1185,7 @@ func encodeCommitResolution(w io.Writer,
 func decodeCommitResolution(r io.Reader,
 	c *lnwallet.CommitOutputResolution) error {
 
-	_, err := io.ReadFull(r, c.SelfOutPoint.Hash[:])
-	if err != nil {
-		return err
-	}
-	err = binary.Read(r, endian, &c.SelfOutPoint.Index)
+	err := lnwallet.ReadOutPoint(r, &c.SelfOutPoint)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,9 @@
 package contractcourt
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/binary"
 	"io/ioutil"
 	"os"
 	"reflect"
@@ -188,6 +190,14 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("expected %v, got %v", ogRes.payHash,
 				diskRes.payHash)
 		}
+		if ogRes.sweepFee != diskRes.sweepFee {
+			t.Fatalf("expected %v, got %v", ogRes.sweepFee,
+				diskRes.sweepFee)
+		}
+		if ogRes.expiry != diskRes.expiry {
+			t.Fatalf("expected %v, got %v", ogRes.expiry,
+				diskRes.expiry)
+		}
 	}
 
 	switch ogRes := originalResolver.(type) {
@@ -234,6 +244,14 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("expected %v, got %v", ogRes.chanPoint,
 				diskRes.chanPoint)
 		}
+		if ogRes.sweepFee != diskRes.sweepFee {
+			t.Fatalf("expected %v, got %v", ogRes.sweepFee,
+				diskRes.sweepFee)
+		}
+		if ogRes.outputIncubating != diskRes.outputIncubating {
+			t.Fatalf("expected %v, got %v",
+				ogRes.outputIncubating, diskRes.outputIncubating)
+		}
 	}
 }
 
@@ -280,6 +298,8 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		broadcastHeight:  109,
 		payHash:          testPreimage,
 		sweepTx:          nil,
+		sweepFee:         300,
+		expiry:           150,
 	}
 	resolvers := []ContractResolver{
 		&timeoutResolver,
@@ -290,10 +310,12 @@ func TestContractInsertionRetrieval(t *testing.T) {
 				SelfOutputSignDesc: testSignDesc,
 				MaturityDelay:      99,
 			},
-			resolved:        false,
-			broadcastHeight: 109,
-			chanPoint:       testChanPoint1,
-			sweepTx:         nil,
+			resolved:         false,
+			broadcastHeight:  109,
+			chanPoint:        testChanPoint1,
+			sweepTx:          nil,
+			sweepFee:         200,
+			outputIncubating: true,
 		},
 	}
 
@@ -422,6 +444,17 @@ func TestContractResolution(t *testing.T) {
 		t.Fatalf("no contract should be from in the db, instead %v "+
 			"were", len(dbContracts))
 	}
+
+	// Its final state should now be found among the resolved contracts.
+	dbContracts, err = testLog.FetchResolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch resolved contracts from db: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("expected 1 resolved contract, got %v",
+			len(dbContracts))
+	}
+	assertResolversEqual(t, timeoutResolver, dbContracts[0])
 }
 
 // TestContractSwapping ensures that callers are able to atomically swap to
@@ -486,6 +519,140 @@ func TestContractSwapping(t *testing.T) {
 	assertResolversEqual(t, &timeoutResolver, dbContracts[0])
 }
 
+// TestResolverRecordVersioning ensures that resolver records written before
+// versioning was introduced can still be decoded, and that records written by
+// a newer version of the format are rejected.
+func TestResolverRecordVersioning(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	boltLog := testLog.(*boltArbitratorLog)
+
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:          100,
+			SignedTimeoutTx: nil,
+			CsvDelay:        10,
+			ClaimOutpoint:   randOutPoint(),
+			SweepSignDesc:   testSignDesc,
+		},
+		outputIncubating: true,
+		resolved:         false,
+		broadcastHeight:  50,
+		htlcIndex:        3,
+	}
+
+	// putRecord writes the raw resolver record directly into the contracts
+	// bucket, bypassing writeResolver.
+	putRecord := func(header []byte) {
+		var buf bytes.Buffer
+		buf.Write(header)
+		if err := timeoutResolver.Encode(&buf); err != nil {
+			t.Fatalf("unable to encode resolver: %v", err)
+		}
+
+		err := boltLog.db.Update(func(tx *bolt.Tx) error {
+			contractBucket, err := fetchContractWriteBucket(
+				tx, boltLog.scopeKey[:],
+			)
+			if err != nil {
+				return err
+			}
+
+			return contractBucket.Put(
+				timeoutResolver.ResolverKey(), buf.Bytes(),
+			)
+		})
+		if err != nil {
+			t.Fatalf("unable to write record: %v", err)
+		}
+	}
+
+	// First, we'll write a legacy record, which consists of only the type
+	// byte followed by the resolver itself. We should be able to read it
+	// back without issue.
+	putRecord([]byte{resolverTimeout})
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch legacy contract: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("expected 1 contract, instead got %v",
+			len(dbContracts))
+	}
+	assertResolversEqual(t, timeoutResolver, dbContracts[0])
+
+	// Checkpointing the resolver should upgrade the record to the current
+	// version, which should also decode properly.
+	if err := testLog.InsertUnresolvedContracts(timeoutResolver); err != nil {
+		t.Fatalf("unable to insert contract: %v", err)
+	}
+	dbContracts, err = testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contract: %v", err)
+	}
+	assertResolversEqual(t, timeoutResolver, dbContracts[0])
+
+	// Finally, a record from a future version should be rejected rather
+	// than decoded incorrectly.
+	putRecord([]byte{
+		resolverRecordMarker, resolverRecordVersion + 1,
+		resolverTimeout,
+	})
+	_, err = testLog.FetchUnresolvedContracts()
+	if err != ErrUnknownResolverVersion {
+		t.Fatalf("expected ErrUnknownResolverVersion, got: %v", err)
+	}
+}
+
+// TestResolverRecordLegacyBody ensures that the body of a legacy success
+// resolver record, which lacks the sweep fee and expiry of the resolver, can
+// still be decoded.
+func TestResolverRecordLegacyBody(t *testing.T) {
+	t.Parallel()
+
+	resolver := &htlcSuccessResolver{
+		htlcResolution: lnwallet.IncomingHtlcResolution{
+			Preimage:      testPreimage,
+			CsvDelay:      900,
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+		outputIncubating: true,
+		broadcastHeight:  109,
+		payHash:          testPreimage,
+	}
+
+	// We'll write out the body the way it was written before the sweep
+	// fee and expiry were added.
+	var buf bytes.Buffer
+	buf.Write([]byte{
+		resolverRecordMarker, legacyResolverRecordVersion,
+		resolverSuccess,
+	})
+	err := encodeIncomingResolution(&buf, &resolver.htlcResolution)
+	if err != nil {
+		t.Fatalf("unable to encode resolution: %v", err)
+	}
+	binary.Write(&buf, endian, resolver.outputIncubating)
+	binary.Write(&buf, endian, resolver.resolved)
+	binary.Write(&buf, endian, resolver.broadcastHeight)
+	buf.Write(resolver.payHash[:])
+
+	legacyRes, err := decodeResolver(buf.Bytes())
+	if err != nil {
+		t.Fatalf("unable to decode legacy record: %v", err)
+	}
+	assertResolversEqual(t, resolver, legacyRes)
+}
+
 // TestContractResolutionsStorage tests that we're able to properly store and
 // retrieve contract resolutions written to disk.
 func TestContractResolutionsStorage(t *testing.T) {
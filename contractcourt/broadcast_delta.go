@@ -0,0 +1,52 @@
+package contractcourt
+
+import (
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// redeemBroadcastDelta returns the number of blocks before the expiry of an
+// incoming HTLC we know the preimage for that we'll go on-chain to claim it.
+// The per-channel override takes precedence over the configured value, which
+// in turn falls back to a multiple of the BroadcastDelta.
+func (c *ChannelArbitrator) redeemBroadcastDelta() uint32 {
+	if delta := atomic.LoadUint32(&c.incomingBroadcastDelta); delta != 0 {
+		return delta
+	}
+
+	if c.cfg.IncomingBroadcastDelta != 0 {
+		return c.cfg.IncomingBroadcastDelta
+	}
+
+	return c.cfg.BroadcastDelta * broadcastRedeemMultiplier
+}
+
+// SetIncomingBroadcastDelta overrides the number of blocks before the expiry
+// of an incoming HTLC that we'll go on-chain to claim it, for this channel
+// only. A delta of zero removes the override. The new value is used from the
+// next block onwards. The override is ephemeral: it isn't persisted in the
+// arbitrator log, so it's lost once the arbitrator is restarted.
+func (c *ChannelArbitrator) SetIncomingBroadcastDelta(delta uint32) {
+	atomic.StoreUint32(&c.incomingBroadcastDelta, delta)
+}
+
+// SetIncomingBroadcastDelta overrides the number of blocks before the expiry
+// of an incoming HTLC that we'll go on-chain to claim it, for the channel
+// identified by the passed channel point. A delta of zero removes the
+// override. The override isn't persisted, so it needs to be set again after a
+// restart. If the channel isn't being watched, then ErrArbitratorNotFound is
+// returned.
+func (c *ChainArbitrator) SetIncomingBroadcastDelta(chanPoint wire.OutPoint,
+	delta uint32) error {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return ErrArbitratorNotFound
+	}
+
+	arbitrator.SetIncomingBroadcastDelta(delta)
+	return nil
+}
@@ -0,0 +1,104 @@
+package contractcourt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestIncomingBroadcastDelta tests that we go on-chain to claim an incoming
+// HTLC we know the preimage for according to the configured broadcast delta,
+// and that a per-channel override takes precedence over it.
+func TestIncomingBroadcastDelta(t *testing.T) {
+	t.Parallel()
+
+	const expiry = 100
+
+	preimage := bytes.Repeat([]byte{0x01}, 32)
+	payHash := sha256.Sum256(preimage)
+	beacon := &mockWitnessBeacon{
+		preimages: map[[32]byte][]byte{
+			payHash: preimage,
+		},
+	}
+	incomingHtlc := channeldb.HTLC{
+		RHash:         payHash,
+		RefundTimeout: expiry,
+		Amt:           lnwire.NewMSatFromSatoshis(100000),
+		Incoming:      true,
+	}
+
+	testCases := []struct {
+		name        string
+		configDelta uint32
+		chanDelta   uint32
+		height      uint32
+		goOnChain   bool
+	}{
+		{
+			// Without any configured delta, we'll go on-chain
+			// twice the broadcast delta before expiry.
+			name:      "default delta, outside",
+			height:    expiry - 21,
+			goOnChain: false,
+		},
+		{
+			name:      "default delta, within",
+			height:    expiry - 20,
+			goOnChain: true,
+		},
+		{
+			// A smaller configured delta lets us wait longer.
+			name:        "config delta",
+			configDelta: 5,
+			height:      expiry - 20,
+			goOnChain:   false,
+		},
+		{
+			name:        "config delta, within",
+			configDelta: 5,
+			height:      expiry - 5,
+			goOnChain:   true,
+		},
+		{
+			// The channel override takes precedence over the
+			// configured delta.
+			name:        "channel override",
+			configDelta: 5,
+			chanDelta:   40,
+			height:      expiry - 40,
+			goOnChain:   true,
+		},
+	}
+
+	for _, test := range testCases {
+		chanArb, _, err := createTestChannelArbitrator(
+			&mockArbitratorLog{},
+		)
+		if err != nil {
+			t.Fatalf("unable to create ChannelArbitrator: %v", err)
+		}
+		chanArb.cfg.BroadcastDelta = 10
+		chanArb.cfg.IncomingBroadcastDelta = test.configDelta
+		chanArb.cfg.PreimageDB = beacon
+		chanArb.SetIncomingBroadcastDelta(test.chanDelta)
+		chanArb.activeHTLCs = newHtlcSet(
+			[]channeldb.HTLC{incomingHtlc},
+		)
+
+		actions := chanArb.checkChainActions(test.height, chainTrigger)
+		claims := len(actions[HtlcClaimAction])
+		switch {
+		case test.goOnChain && claims != 1:
+			t.Fatalf("%s: expected to go on-chain to claim htlc",
+				test.name)
+
+		case !test.goOnChain && len(actions) != 0:
+			t.Fatalf("%s: expected no actions, got %v", test.name,
+				actions)
+		}
+	}
+}
@@ -58,6 +58,14 @@ type ChainArbitratorConfig struct {
 	// transaction is already confirmed, by the time the HTLC expires.
 	BroadcastDelta uint32
 
+	// IncomingBroadcastDelta is the number of blocks before the expiry of
+	// an incoming HTLC we know the preimage for that we'll broadcast our
+	// commitment transaction to claim it on-chain. A larger value leaves
+	// more time to confirm the claim, at the cost of closing channels
+	// that may still have been settled off-chain. This can be overridden
+	// per channel. If unset, twice the BroadcastDelta is used.
+	IncomingBroadcastDelta uint32
+
 	// NewSweepAddr is a function that returns a new address under control
 	// by the wallet. We'll use this to sweep any no-delay outputs as a
 	// result of unilateral channel closes.
@@ -107,6 +115,13 @@ type ChainArbitratorConfig struct {
 		*lnwallet.OutgoingHtlcResolution,
 		*lnwallet.IncomingHtlcResolution) error
 
+	// RemoveIncubatingOutput stops the utxo nursery from incubating an
+	// output of the passed channel that was sent to it by IncubateOutputs,
+	// as the remote party has spent it. It's a no-op if the output isn't
+	// being incubated. This may be nil, in which case the nursery is left
+	// to find out for itself.
+	RemoveIncubatingOutput func(chanPoint, op wire.OutPoint) error
+
 	// PreimageDB is a global store of all known pre-images. We'll use this
 	// to decide if we should broadcast a commitment transaction to claim
 	// an HTLC on-chain.
@@ -124,12 +139,80 @@ type ChainArbitratorConfig struct {
 	// FeeEstimator will be used to return fee estimates.
 	FeeEstimator lnwallet.FeeEstimator
 
+	// SweepFeePreference is the fee preference used when sweeping
+	// resolved outputs back into the wallet. If unset, a default
+	// confirmation target is used.
+	SweepFeePreference lnwallet.FeePreference
+
+	// MaxSweepFeeRate, if non-zero, is the highest fee rate resolved
+	// outputs are swept with, overriding the MaxFeeRate of
+	// SweepFeePreference.
+	MaxSweepFeeRate lnwallet.SatPerKWeight
+
 	// ChainIO allows us to query the state of the current main chain.
 	ChainIO lnwallet.BlockChainIO
 
+	// ConfirmHints is the cache of best-known height hints for
+	// transactions watched by the subsystems resolving closed channels.
+	// Resolvers commit the height at which their claims were seen to
+	// confirm, such that they can resume watching for their confirmation
+	// after a restart without rescanning the chain from the height the
+	// contract was broadcast at. If nil, no hints are cached.
+	ConfirmHints chainntnfs.ConfirmHintCache
+
 	// DisableChannel disables a channel, resulting in it not being able to
 	// forward payments.
 	DisableChannel func(wire.OutPoint) error
+
+	// ResolutionStrategy decides how each output of a channel should be
+	// resolved on-chain. If nil, we'll go on-chain for, and sweep, every
+	// output as soon as needed.
+	ResolutionStrategy ResolutionStrategy
+
+	// ResolverPolicies bounds the fee paid and the time spent resolving
+	// each class of outputs. Classes without a policy are resolved
+	// without any limits.
+	ResolverPolicies map[ReportOutputType]ResolverPolicy
+
+	// ClaimConfDepth is the number of confirmations a transaction claiming
+	// an output needs before the output is considered resolved. Until
+	// then, the claim is rebroadcast should it be reorged out of the
+	// chain. If unset, defaultClaimConfDepth is used.
+	ClaimConfDepth uint32
+
+	// MaxActiveClaims is the number of claim transactions that resolvers
+	// across all channels may broadcast at once. Claims waiting their turn
+	// are broadcast in order of their deadline. If unset,
+	// defaultMaxActiveClaims is used.
+	MaxActiveClaims int
+
+	// SweepViaNursery, if true, forces resolvers to hand every output
+	// they'd otherwise sweep themselves to the utxo nursery, such that all
+	// outputs are swept through a single code path. Incoming HTLCs on the
+	// remote party's commitment are still swept by their resolver, as the
+	// nursery is unable to claim them using the preimage.
+	SweepViaNursery bool
+
+	// NurseryChannels is the set of channels whose outputs are handed to
+	// the utxo nursery, as if SweepViaNursery was set for them only.
+	NurseryChannels map[wire.OutPoint]struct{}
+
+	// ContractResolved, if set, is called each time the resolution of an
+	// output concludes, detailing its outcome. It's called from the
+	// goroutine driving the resolver, so it shouldn't block.
+	ContractResolved func(*ResolvedContract)
+
+	// Clock is the source of time for the arbitrators and their
+	// resolvers. If unset, the system clock is used.
+	Clock Clock
+
+	// sweeper batches the sweeps of outputs across all resolvers into as
+	// few transactions as possible. This is set by the ChainArbitrator.
+	sweeper *sweepBatcher
+
+	// scheduler orders and bounds the broadcast of claims across all
+	// resolvers. This is set by the ChainArbitrator.
+	scheduler *resolverScheduler
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -173,6 +256,25 @@ type ChainArbitrator struct {
 func NewChainArbitrator(cfg ChainArbitratorConfig,
 	db *channeldb.DB) *ChainArbitrator {
 
+	if cfg.SweepFeePreference == (lnwallet.FeePreference{}) {
+		cfg.SweepFeePreference = defaultSweepFeePreference
+	}
+	if cfg.MaxSweepFeeRate != 0 {
+		cfg.SweepFeePreference.MaxFeeRate = cfg.MaxSweepFeeRate
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = systemClock{}
+	}
+	cfg.sweeper = newSweepBatcher(
+		cfg.FeeEstimator, cfg.SweepFeePreference, cfg.NewSweepAddr,
+		defaultSweepBatchWindow, cfg.Clock,
+	)
+
+	if cfg.MaxActiveClaims == 0 {
+		cfg.MaxActiveClaims = defaultMaxActiveClaims
+	}
+	cfg.scheduler = newResolverScheduler(cfg.MaxActiveClaims)
+
 	return &ChainArbitrator{
 		cfg:            cfg,
 		activeChannels: make(map[wire.OutPoint]*ChannelArbitrator),
@@ -325,6 +427,12 @@ func (c *ChainArbitrator) Start() error {
 
 	log.Tracef("Starting ChainArbitrator")
 
+	// Before launching any resolvers, we'll start the sweep batcher so
+	// they're able to sweep their outputs.
+	if err := c.cfg.sweeper.Start(); err != nil {
+		return err
+	}
+
 	// First, we'll fetch all the channels that are still open, in order to
 	// collect them within our set of active contracts.
 	openChannels, err := c.chanSource.FetchAllChannels()
@@ -527,6 +635,10 @@ func (c *ChainArbitrator) Stop() error {
 		}
 	}
 
+	if err := c.cfg.sweeper.Stop(); err != nil {
+		log.Errorf("unable to stop sweep batcher: %v", err)
+	}
+
 	c.wg.Wait()
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -169,6 +170,10 @@ type ChannelArbitrator struct {
 	started int32 // To be used atomically.
 	stopped int32 // To be used atomically.
 
+	// incomingBroadcastDelta, if non-zero, overrides the
+	// IncomingBroadcastDelta of the config for this channel.
+	incomingBroadcastDelta uint32 // To be used atomically.
+
 	// log is a persistent log that the attendant will use to checkpoint
 	// its next action, and the state of any unresolved contracts.
 	log ArbitratorLog
@@ -194,6 +199,13 @@ type ChannelArbitrator struct {
 	// be able to signal them for shutdown in the case that we shutdown.
 	activeResolvers []ContractResolver
 
+	// abandonedResolvers is the set of resolvers that have been abandoned
+	// by the user, keyed by their resolver key.
+	abandonedResolvers map[resolverID]struct{}
+
+	// activeResolversLock guards activeResolvers and abandonedResolvers.
+	activeResolversLock sync.RWMutex
+
 	// resolutionSignal is a channel that will be sent upon by contract
 	// resolvers once their contract has been fully resolved. With each
 	// send, we'll check to see if the contract is fully resolved.
@@ -207,6 +219,12 @@ type ChannelArbitrator struct {
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// eventSubs is the set of active subscribers to the arbitration
+	// events of the channel, keyed by an ephemeral client ID.
+	eventSubs   map[uint64]*eventSubscriber
+	eventSubID  uint64
+	eventSubMtx sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -217,14 +235,16 @@ func NewChannelArbitrator(cfg ChannelArbitratorConfig,
 	startingHTLCs []channeldb.HTLC, log ArbitratorLog) *ChannelArbitrator {
 
 	return &ChannelArbitrator{
-		log:              log,
-		signalUpdates:    make(chan *signalUpdateMsg),
-		htlcUpdates:      make(<-chan []channeldb.HTLC),
-		resolutionSignal: make(chan struct{}),
-		forceCloseReqs:   make(chan *forceCloseReq),
-		activeHTLCs:      newHtlcSet(startingHTLCs),
-		cfg:              cfg,
-		quit:             make(chan struct{}),
+		log:                log,
+		signalUpdates:      make(chan *signalUpdateMsg),
+		htlcUpdates:        make(<-chan []channeldb.HTLC),
+		resolutionSignal:   make(chan struct{}),
+		forceCloseReqs:     make(chan *forceCloseReq),
+		activeHTLCs:        newHtlcSet(startingHTLCs),
+		cfg:                cfg,
+		eventSubs:          make(map[uint64]*eventSubscriber),
+		abandonedResolvers: make(map[resolverID]struct{}),
+		quit:               make(chan struct{}),
 	}
 }
 
@@ -336,8 +356,19 @@ func (c *ChannelArbitrator) Start() error {
 		log.Infof("ChannelArbitrator(%v): relaunching %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(unresolvedContracts))
 
+		// The resolvers with the most pressing deadlines are launched
+		// first.
+		sortResolversByDeadline(unresolvedContracts)
+
+		c.activeResolversLock.Lock()
 		c.activeResolvers = unresolvedContracts
+		c.activeResolversLock.Unlock()
 		for _, contract := range unresolvedContracts {
+			// The resolvers read from disk are handed a fresh
+			// kit, such that their actions are recorded just like
+			// those of newly created resolvers.
+			contract.AttachResolverKit(c.newResolverKit())
+
 			c.wg.Add(1)
 			go c.resolveContract(contract)
 		}
@@ -362,9 +393,11 @@ func (c *ChannelArbitrator) Stop() error {
 		go c.cfg.ChainEvents.Cancel()
 	}
 
+	c.activeResolversLock.RLock()
 	for _, activeResolver := range c.activeResolvers {
 		activeResolver.Stop()
 	}
+	c.activeResolversLock.RUnlock()
 
 	close(c.quit)
 	c.wg.Wait()
@@ -570,6 +603,11 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 			}
 		}
 
+		closeTxid := closeTx.TxHash()
+		c.logEvent(
+			EventTxBroadcast, &closeTxid, "force close transaction",
+		)
+
 		if err := c.cfg.MarkCommitmentBroadcasted(); err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"mark commitment broadcasted: %v",
@@ -688,14 +726,23 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 		log.Debugf("ChannelArbitrator(%v): inserting %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(htlcResolvers))
 
+		// Before persisting the resolvers, we'll fix the limits each
+		// of them is bound by.
+		c.applyResolverPolicies(htlcResolvers, triggerHeight)
+
 		err = c.log.InsertUnresolvedContracts(htlcResolvers...)
 		if err != nil {
 			return StateError, closeTx, err
 		}
 
-		// Finally, we'll launch all the required contract resolvers.
-		// Once they're all resolved, we're no longer needed.
+		// Finally, we'll launch all the required contract resolvers,
+		// starting with those with the most pressing deadlines. Once
+		// they're all resolved, we're no longer needed.
+		sortResolversByDeadline(htlcResolvers)
+
+		c.activeResolversLock.Lock()
 		c.activeResolvers = htlcResolvers
+		c.activeResolversLock.Unlock()
 		for _, contract := range htlcResolvers {
 			c.wg.Add(1)
 			go c.resolveContract(contract)
@@ -787,6 +834,12 @@ func (c *ChannelArbitrator) advanceState(triggerHeight uint32,
 			return priorState, nil, err
 		}
 		c.state = nextState
+
+		c.logEvent(
+			EventStateTransition, nil, "%v -> %v (trigger=%v, "+
+				"height=%v)", priorState, nextState, trigger,
+			triggerHeight,
+		)
 	}
 }
 
@@ -902,7 +955,7 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 		"height=%v", c.cfg.ChanPoint, height)
 
 	actionMap := make(ChainActionMap)
-	redeemCutoff := c.cfg.BroadcastDelta * broadcastRedeemMultiplier
+	redeemCutoff := c.redeemBroadcastDelta()
 
 	// First, we'll make an initial pass over the set of incoming and
 	// outgoing HTLC's to decide if we need to go on chain at all.
@@ -914,6 +967,15 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 			break
 		}
 
+		// If the HTLC isn't worth going on-chain for by itself, then
+		// it won't trigger an on-chain action.
+		action := c.resolutionAction(
+			ReportOutputOutgoingHtlc, htlc.Amt.ToSatoshis(),
+		)
+		if action != ResolveSweepNow {
+			continue
+		}
+
 		// We'll need to go on-chain for an outgoing HTLC if it was
 		// never resolved downstream, and it's "close" to timing out.
 		haveChainActions = haveChainActions || c.shouldGoOnChain(
@@ -934,6 +996,12 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 		if _, ok := c.cfg.PreimageDB.LookupPreimage(htlc.RHash[:]); !ok {
 			continue
 		}
+		action := c.resolutionAction(
+			ReportOutputIncomingHtlc, htlc.Amt.ToSatoshis(),
+		)
+		if action != ResolveSweepNow {
+			continue
+		}
 		haveChainActions = haveChainActions || c.shouldGoOnChain(
 			htlc.RefundTimeout, redeemCutoff, height,
 		)
@@ -968,6 +1036,20 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 				actionMap[HtlcFailNowAction], htlc,
 			)
 
+		// If the HTLC isn't worth resolving on-chain, then we'll treat
+		// it just like a dust HTLC, and cancel it backwards
+		// immediately.
+		case c.resolutionAction(
+			ReportOutputOutgoingHtlc, htlc.Amt.ToSatoshis(),
+		) == ResolveAbandon:
+			log.Infof("ChannelArbitrator(%v): abandoning "+
+				"htlc=%x worth %v", c.cfg.ChanPoint,
+				htlc.RHash[:], htlc.Amt)
+
+			actionMap[HtlcFailNowAction] = append(
+				actionMap[HtlcFailNowAction], htlc,
+			)
+
 		// If we don't need to immediately act on this HTLC, then we'll
 		// mark it still "live". After we broadcast, we'll monitor it
 		// until the HTLC times out to see if we can also redeem it
@@ -1010,6 +1092,18 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 	for _, htlc := range c.activeHTLCs.incomingHTLCs {
 		payHash := htlc.RHash
 
+		// If the HTLC isn't worth resolving on-chain, then we won't
+		// attempt to claim it at all.
+		action := c.resolutionAction(
+			ReportOutputIncomingHtlc, htlc.Amt.ToSatoshis(),
+		)
+		if action == ResolveAbandon {
+			log.Infof("ChannelArbitrator(%v): abandoning "+
+				"incoming htlc=%x worth %v", c.cfg.ChanPoint,
+				payHash[:], htlc.Amt)
+			continue
+		}
+
 		// If we have the pre-image, then we should go on-chain to
 		// redeem the HTLC immediately.
 		if _, ok := c.cfg.PreimageDB.LookupPreimage(payHash[:]); ok {
@@ -1037,6 +1131,23 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 	return actionMap
 }
 
+// newResolverKit returns a new ResolverKit for a resolver launched by the
+// ChannelArbitrator. Broadcasts and other notable actions of the resolver
+// will be recorded within the arbitration event log.
+func (c *ChannelArbitrator) newResolverKit() ResolverKit {
+	resKit := ResolverKit{
+		ChannelArbitratorConfig: c.cfg,
+		Checkpoint: func(res ContractResolver) error {
+			return c.log.InsertUnresolvedContracts(res)
+		},
+		LogEvent: c.logEvent,
+		Quit:     make(chan struct{}),
+	}
+	resKit.PublishTx = c.publishTx
+
+	return resKit
+}
+
 // prepContractResolutions is called either int he case that we decide we need
 // to go to chain, or the remote party goes to chain. Given a set of actions we
 // need to take for each HTLC, this method will return a set of contract
@@ -1092,15 +1203,6 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 		outResolutionMap[htlcPoint] = outRes
 	}
 
-	// We'll create the resolver kit that we'll be cloning for each
-	// resolver so they each can do their duty.
-	resKit := ResolverKit{
-		ChannelArbitratorConfig: c.cfg,
-		Checkpoint: func(res ContractResolver) error {
-			return c.log.InsertUnresolvedContracts(res)
-		},
-	}
-
 	commitHash := contractResolutions.CommitHash
 	failureMsg := &lnwire.FailPermanentChannelFailure{}
 
@@ -1142,12 +1244,12 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					continue
 				}
 
-				resKit.Quit = make(chan struct{})
 				resolver := &htlcSuccessResolver{
 					htlcResolution:  resolution,
 					broadcastHeight: height,
 					payHash:         htlc.RHash,
-					ResolverKit:     resKit,
+					expiry:          htlc.RefundTimeout,
+					ResolverKit:     c.newResolverKit(),
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
 			}
@@ -1169,12 +1271,11 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					continue
 				}
 
-				resKit.Quit = make(chan struct{})
 				resolver := &htlcTimeoutResolver{
 					htlcResolution:  resolution,
 					broadcastHeight: height,
 					htlcIndex:       htlc.HtlcIndex,
-					ResolverKit:     resKit,
+					ResolverKit:     c.newResolverKit(),
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
 			}
@@ -1200,14 +1301,13 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					continue
 				}
 
-				resKit.Quit = make(chan struct{})
 				resolver := &htlcIncomingContestResolver{
 					htlcExpiry: htlc.RefundTimeout,
 					htlcSuccessResolver: htlcSuccessResolver{
 						htlcResolution:  resolution,
 						broadcastHeight: height,
 						payHash:         htlc.RHash,
-						ResolverKit:     resKit,
+						ResolverKit:     c.newResolverKit(),
 					},
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1231,13 +1331,12 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					continue
 				}
 
-				resKit.Quit = make(chan struct{})
 				resolver := &htlcOutgoingContestResolver{
 					htlcTimeoutResolver{
 						htlcResolution:  resolution,
 						broadcastHeight: height,
 						htlcIndex:       htlc.HtlcIndex,
-						ResolverKit:     resKit,
+						ResolverKit:     c.newResolverKit(),
 					},
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1248,13 +1347,13 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 	// Finally, if this is was a unilateral closure, then we'll also create
 	// a resolver to sweep our commitment output (but only if it wasn't
 	// trimmed).
-	if contractResolutions.CommitResolution != nil {
-		resKit.Quit = make(chan struct{})
+	commitRes := contractResolutions.CommitResolution
+	if commitRes != nil && !c.abandonCommitOutput(commitRes) {
 		resolver := &commitSweepResolver{
 			commitResolution: *contractResolutions.CommitResolution,
 			broadcastHeight:  height,
 			chanPoint:        c.cfg.ChanPoint,
-			ResolverKit:      resKit,
+			ResolverKit:      c.newResolverKit(),
 		}
 
 		htlcResolvers = append(htlcResolvers, resolver)
@@ -1263,6 +1362,23 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 	return htlcResolvers, msgsToSend, nil
 }
 
+// abandonCommitOutput returns true if the configured ResolutionStrategy
+// deems our commitment output not worth sweeping.
+func (c *ChannelArbitrator) abandonCommitOutput(
+	commitRes *lnwallet.CommitOutputResolution) bool {
+
+	amt := btcutil.Amount(commitRes.SelfOutputSignDesc.Output.Value)
+	action := c.resolutionAction(ReportOutputCommit, amt)
+	if action != ResolveAbandon {
+		return false
+	}
+
+	log.Infof("ChannelArbitrator(%v): abandoning commitment output %v "+
+		"worth %v", c.cfg.ChanPoint, commitRes.SelfOutPoint, amt)
+
+	return true
+}
+
 // resolveContract is a goroutine tasked with fully resolving an unresolved
 // contract. Either the initial contract will be resolved after a single step,
 // or the contract will itself create another contract to be resolved. In
@@ -1277,6 +1393,11 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 	log.Debugf("ChannelArbitrator(%v): attempting to resolve %T",
 		c.cfg.ChanPoint, currentContract)
 
+	c.logEvent(
+		EventResolverLaunched, nil, "%T(%x)", currentContract,
+		currentContract.ResolverKey(),
+	)
+
 	// Until the contract is fully resolved, we'll continue to iteratively
 	// resolve the contract one step at a time.
 	for !currentContract.IsResolved() {
@@ -1293,6 +1414,15 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 			// Otherwise, we'll attempt to resolve the current
 			// contract.
 			nextContract, err := currentContract.Resolve()
+
+			// If the contract was abandoned while we were
+			// resolving it, then we'll drop it regardless of the
+			// outcome.
+			if c.isAbandoned(currentContract) {
+				c.finalizeAbandoned(currentContract)
+				return
+			}
+
 			if err != nil {
 				log.Errorf("ChannelArbitrator(%v): unable to "+
 					"progress resolver: %v",
@@ -1320,6 +1450,11 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 						"contract: %v", err)
 				}
 
+				c.logEvent(
+					EventResolverSwapped, nil, "%T -> %T",
+					currentContract, nextContract,
+				)
+
 				// As this contract produced another, we'll
 				// re-assign, so we can continue our resolution
 				// loop.
@@ -1338,6 +1473,13 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 						err)
 				}
 
+				c.logEvent(
+					EventResolverResolved, nil, "%T(%x)",
+					currentContract,
+					currentContract.ResolverKey(),
+				)
+				c.notifyContractResolved(currentContract, false)
+
 				// Now that the contract has been resolved,
 				// well signal to the main goroutine.
 				select {
@@ -1418,8 +1560,10 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 
 			// If we're not in the default state, then we can
 			// ignore this signal as we're waiting for contract
-			// resolution.
+			// resolution, other than to give up on any resolvers
+			// that have run out of time.
 			if c.state != StateDefault {
+				c.abandonExpiredResolvers(uint32(bestHeight))
 				continue
 			}
 
@@ -1477,6 +1621,12 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			log.Infof("ChannelArbitrator(%v) marking channel "+
 				"cooperatively closed", c.cfg.ChanPoint)
 
+			c.logEvent(
+				EventTxConfirmed, &closeInfo.ClosingTXID,
+				"cooperative close at height %v",
+				closeInfo.CloseHeight,
+			)
+
 			err := c.cfg.MarkChannelClosed(
 				closeInfo.ChannelCloseSummary,
 			)
@@ -1509,6 +1659,13 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			}
 			closeTx := closeInfo.CloseTx
 
+			closeTxid := closeTx.TxHash()
+			c.logEvent(
+				EventTxConfirmed, &closeTxid,
+				"local commitment at height %v",
+				closeInfo.SpendingHeight,
+			)
+
 			contractRes := &ContractResolutions{
 				CommitHash:       closeTx.TxHash(),
 				CommitResolution: closeInfo.CommitResolution,
@@ -1563,6 +1720,12 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			log.Infof("ChannelArbitrator(%v): remote party has "+
 				"closed channel out on-chain", c.cfg.ChanPoint)
 
+			c.logEvent(
+				EventTxConfirmed, uniClosure.SpenderTxHash,
+				"remote commitment at height %v",
+				uniClosure.SpendingHeight,
+			)
+
 			// If we don't have a self output, and there are no
 			// active HTLC's, then we can immediately mark the
 			// contract as fully resolved and exit.
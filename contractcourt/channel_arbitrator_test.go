@@ -45,6 +45,10 @@ func (b *mockArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 	return contracts, nil
 }
 
+func (b *mockArbitratorLog) FetchResolvedContracts() ([]ContractResolver, error) {
+	return nil, nil
+}
+
 func (b *mockArbitratorLog) InsertUnresolvedContracts(resolvers ...ContractResolver) error {
 	return nil
 }
@@ -86,6 +90,14 @@ func (b *mockArbitratorLog) WipeHistory() error {
 	return nil
 }
 
+func (b *mockArbitratorLog) LogEvent(e *ArbitratorEvent) error {
+	return nil
+}
+
+func (b *mockArbitratorLog) FetchEvents() ([]*ArbitratorEvent, error) {
+	return nil, nil
+}
+
 type mockChainIO struct{}
 
 func (*mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
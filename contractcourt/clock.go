@@ -0,0 +1,137 @@
+package contractcourt
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for the ChainArbitrator and its resolvers.
+// Swapping it out allows time to be controlled by a test harness, such that
+// resolution can be simulated deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel that the current time is sent over once the
+	// passed duration has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is a Clock backed by the system time.
+type systemClock struct{}
+
+// Now returns the current time.
+//
+// NOTE: Part of the Clock interface.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns a channel that the current time is sent over once the passed
+// duration has elapsed.
+//
+// NOTE: Part of the Clock interface.
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// clock returns the configured Clock, or the system clock if there's none.
+func (c *ChainArbitratorConfig) clock() Clock {
+	if c.Clock == nil {
+		return systemClock{}
+	}
+
+	return c.Clock
+}
+
+// simTimer is a pending timer of the SimClock.
+type simTimer struct {
+	deadline time.Time
+	c        chan time.Time
+}
+
+// SimClock is a Clock whose time only moves forward when advanced by the
+// caller. Timers created through After fire once the clock has been advanced
+// past their deadline, making any timing within the ChainArbitrator fully
+// deterministic.
+type SimClock struct {
+	mu sync.Mutex
+
+	now    time.Time
+	timers []*simTimer
+}
+
+// NewSimClock returns a new SimClock, starting out at the passed time.
+func NewSimClock(start time.Time) *SimClock {
+	return &SimClock{
+		now: start,
+	}
+}
+
+// Now returns the current time of the clock.
+//
+// NOTE: Part of the Clock interface.
+func (s *SimClock) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.now
+}
+
+// After returns a channel that the time of the clock is sent over once it has
+// been advanced by the passed duration.
+//
+// NOTE: Part of the Clock interface.
+func (s *SimClock) After(d time.Duration) <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := make(chan time.Time, 1)
+	if d <= 0 {
+		c <- s.now
+		return c
+	}
+
+	s.timers = append(s.timers, &simTimer{
+		deadline: s.now.Add(d),
+		c:        c,
+	})
+
+	return c
+}
+
+// Advance moves the time of the clock forward by the passed duration, firing
+// all timers whose deadline has been reached.
+func (s *SimClock) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.now = s.now.Add(d)
+
+	pending := s.timers[:0]
+	for _, timer := range s.timers {
+		if timer.deadline.After(s.now) {
+			pending = append(pending, timer)
+			continue
+		}
+
+		timer.c <- s.now
+	}
+	s.timers = pending
+}
+
+// NumTimers returns the number of timers that are yet to fire. This allows a
+// harness to wait until a subsystem has armed a timer before advancing the
+// clock.
+func (s *SimClock) NumTimers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.timers)
+}
+
+// A compile time assertion to ensure each clock meets the Clock interface.
+var (
+	_ Clock = systemClock{}
+	_ Clock = (*SimClock)(nil)
+)
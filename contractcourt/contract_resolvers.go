@@ -8,8 +8,9 @@ import (
 	"io"
 	"io/ioutil"
 
-	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -20,6 +21,21 @@ var (
 	endian = binary.BigEndian
 )
 
+// defaultClaimConfDepth is the number of confirmations a transaction claiming
+// an output needs by default before the output is considered resolved. As
+// elsewhere in the contract court, a single confirmation suffices. A larger
+// depth leaves us room to rebroadcast the claim should it be reorged out of the
+// chain shortly after confirming.
+const defaultClaimConfDepth = 1
+
+// claimMempoolRebroadcastBlocks is the number of blocks an unconfirmed claim
+// transaction may go without being seen entering the mempool of the backend
+// node before it's rebroadcast. It may have been rejected, or evicted by a
+// block spending one of its inputs, and as we aren't notified of evictions,
+// this also applies to claims seen within the mempool before. Rebroadcasting
+// a claim that's still within the mempool is harmless.
+const claimMempoolRebroadcastBlocks = 3
+
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Bitcoin
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
@@ -78,9 +94,621 @@ type ResolverKit struct {
 	// return a non-nil error upon success.
 	Checkpoint func(ContractResolver) error
 
+	// LogEvent records a notable action of the resolver within the
+	// arbitration event log of the channel. This may be nil, in which
+	// case no events are recorded.
+	LogEvent func(ArbitratorEventType, *chainhash.Hash, string,
+		...interface{})
+
 	Quit chan struct{}
 }
 
+// logEvent records an event within the arbitration event log, if the
+// resolver has been given the means to do so.
+func (r *ResolverKit) logEvent(eventType ArbitratorEventType,
+	txid *chainhash.Hash, format string, params ...interface{}) {
+
+	if r.LogEvent == nil {
+		return
+	}
+
+	r.LogEvent(eventType, txid, format, params...)
+}
+
+// sweepOutput crafts a transaction that sweeps the target output back into
+// the wallet. If the sweep batcher is active, then the output will be swept
+// along with those of any other resolvers that are sweeping within the same
+// batch window, unless alone is set. If a non-zero max fee is passed, or our
+// fee preference has one, and the portion of the sweep's fee attributed to the
+// output would exceed the lower of the two, then the sweep is retried each
+// block until it no longer does. If the output must be claimed by a non-zero
+// deadline, then the fee rate of the sweep is estimated for the blocks left
+// until the deadline whenever it's closer than our confirmation target, and
+// the retries are bounded by it: once the sweep would no longer confirm within
+// our confirmation target before the deadline, the max fee is lifted, as we'd
+// otherwise lose the output to the remote party. The returned
+// transaction still needs to be broadcast, and is returned along with the
+// portion of its fee attributed to the output.
+func (r *ResolverKit) sweepOutput(outpoint wire.OutPoint,
+	signDesc *lnwallet.SignDescriptor, witnessSize int,
+	genWitness witnessGenerator, maxFee btcutil.Amount, deadline uint32,
+	alone bool) (*wire.MsgTx, btcutil.Amount, error) {
+
+	req := &sweepRequest{
+		outpoint:    outpoint,
+		signDesc:    *signDesc,
+		witnessSize: witnessSize,
+		genWitness:  genWitness,
+		maxFee:      maxFee,
+		deadline:    deadline,
+		alone:       alone,
+		quit:        r.Quit,
+	}
+
+	// The fee rate of the sweep is estimated for our confirmation target,
+	// unless the deadline is closer. The max fee of our fee preference
+	// applies to each output swept, rather than to the sweep as a whole.
+	feePref := r.sweepFeePreference()
+	feePref.Deadline = deadline
+	if feePref.MaxFee != 0 &&
+		(req.maxFee == 0 || feePref.MaxFee < req.maxFee) {
+
+		req.maxFee = feePref.MaxFee
+	}
+
+	// liftMaxFee lifts the max fee of the request if the deadline is
+	// closer than our confirmation target as of the passed height.
+	liftMaxFee := func(height uint32) {
+		confTarget := feePref.ConfTarget
+		if confTarget == 0 {
+			confTarget = 1
+		}
+		if req.maxFee == 0 || deadline == 0 ||
+			height+confTarget < deadline {
+
+			return
+		}
+
+		log.Infof("Deadline of %v at height %v is near, sweeping "+
+			"regardless of max fee of %v", outpoint, deadline,
+			req.maxFee)
+
+		req.maxFee = 0
+	}
+	if deadline != 0 {
+		_, bestHeight, err := r.ChainIO.GetBestBlock()
+		if err != nil {
+			return nil, 0, err
+		}
+		req.height = uint32(bestHeight)
+		liftMaxFee(req.height)
+	}
+
+	var (
+		blockEpochs *chainntnfs.BlockEpochEvent
+		feeUpdates  <-chan lnwallet.SatPerKWeight
+	)
+	for {
+		sweepTx, err := r.sweepRequest(req)
+		if err != lnwallet.ErrFeeExceedsMax {
+			return sweepTx, req.fee, err
+		}
+
+		log.Infof("Sweep of %v would exceed max fee of %v, retrying "+
+			"next block or once the fee rate changes", outpoint,
+			req.maxFee)
+
+		if blockEpochs == nil {
+			blockEpochs, err = r.Notifier.RegisterBlockEpochNtfn(nil)
+			if err != nil {
+				return nil, 0, err
+			}
+			defer blockEpochs.Cancel()
+
+			// If the fee rate is estimated, we'll also retry as
+			// soon as the estimate for the effective confirmation
+			// target of the sweep changes, as it may have dropped
+			// enough for the sweep to fit within the max fee. The
+			// target can't be reduced by the deadline while we
+			// retry, as the max fee is lifted once it would be.
+			confTarget := feePref.EffectiveConfTarget(req.height)
+			if feePref.FeeRate == 0 && confTarget != 0 {
+				sub, err := r.FeeEstimator.SubscribeFeeUpdates(
+					confTarget,
+				)
+				if err != nil {
+					return nil, 0, err
+				}
+				defer sub.Cancel()
+
+				feeUpdates = sub.Updates
+			}
+		}
+
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return nil, 0, fmt.Errorf("quitting")
+			}
+			req.height = uint32(epoch.Height)
+			liftMaxFee(req.height)
+
+		case feePerKw := <-feeUpdates:
+			log.Debugf("Fee rate changed to %v sat/kw, retrying "+
+				"sweep of %v", int64(feePerKw), outpoint)
+
+		case <-r.Quit:
+			return nil, 0, fmt.Errorf("quitting")
+		}
+	}
+}
+
+// sweepFeePreference returns the fee preference sweeps should satisfy.
+func (r *ResolverKit) sweepFeePreference() lnwallet.FeePreference {
+	if r.SweepFeePreference == (lnwallet.FeePreference{}) {
+		return defaultSweepFeePreference
+	}
+
+	return r.SweepFeePreference
+}
+
+// sweepRequest makes a single attempt at crafting a transaction that sweeps
+// the output of the passed request.
+func (r *ResolverKit) sweepRequest(req *sweepRequest) (*wire.MsgTx, error) {
+	if r.sweeper == nil || req.alone {
+		return craftSweepTx(
+			r.FeeEstimator, r.sweepFeePreference(), r.NewSweepAddr,
+			[]*sweepRequest{req},
+		)
+	}
+
+	return r.sweeper.sweep(req)
+}
+
+// publishClaim broadcasts the passed transaction claiming an output that
+// needs to be claimed by the passed deadline, where zero means there's none.
+// If the resolver scheduler is active, then the broadcast waits its turn
+// behind claims with a more pressing deadline.
+func (r *ResolverKit) publishClaim(tx *wire.MsgTx, deadline uint32) error {
+	if r.scheduler == nil {
+		return r.PublishTx(tx)
+	}
+
+	release, err := r.scheduler.acquire(deadline, r.Quit)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return r.PublishTx(tx)
+}
+
+// sweepViaNursery returns whether outputs that the resolver would otherwise
+// sweep itself should be handed to the utxo nursery instead.
+func (r *ResolverKit) sweepViaNursery() bool {
+	if r.SweepViaNursery {
+		return true
+	}
+
+	_, ok := r.NurseryChannels[r.ChanPoint]
+	return ok
+}
+
+// publishSweep broadcasts the passed transaction sweeping the output of the
+// resolver. If the sweep conflicts with a transaction in the mempool or
+// chain, then either our output, or that of another resolver we were batched
+// with, has already been spent by the remote party. Rather than failing,
+// we'll record the conflict, and use rebuild to craft a sweep of our output
+// alone, excluding any conflicted inputs of others. Should that conflict as
+// well, then our own output has been spent, and we'll wait for the
+// conflicting spend to confirm.
+//
+// The sweep that was broadcast is returned, unless our output was spent by a
+// foreign transaction, in which case the details of its spend are returned
+// instead.
+func (r *ResolverKit) publishSweep(sweepTx *wire.MsgTx, deadline uint32,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint uint32,
+	rebuild func() (*wire.MsgTx, error)) (*wire.MsgTx,
+	*chainntnfs.SpendDetail, error) {
+
+	err := r.publishClaim(sweepTx, deadline)
+	if err != lnwallet.ErrDoubleSpend {
+		return sweepTx, nil, err
+	}
+
+	sweepTxid := sweepTx.TxHash()
+	log.Warnf("Sweep tx %v of %v conflicts with another spend", sweepTxid,
+		outpoint)
+	r.logEvent(
+		EventSweepConflict, &sweepTxid, "sweep of %v, spending %v "+
+			"inputs, conflicts with another spend", outpoint,
+		len(sweepTx.TxIn),
+	)
+
+	// If our output was swept along with others, then any one of them may
+	// be the conflicted input, so we'll retry sweeping our output alone.
+	ourSweeps := map[chainhash.Hash]*wire.MsgTx{
+		sweepTxid: sweepTx,
+	}
+	if len(sweepTx.TxIn) > 1 {
+		sweepTx, err = rebuild()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = r.publishClaim(sweepTx, deadline)
+		if err != lnwallet.ErrDoubleSpend {
+			return sweepTx, nil, err
+		}
+		ourSweeps[sweepTx.TxHash()] = sweepTx
+	}
+
+	// Otherwise, our own output has been spent, so we'll wait for the
+	// conflicting spend to confirm. It may still turn out to be a prior
+	// sweep of ours.
+	spendNtfn, err := r.Notifier.RegisterSpendNtfn(
+		outpoint, pkScript, heightHint,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer spendNtfn.Cancel()
+
+	select {
+	case spend, ok := <-spendNtfn.Spend:
+		if !ok {
+			return nil, nil, fmt.Errorf("quitting")
+		}
+
+		if ourSweep, ok := ourSweeps[*spend.SpenderTxHash]; ok {
+			return ourSweep, nil, nil
+		}
+
+		return nil, spend, nil
+
+	case <-r.Quit:
+		return nil, nil, fmt.Errorf("quitting")
+	}
+}
+
+// claimConfDepth returns the number of confirmations a claim transaction
+// needs before the output it claims is considered resolved.
+func (r *ResolverKit) claimConfDepth() uint32 {
+	if r.ClaimConfDepth == 0 {
+		return defaultClaimConfDepth
+	}
+
+	return r.ClaimConfDepth
+}
+
+// claimConfHint returns the height from which to watch for the confirmation
+// of the passed claim transaction. If its confirmation was seen prior to a
+// restart, then the height hint cache spares us rescanning the chain from the
+// height the contract was broadcast at.
+func (r *ResolverKit) claimConfHint(txid *chainhash.Hash,
+	heightHint uint32) uint32 {
+
+	if r.ConfirmHints == nil {
+		return heightHint
+	}
+
+	cachedHint, err := r.ConfirmHints.QueryConfirmHint(*txid)
+	switch {
+	case err == chainntnfs.ErrConfirmHintNotFound:
+		return heightHint
+
+	case err != nil:
+		log.Errorf("Unable to query height hint of claim tx %v: %v",
+			txid, err)
+		return heightHint
+
+	case cachedHint > heightHint:
+		return cachedHint
+
+	default:
+		return heightHint
+	}
+}
+
+// commitClaimConfHint commits the height at which the passed claim
+// transaction was seen to confirm to the height hint cache.
+func (r *ResolverKit) commitClaimConfHint(txid *chainhash.Hash, height uint32) {
+	if r.ConfirmHints == nil {
+		return
+	}
+
+	err := r.ConfirmHints.CommitConfirmHint(height, *txid)
+	if err != nil {
+		log.Errorf("Unable to commit height hint of claim tx %v: %v",
+			txid, err)
+	}
+}
+
+// purgeClaimConfHint removes the height hint of the passed claim transaction
+// from the height hint cache, as its confirmation has either been reorged out
+// of the chain, or is no longer of interest.
+func (r *ResolverKit) purgeClaimConfHint(txid *chainhash.Hash) {
+	if r.ConfirmHints == nil {
+		return
+	}
+
+	if err := r.ConfirmHints.PurgeConfirmHint(*txid); err != nil {
+		log.Errorf("Unable to purge height hint of claim tx %v: %v",
+			txid, err)
+	}
+}
+
+// waitForClaimConf waits for the passed transaction claiming an output to be
+// buried under a sufficient number of confirmations. Should the transaction
+// be reorged out of the chain before then, the claim is considered pending
+// once again: the rebroadcast closure, if set, is called to get the
+// transaction back into the mempool, and we'll wait for it to re-confirm. If
+// the notifier has a view of the mempool, the transaction being accepted into
+// it while unconfirmed is recorded as well, and the rebroadcast closure is
+// also called each claimMempoolRebroadcastBlocks blocks the transaction goes
+// without being seen entering the mempool. Should the rebroadcast be rejected
+// with lnwallet.ErrDoubleSpend, then the transaction can no longer confirm,
+// and the error is returned.
+//
+// If outpoint is set, then the output claimed by the transaction is watched
+// as well. Should it be spent by any other transaction, then we'll stop
+// waiting, and return the details of its spend instead.
+func (r *ResolverKit) waitForClaimConf(txid *chainhash.Hash, pkScript []byte,
+	heightHint uint32, outpoint *wire.OutPoint, outputScript []byte,
+	rebroadcast func() error) (*chainntnfs.TxConfirmation,
+	*chainntnfs.SpendDetail, error) {
+
+	confNtfn, err := r.Notifier.RegisterConfirmationsNtfn(
+		txid, pkScript, 1, r.claimConfHint(txid, heightHint),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var spends <-chan *chainntnfs.SpendDetail
+	if outpoint != nil {
+		spendNtfn, err := r.Notifier.RegisterSpendNtfn(
+			outpoint, outputScript, heightHint,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer spendNtfn.Cancel()
+
+		spends = spendNtfn.Spend
+	}
+
+	// rebroadcastClaim rebroadcasts the claim, returning an error only if
+	// it conflicts with another spend.
+	rebroadcastClaim := func() error {
+		err := rebroadcast()
+		switch {
+		case err == lnwallet.ErrDoubleSpend:
+			log.Warnf("Rebroadcast claim tx %v conflicts with "+
+				"another spend", txid)
+			return err
+
+		case err != nil:
+			log.Errorf("Unable to rebroadcast claim tx %v: %v",
+				txid, err)
+		}
+
+		return nil
+	}
+
+	// Light clients have no view of the mempool, in which case the
+	// mempool channel remains nil. As the mempool notification only
+	// serves to track the status of the claim, we'll also go without it
+	// should we be unable to register for it.
+	var mempoolSeen <-chan struct{}
+	if mempoolNotifier, ok := r.Notifier.(chainntnfs.MempoolNotifier); ok {
+		mempoolNtfn, err := mempoolNotifier.RegisterMempoolNtfn(txid)
+		if err != nil {
+			log.Warnf("Unable to watch for claim tx %v within the "+
+				"mempool: %v", txid, err)
+		} else {
+			defer mempoolNtfn.Cancel()
+
+			mempoolSeen = mempoolNtfn.Seen
+		}
+	}
+
+	confDepth := r.claimConfDepth()
+
+	var (
+		confInfo    *chainntnfs.TxConfirmation
+		blockEpochs *chainntnfs.BlockEpochEvent
+		epochs      <-chan *chainntnfs.BlockEpoch
+
+		// blocksUnseen counts the blocks the claim has gone without
+		// being seen entering the mempool.
+		blocksUnseen uint32
+	)
+
+	// If we're able to tell whether the claim made it into the mempool,
+	// we'll count the blocks it goes without, such that we can rebroadcast
+	// it.
+	if mempoolSeen != nil && rebroadcast != nil {
+		blockEpochs, err = r.Notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer blockEpochs.Cancel()
+
+		epochs = blockEpochs.Epochs
+	}
+
+	for {
+		select {
+		case spend, ok := <-spends:
+			if !ok {
+				return nil, nil, fmt.Errorf("quitting")
+			}
+
+			// The spend by the claim itself is handled once it
+			// confirms.
+			spends = nil
+			if *spend.SpenderTxHash == *txid {
+				continue
+			}
+
+			log.Warnf("Output %v claimed by tx %v was spent by "+
+				"txid=%v", outpoint, txid, spend.SpenderTxHash)
+
+			r.purgeClaimConfHint(txid)
+			return nil, spend, nil
+
+		case <-mempoolSeen:
+			// The transaction may briefly be reported within the
+			// mempool after confirming, which we'll ignore.
+			if confInfo != nil {
+				continue
+			}
+
+			blocksUnseen = 0
+
+			log.Debugf("Claim tx %v accepted into the mempool", txid)
+
+			r.logEvent(
+				EventClaimInMempool, txid, "claim tx accepted "+
+					"into the mempool",
+			)
+
+		case conf, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return nil, nil, fmt.Errorf("quitting")
+			}
+
+			if confDepth <= 1 {
+				return conf, nil, nil
+			}
+			confInfo = conf
+
+			// Until the claim is buried deep enough, we'll remember
+			// where it confirmed, such that we can resume watching
+			// from there after a restart.
+			r.commitClaimConfHint(txid, conf.BlockHeight)
+
+			// We'll track the depth of the transaction from here on,
+			// as the notification only covers its first
+			// confirmation.
+			if blockEpochs == nil {
+				blockEpochs, err = r.Notifier.RegisterBlockEpochNtfn(
+					nil,
+				)
+				if err != nil {
+					return nil, nil, err
+				}
+				defer blockEpochs.Cancel()
+
+				epochs = blockEpochs.Epochs
+			}
+
+		case reorgDepth, ok := <-confNtfn.NegativeConf:
+			if !ok {
+				return nil, nil, fmt.Errorf("quitting")
+			}
+
+			log.Warnf("Claim tx %v reorged out of the chain (depth=%v), "+
+				"waiting for it to re-confirm", txid, reorgDepth)
+
+			confInfo = nil
+			r.purgeClaimConfHint(txid)
+			r.logEvent(
+				EventClaimReorged, txid, "claim tx reorged out "+
+					"of the chain at depth %v", reorgDepth,
+			)
+
+			if rebroadcast == nil {
+				continue
+			}
+			if err := rebroadcastClaim(); err != nil {
+				return nil, nil, err
+			}
+
+		case epoch, ok := <-epochs:
+			if !ok {
+				return nil, nil, fmt.Errorf("quitting")
+			}
+
+			if confInfo == nil {
+				if mempoolSeen == nil || rebroadcast == nil {
+					continue
+				}
+
+				// The claim may have been rejected, or evicted
+				// from the mempool since it was last seen. If
+				// it's gone unseen for long enough, we'll
+				// rebroadcast it, which also surfaces any
+				// conflict with another spend.
+				blocksUnseen++
+				if blocksUnseen < claimMempoolRebroadcastBlocks {
+					continue
+				}
+				blocksUnseen = 0
+
+				log.Infof("Claim tx %v not seen entering the "+
+					"mempool for %v blocks, rebroadcasting",
+					txid, claimMempoolRebroadcastBlocks)
+
+				if err := rebroadcastClaim(); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			if uint32(epoch.Height) >= confInfo.BlockHeight+confDepth-1 {
+				r.purgeClaimConfHint(txid)
+				return confInfo, nil, nil
+			}
+
+		case <-r.Quit:
+			return nil, nil, fmt.Errorf("quitting")
+		}
+	}
+}
+
+// waitForSweepConf waits for the passed transaction sweeping the output of
+// the resolver to be sufficiently confirmed. The sweep may have been batched
+// with the outputs of other resolvers, and been accepted, or checkpointed
+// prior to a restart, before the remote party spent one of them. Should a
+// rebroadcast of the sweep then conflict with another spend, we'll rebuild it
+// as publishSweep would, and wait for the rebuilt sweep instead.
+//
+// The sweep that confirmed is returned, unless our output was spent by a
+// foreign transaction, in which case the details of its spend are returned
+// instead.
+func (r *ResolverKit) waitForSweepConf(sweepTx *wire.MsgTx, deadline uint32,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint uint32,
+	rebuild func() (*wire.MsgTx, error)) (*wire.MsgTx,
+	*chainntnfs.TxConfirmation, *chainntnfs.SpendDetail, error) {
+
+	for {
+		sweepTXID := sweepTx.TxHash()
+		confInfo, spend, err := r.waitForClaimConf(
+			&sweepTXID, sweepTx.TxOut[0].PkScript, heightHint,
+			outpoint, pkScript, func() error {
+				return r.publishClaim(sweepTx, deadline)
+			},
+		)
+		switch {
+		case err == lnwallet.ErrDoubleSpend:
+			sweepTx, spend, err = r.publishSweep(
+				sweepTx, deadline, outpoint, pkScript,
+				heightHint, rebuild,
+			)
+			if err != nil || spend != nil {
+				return nil, nil, spend, err
+			}
+
+		case err != nil || spend != nil:
+			return nil, nil, spend, err
+
+		default:
+			return sweepTx, confInfo, nil, nil
+		}
+	}
+}
+
 // htlcTimeoutResolver is a ContractResolver that's capable of resolving an
 // outgoing HTLC. The HTLC may be on our commitment transaction, or on the
 // commitment transaction of the remote party. An output on our commitment
@@ -111,6 +739,14 @@ type htlcTimeoutResolver struct {
 	// additional commitment state machine.
 	htlcIndex uint64
 
+	// claimedByRemote is true if the remote party claimed the HTLC with
+	// the preimage. This isn't persisted, as the resolver is removed from
+	// the log once resolved.
+	claimedByRemote bool
+
+	// policy bounds the resources spent resolving the HTLC.
+	policy resolverPolicy
+
 	ResolverKit
 }
 
@@ -164,32 +800,39 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		}
 	}
 
-	// waitForOutputResolution waits for the HTLC output to be fully
-	// resolved. The output is considered fully resolved once it has been
-	// spent, and the spending transaction has been fully confirmed.
-	waitForOutputResolution := func() error {
-		// We first need to register to see when the HTLC output itself
-		// has been spent by a confirmed transaction.
+	// waitForSpend waits for the passed output to be spent by a
+	// confirmed transaction, returning the details of the spend.
+	waitForSpend := func(op *wire.OutPoint,
+		pkScript []byte) (*chainntnfs.SpendDetail, error) {
+
 		spendNtfn, err := h.Notifier.RegisterSpendNtfn(
-			&h.htlcResolution.ClaimOutpoint,
-			h.htlcResolution.SweepSignDesc.Output.PkScript,
-			h.broadcastHeight,
+			op, pkScript, h.broadcastHeight,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		select {
-		case _, ok := <-spendNtfn.Spend:
+		case spend, ok := <-spendNtfn.Spend:
 			if !ok {
-				return fmt.Errorf("notifier quit")
+				return nil, fmt.Errorf("notifier quit")
 			}
 
+			return spend, nil
+
 		case <-h.Quit:
-			return fmt.Errorf("quitting")
+			return nil, fmt.Errorf("quitting")
 		}
+	}
 
-		return nil
+	// waitForOutputResolution waits for the HTLC output to be fully
+	// resolved. The output is considered fully resolved once it has been
+	// spent, and the spending transaction has been fully confirmed.
+	waitForOutputResolution := func() (*chainntnfs.SpendDetail, error) {
+		return waitForSpend(
+			&h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.SweepSignDesc.Output.PkScript,
+		)
 	}
 
 	// With the output sent to the nursery, we'll now wait until the output
@@ -205,41 +848,56 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		// transaction spending that output is sufficiently confirmed.
 		log.Infof("%T(%v): waiting for nursery to spend CLTV-locked "+
 			"output", h, h.htlcResolution.ClaimOutpoint)
-		if err := waitForOutputResolution(); err != nil {
+		spend, err := waitForOutputResolution()
+		if err != nil {
 			return nil, err
 		}
+
+		// The remote party may have beaten the nursery to the output
+		// by sweeping it with the preimage. In that case, we'll settle
+		// the HTLC backwards instead.
+		if h.isPreimageSpend(spend) {
+			return h.claimCleanUp(spend)
+		}
 	} else {
-		// Otherwise, this is our commitment, so we'll watch for the
-		// second-level transaction to be sufficiently confirmed.
-		secondLevelTXID := h.htlcResolution.SignedTimeoutTx.TxHash()
-		sweepScript := h.htlcResolution.SignedTimeoutTx.TxOut[0].PkScript
-		confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
-			&secondLevelTXID, sweepScript, 1, h.broadcastHeight,
-		)
+		// Otherwise, this is our commitment. We'll first wait for the
+		// HTLC output to be spent, as the remote party may beat our
+		// second-level transaction to it by sweeping it with the
+		// preimage.
+		htlcOutpoint, htlcScript, err := h.htlcOutputToWatch()
+		if err != nil {
+			return nil, err
+		}
+		spend, err := waitForSpend(&htlcOutpoint, htlcScript)
 		if err != nil {
 			return nil, err
 		}
+		if h.isPreimageSpend(spend) {
+			return h.claimCleanUp(spend)
+		}
+
+		// Otherwise, it was spent by our second-level transaction,
+		// which we'll wait to be sufficiently confirmed.
+		timeoutTx := h.htlcResolution.SignedTimeoutTx
+		secondLevelTXID := timeoutTx.TxHash()
 
 		log.Infof("%T(%v): waiting second-level tx (txid=%v) to be "+
 			"fully confirmed", h, h.htlcResolution.ClaimOutpoint,
 			secondLevelTXID)
 
-		select {
-		case _, ok := <-confNtfn.Confirmed:
-			if !ok {
-				return nil, fmt.Errorf("quitting")
-			}
-
-		case <-h.Quit:
-			return nil, fmt.Errorf("quitting")
+		_, _, err = h.waitForClaimConf(
+			&secondLevelTXID, timeoutTx.TxOut[0].PkScript,
+			h.broadcastHeight, nil, nil, func() error {
+				return h.publishClaim(
+					timeoutTx, h.htlcResolution.Expiry,
+				)
+			},
+		)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	// TODO(roasbeef): need to watch for remote party sweeping with pre-image?
-	//  * have another waiting on spend above, will check the type, if it's
-	//    pre-image, then we'll cancel, and send a clean up back with
-	//    pre-image, also add to preimage cache
-
 	log.Infof("%T(%v): resolving htlc with incoming fail msg, fully "+
 		"confirmed", h, h.htlcResolution.ClaimOutpoint)
 
@@ -261,7 +919,7 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	if h.htlcResolution.SignedTimeoutTx != nil {
 		log.Infof("%T(%v): waiting for nursery to spend CSV delayed "+
 			"output", h, h.htlcResolution.ClaimOutpoint)
-		if err := waitForOutputResolution(); err != nil {
+		if _, err := waitForOutputResolution(); err != nil {
 			return nil, err
 		}
 	}
@@ -341,20 +999,184 @@ func (h *htlcTimeoutResolver) Decode(r io.Reader) error {
 		return err
 	}
 
-	if err := binary.Read(r, endian, &h.htlcIndex); err != nil {
-		return err
-	}
+	if err := binary.Read(r, endian, &h.htlcIndex); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttachResolverKit should be called once a resolved is successfully decoded
+// from its stored format. This struct delivers a generic tool kit that
+// resolvers need to complete their duty.
+//
+// NOTE: Part of the ContractResolver interface.
+func (h *htlcTimeoutResolver) AttachResolverKit(r ResolverKit) {
+	h.ResolverKit = r
+}
+
+// htlcOutputToWatch returns the HTLC output on the commitment transaction,
+// along with its pkScript. If this is the remote party's commitment, this is
+// the output we'll sweep directly. Otherwise, it's the output our timeout
+// transaction spends, whose pkScript we'll re-construct from the witness
+// script (the last element of the witness stack).
+func (h *htlcTimeoutResolver) htlcOutputToWatch() (wire.OutPoint, []byte,
+	error) {
+
+	if h.htlcResolution.SignedTimeoutTx == nil {
+		return h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.SweepSignDesc.Output.PkScript, nil
+	}
+
+	timeoutInput := h.htlcResolution.SignedTimeoutTx.TxIn[0]
+	witness := timeoutInput.Witness
+	pkScript, err := lnwallet.WitnessScriptHash(witness[len(witness)-1])
+	if err != nil {
+		return wire.OutPoint{}, nil, err
+	}
+
+	return timeoutInput.PreviousOutPoint, pkScript, nil
+}
+
+// isPreimageSpend returns true if the passed spend of the HTLC output is the
+// remote party claiming it with the preimage, rather than us timing it out.
+func (h *htlcTimeoutResolver) isPreimageSpend(
+	spend *chainntnfs.SpendDetail) bool {
+
+	witness := spend.SpendingTx.TxIn[spend.SpenderInputIndex].Witness
+
+	// If this is the remote party's commitment, then they'll claim the
+	// output with their second-level success transaction, while our
+	// timeout sweep only carries a signature and an empty element.
+	if h.htlcResolution.SignedTimeoutTx == nil {
+		return len(witness) == 5 && len(witness[3]) == 32
+	}
+
+	// Otherwise, they'll claim it directly from our commitment, while our
+	// second-level timeout transaction carries both signatures.
+	return len(witness) == 3 && len(witness[1]) == 32
+}
+
+// claimCleanUp is called once the HTLC output is spent by the remote party
+// with the preimage. It'll extract the preimage, add it to the global cache,
+// and finally send the appropriate clean up message.
+func (h *htlcTimeoutResolver) claimCleanUp(
+	commitSpend *chainntnfs.SpendDetail) (ContractResolver, error) {
+
+	// Depending on if this is our commitment or not, then we'll be
+	// looking for a different witness pattern.
+	spenderIndex := commitSpend.SpenderInputIndex
+	spendingInput := commitSpend.SpendingTx.TxIn[spenderIndex]
+
+	log.Infof("%T(%v): extracting preimage! remote party spent "+
+		"HTLC with tx=%v", h, h.htlcResolution.ClaimOutpoint,
+		spew.Sdump(commitSpend.SpendingTx))
+
+	// If this is the remote party's commitment, then we'll be
+	// looking for them to spend using the second-level success
+	// transaction.
+	var preimage [32]byte
+	if h.htlcResolution.SignedTimeoutTx == nil {
+		// The witness stack when the remote party sweeps the
+		// output to them looks like:
+		//
+		//  * <sender sig> <recvr sig> <preimage> <witness script>
+		copy(preimage[:], spendingInput.Witness[3])
+	} else {
+		// Otherwise, they'll be spending directly from our
+		// commitment output. In which case the witness stack
+		// looks like:
+		//
+		//  * <sig> <preimage> <witness script>
+		copy(preimage[:], spendingInput.Witness[1])
+	}
+
+	log.Infof("%T(%v): extracting preimage=%x from on-chain "+
+		"spend!", h, h.htlcResolution.ClaimOutpoint, preimage[:])
+
+	h.logEvent(
+		EventCounterpartySpend, commitSpend.SpenderTxHash,
+		"remote party claimed outgoing htlc %v with preimage",
+		h.htlcResolution.ClaimOutpoint,
+	)
+
+	// With the preimage obtained, we'll persist it to the global cache
+	// before settling backwards. Should this fail, we'll bail out, such
+	// that the spend is processed again once we're restarted.
+	if err := h.PreimageDB.AddPreimage(preimage[:]); err != nil {
+		log.Errorf("%T(%v): unable to add witness to cache: %v",
+			h, h.htlcResolution.ClaimOutpoint, err)
+		return nil, err
+	}
+
+	// The output was handed to the utxo nursery to be swept once it timed
+	// out, which can no longer happen, so we'll have it stop incubating
+	// the output. Otherwise, the nursery would keep broadcasting sweeps
+	// spending it, holding up the sweep of any outputs batched with it.
+	if h.outputIncubating && h.RemoveIncubatingOutput != nil {
+		err := h.RemoveIncubatingOutput(
+			h.ChanPoint, h.htlcResolution.ClaimOutpoint,
+		)
+		if err != nil {
+			log.Errorf("%T(%v): unable to remove output from "+
+				"nursery: %v", h, h.htlcResolution.ClaimOutpoint,
+				err)
+			return nil, err
+		}
+	}
+
+	// Finally, we'll send the clean up message, mark ourselves as
+	// resolved, then exit.
+	if err := h.DeliverResolutionMsg(ResolutionMsg{
+		SourceChan: h.ShortChanID,
+		HtlcIndex:  h.htlcIndex,
+		PreImage:   &preimage,
+	}); err != nil {
+		return nil, err
+	}
+	h.claimedByRemote = true
+	h.resolved = true
+	return nil, h.Checkpoint(h)
+}
+
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcTimeoutResolver) report() *ContractReport {
+	amt := btcutil.Amount(h.htlcResolution.SweepSignDesc.Output.Value)
+	report := &ContractReport{
+		Outpoint:       h.htlcResolution.ClaimOutpoint,
+		Type:           ReportOutputOutgoingHtlc,
+		Amount:         amt,
+		MaturityHeight: h.htlcResolution.Expiry,
+	}
+
+	// If this is our commitment, then we'll first need to broadcast the
+	// timeout transaction. Otherwise, the output on the remote party's
+	// commitment can be swept directly once it expires.
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		report.Stage = 1
+		if h.outputIncubating {
+			txid := h.htlcResolution.SignedTimeoutTx.TxHash()
+			report.SweepTxid = &txid
+		}
+	} else {
+		report.Stage = 2
+	}
+	report.Delegated = h.outputIncubating
+
+	switch {
+	case h.resolved && h.claimedByRemote:
+		report.SweepTxid = nil
 
-	return nil
-}
+	case h.resolved:
+		report.RecoveredBalance = amt
 
-// AttachResolverKit should be called once a resolved is successfully decoded
-// from its stored format. This struct delivers a generic tool kit that
-// resolvers need to complete their duty.
-//
-// NOTE: Part of the ContractResolver interface.
-func (h *htlcTimeoutResolver) AttachResolverKit(r ResolverKit) {
-	h.ResolverKit = r
+	default:
+		report.LimboBalance = amt
+	}
+
+	return report
 }
 
 // A compile time assertion to ensure htlcTimeoutResolver meets the
@@ -389,6 +1211,10 @@ type htlcSuccessResolver struct {
 	// payHash is the payment hash of the original HTLC extended to us.
 	payHash [32]byte
 
+	// expiry is the absolute expiry of the HTLC. Our claim needs to
+	// confirm before it, as the remote party can time out the HTLC after.
+	expiry uint32
+
 	// sweepTx will be non-nil if we've already crafted a transaction to
 	// sweep a direct HTLC output. This is only a concern if we're sweeping
 	// from the commitment transaction of the remote party.
@@ -396,6 +1222,18 @@ type htlcSuccessResolver struct {
 	// TODO(roasbeef): send off to utxobundler
 	sweepTx *wire.MsgTx
 
+	// sweepFee is the portion of the fee of sweepTx that's attributed to
+	// the HTLC output.
+	sweepFee btcutil.Amount
+
+	// policy bounds the resources spent resolving the HTLC.
+	policy resolverPolicy
+
+	// claimedByRemote is true if the remote party timed out the HTLC
+	// output on their commitment before our sweep confirmed. This isn't
+	// persisted, as the resolver is removed from the log once resolved.
+	claimedByRemote bool
+
 	ResolverKit
 }
 
@@ -436,6 +1274,25 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
+		// Should our sweep conflict with another spend, then we'll
+		// rebuild it to sweep our output alone, which is checkpointed
+		// before being broadcast.
+		sweepFees := make(map[*wire.MsgTx]btcutil.Amount)
+		rebuildSweep := func() (*wire.MsgTx, error) {
+			tx, fee, err := h.craftSweepTx(true)
+			if err != nil {
+				return nil, err
+			}
+			sweepFees[tx] = fee
+
+			h.sweepTx, h.sweepFee = tx, fee
+			if err := h.Checkpoint(h); err != nil {
+				log.Errorf("unable to Checkpoint: %v", err)
+			}
+
+			return tx, nil
+		}
+
 		// If we don't already have the sweep transaction constructed,
 		// we'll do so and broadcast it.
 		if h.sweepTx == nil {
@@ -444,58 +1301,10 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 				h.payHash[:])
 
 			// In this case, we can sweep it directly from the
-			// commitment output. We'll first grab a fresh address
-			// from the wallet to sweep the output.
-			addr, err := h.NewSweepAddr()
-			if err != nil {
-				return nil, err
-			}
-
-			// With our address obtained, we'll query for an
-			// estimate to be confirmed at ease.
-			//
-			// TODO(roasbeef): signal up if fee would be too large
-			// to sweep singly, need to batch
-			feePerKw, err := h.FeeEstimator.EstimateFeePerKW(6)
-			if err != nil {
-				return nil, err
-			}
-
-			log.Debugf("%T(%x): using %v sat/kw to sweep htlc"+
-				"incoming+remote htlc confirmed", h,
-				h.payHash[:], int64(feePerKw))
-
-			// Using a weight estimator, we'll compute the total
-			// fee required, and from that the value we'll end up
-			// with.
-			totalWeight := (&lnwallet.TxWeightEstimator{}).
-				AddWitnessInput(lnwallet.OfferedHtlcSuccessWitnessSize).
-				AddP2WKHOutput().Weight()
-			totalFees := feePerKw.FeeForWeight(int64(totalWeight))
-			sweepAmt := h.htlcResolution.SweepSignDesc.Output.Value -
-				int64(totalFees)
-
-			// With the fee computation finished, we'll now
-			// construct the sweep transaction.
-			htlcPoint := h.htlcResolution.ClaimOutpoint
-			h.sweepTx = wire.NewMsgTx(2)
-			h.sweepTx.AddTxIn(&wire.TxIn{
-				PreviousOutPoint: htlcPoint,
-			})
-			h.sweepTx.AddTxOut(&wire.TxOut{
-				PkScript: addr,
-				Value:    sweepAmt,
-			})
-
-			// With the transaction fully assembled, we can now
-			// generate a valid witness for the transaction.
-			h.htlcResolution.SweepSignDesc.SigHashes = txscript.NewTxSigHashes(
-				h.sweepTx,
-			)
-			h.sweepTx.TxIn[0].Witness, err = lnwallet.SenderHtlcSpendRedeem(
-				h.Signer, &h.htlcResolution.SweepSignDesc, h.sweepTx,
-				h.htlcResolution.Preimage[:],
-			)
+			// commitment output. The output will be swept along
+			// with any others that are maturing at the same time.
+			var err error
+			h.sweepTx, h.sweepFee, err = h.craftSweepTx(false)
 			if err != nil {
 				return nil, err
 			}
@@ -513,36 +1322,50 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 			// the network.
 			//
 			// TODO(roasbeef): validate first?
-			if err := h.PublishTx(h.sweepTx); err != nil {
+			sweepFees[h.sweepTx] = h.sweepFee
+			sweepTx, spend, err := h.publishSweep(
+				h.sweepTx, h.expiry,
+				&h.htlcResolution.ClaimOutpoint,
+				h.htlcResolution.SweepSignDesc.Output.PkScript,
+				h.broadcastHeight, rebuildSweep,
+			)
+			if err != nil {
 				log.Infof("%T(%x): unable to publish tx: %v",
 					h, h.payHash[:], err)
 				return nil, err
 			}
+
+			// If the remote party beat us to the HTLC output,
+			// then there's nothing left for us to claim.
+			if spend != nil {
+				return h.remoteSpendCleanUp(spend)
+			}
+
+			// The spend of our output may have turned out to be
+			// our sweep from before it was rebuilt.
+			h.updateSweepTx(sweepTx, sweepFees)
 		}
 
 		// With the sweep transaction broadcast, we'll wait for its
-		// confirmation.
-		sweepTXID := h.sweepTx.TxHash()
-		sweepScript := h.sweepTx.TxOut[0].PkScript
-		confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
-			&sweepTXID, sweepScript, 1, h.broadcastHeight,
+		// confirmation. Should it conflict with a spend of another
+		// output it was batched with in the meantime, then it'll be
+		// rebuilt.
+		log.Infof("%T(%x): waiting for sweep tx (txid=%v) to be "+
+			"confirmed", h, h.payHash[:], h.sweepTx.TxHash())
+
+		sweepFees[h.sweepTx] = h.sweepFee
+		sweepTx, _, spend, err := h.waitForSweepConf(
+			h.sweepTx, h.expiry, &h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.SweepSignDesc.Output.PkScript,
+			h.broadcastHeight, rebuildSweep,
 		)
 		if err != nil {
 			return nil, err
 		}
-
-		log.Infof("%T(%x): waiting for sweep tx (txid=%v) to be "+
-			"confirmed", h, h.payHash[:], sweepTXID)
-
-		select {
-		case _, ok := <-confNtfn.Confirmed:
-			if !ok {
-				return nil, fmt.Errorf("quitting")
-			}
-
-		case <-h.Quit:
-			return nil, fmt.Errorf("quitting")
+		if spend != nil {
+			return h.remoteSpendCleanUp(spend)
 		}
+		h.updateSweepTx(sweepTx, sweepFees)
 
 		// Once the transaction has received a sufficient number of
 		// confirmations, we'll mark ourselves as fully resolved and exit.
@@ -557,7 +1380,8 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// the claiming process.
 	//
 	// TODO(roasbeef): after changing sighashes send to tx bundler
-	if err := h.PublishTx(h.htlcResolution.SignedSuccessTx); err != nil {
+	err := h.publishClaim(h.htlcResolution.SignedSuccessTx, h.expiry)
+	if err != nil {
 		return nil, err
 	}
 
@@ -608,6 +1432,61 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	return nil, h.Checkpoint(h)
 }
 
+// remoteSpendCleanUp marks the resolver as resolved once the remote party
+// beat us to the HTLC output on their commitment, as there's nothing left for
+// us to claim.
+func (h *htlcSuccessResolver) remoteSpendCleanUp(
+	spend *chainntnfs.SpendDetail) (ContractResolver, error) {
+
+	log.Infof("%T(%x): htlc output spent by remote party in txid=%v", h,
+		h.payHash[:], spend.SpenderTxHash)
+
+	h.logEvent(
+		EventCounterpartySpend, spend.SpenderTxHash, "incoming htlc "+
+			"%x spent by remote party", h.payHash[:],
+	)
+
+	h.claimedByRemote = true
+	h.resolved = true
+	return nil, h.Checkpoint(h)
+}
+
+// updateSweepTx checkpoints the passed transaction as our sweep, along with
+// its fee, should it differ from the sweep we have on record.
+func (h *htlcSuccessResolver) updateSweepTx(sweepTx *wire.MsgTx,
+	sweepFees map[*wire.MsgTx]btcutil.Amount) {
+
+	if sweepTx == h.sweepTx {
+		return
+	}
+
+	h.sweepTx, h.sweepFee = sweepTx, sweepFees[sweepTx]
+	if err := h.Checkpoint(h); err != nil {
+		log.Errorf("unable to Checkpoint: %v", err)
+	}
+}
+
+// craftSweepTx crafts a transaction sweeping the HTLC output on the remote
+// party's commitment directly, using the preimage. Unless alone is set, the
+// output is batched with those of other resolvers.
+func (h *htlcSuccessResolver) craftSweepTx(alone bool) (*wire.MsgTx,
+	btcutil.Amount, error) {
+
+	return h.sweepOutput(
+		h.htlcResolution.ClaimOutpoint, &h.htlcResolution.SweepSignDesc,
+		lnwallet.OfferedHtlcSuccessWitnessSize,
+		func(tx *wire.MsgTx, signDesc *lnwallet.SignDescriptor) (
+			wire.TxWitness, error) {
+
+			return lnwallet.SenderHtlcSpendRedeem(
+				h.Signer, signDesc, tx,
+				h.htlcResolution.Preimage[:],
+			)
+		},
+		h.policy.maxFee, h.expiry, alone,
+	)
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -649,7 +1528,13 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 		return err
 	}
 
-	return nil
+	// Finally, we'll write out the fee we paid to sweep the HTLC, and its
+	// expiry.
+	if err := binary.Write(w, endian, int64(h.sweepFee)); err != nil {
+		return err
+	}
+
+	return binary.Write(w, endian, h.expiry)
 }
 
 // Decode attempts to decode an encoded ContractResolver from the passed Reader
@@ -657,6 +1542,14 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) Decode(r io.Reader) error {
+	return h.decodeVersion(r, resolverRecordVersion)
+}
+
+// decodeVersion decodes the resolver from the body of a resolver record of the
+// passed version.
+//
+// NOTE: Part of the versionedContractResolver interface.
+func (h *htlcSuccessResolver) decodeVersion(r io.Reader, version uint8) error {
 	// First we'll decode our inner HTLC resolution.
 	if err := decodeIncomingResolution(r, &h.htlcResolution); err != nil {
 		return err
@@ -677,7 +1570,19 @@ func (h *htlcSuccessResolver) Decode(r io.Reader) error {
 		return err
 	}
 
-	return nil
+	// Legacy records don't carry the fee we paid to sweep the HTLC, nor
+	// its expiry.
+	if version <= legacyResolverRecordVersion {
+		return nil
+	}
+
+	var sweepFee int64
+	if err := binary.Read(r, endian, &sweepFee); err != nil {
+		return err
+	}
+	h.sweepFee = btcutil.Amount(sweepFee)
+
+	return binary.Read(r, endian, &h.expiry)
 }
 
 // AttachResolverKit should be called once a resolved is successfully decoded
@@ -689,6 +1594,53 @@ func (h *htlcSuccessResolver) AttachResolverKit(r ResolverKit) {
 	h.ResolverKit = r
 }
 
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcSuccessResolver) report() *ContractReport {
+	amt := btcutil.Amount(h.htlcResolution.SweepSignDesc.Output.Value)
+	report := &ContractReport{
+		Outpoint: h.htlcResolution.ClaimOutpoint,
+		Type:     ReportOutputIncomingHtlc,
+		Amount:   amt,
+	}
+
+	// If this is our commitment, then the success transaction needs to be
+	// confirmed before the output can be swept. Otherwise, we sweep the
+	// output on the remote party's commitment directly.
+	switch {
+	case h.htlcResolution.SignedSuccessTx != nil:
+		report.Stage = 1
+		if h.outputIncubating {
+			txid := h.htlcResolution.SignedSuccessTx.TxHash()
+			report.SweepTxid = &txid
+		}
+		report.Delegated = h.outputIncubating
+
+	default:
+		report.Stage = 2
+		if h.sweepTx != nil {
+			txid := h.sweepTx.TxHash()
+			report.SweepTxid = &txid
+			report.SweepFee = h.sweepFee
+		}
+	}
+
+	switch {
+	case h.resolved && h.claimedByRemote:
+		report.SweepTxid = nil
+		report.SweepFee = 0
+
+	case h.resolved:
+		report.RecoveredBalance = amt
+
+	default:
+		report.LimboBalance = amt
+	}
+
+	return report
+}
+
 // A compile time assertion to ensure htlcSuccessResolver meets the
 // ContractResolver interface.
 var _ ContractResolver = (*htlcSuccessResolver)(nil)
@@ -724,90 +1676,14 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		return nil, nil
 	}
 
-	// claimCleanUp is a helper function that's called once the HTLC output
-	// is spent by the remote party. It'll extract the preimage, add it to
-	// the global cache, and finally send the appropriate clean up message.
-	claimCleanUp := func(commitSpend *chainntnfs.SpendDetail) (ContractResolver, error) {
-		// Depending on if this is our commitment or not, then we'll be
-		// looking for a different witness pattern.
-		spenderIndex := commitSpend.SpenderInputIndex
-		spendingInput := commitSpend.SpendingTx.TxIn[spenderIndex]
-
-		log.Infof("%T(%v): extracting preimage! remote party spent "+
-			"HTLC with tx=%v", h, h.htlcResolution.ClaimOutpoint,
-			spew.Sdump(commitSpend.SpendingTx))
-
-		// If this is the remote party's commitment, then we'll be
-		// looking for them to spend using the second-level success
-		// transaction.
-		var preimage [32]byte
-		if h.htlcResolution.SignedTimeoutTx == nil {
-			// The witness stack when the remote party sweeps the
-			// output to them looks like:
-			//
-			//  * <sender sig> <recvr sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[3])
-		} else {
-			// Otherwise, they'll be spending directly from our
-			// commitment output. In which case the witness stack
-			// looks like:
-			//
-			//  * <sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[1])
-		}
-
-		log.Infof("%T(%v): extracting preimage=%x from on-chain "+
-			"spend!", h, h.htlcResolution.ClaimOutpoint, preimage[:])
-
-		// With the preimage obtained, we can now add it to the global
-		// cache.
-		if err := h.PreimageDB.AddPreimage(preimage[:]); err != nil {
-			log.Errorf("%T(%v): unable to add witness to cache",
-				h, h.htlcResolution.ClaimOutpoint)
-		}
-
-		// Finally, we'll send the clean up message, mark ourselves as
-		// resolved, then exit.
-		if err := h.DeliverResolutionMsg(ResolutionMsg{
-			SourceChan: h.ShortChanID,
-			HtlcIndex:  h.htlcIndex,
-			PreImage:   &preimage,
-		}); err != nil {
-			return nil, err
-		}
-		h.resolved = true
-		return nil, h.Checkpoint(h)
-	}
-
 	// Otherwise, we'll watch for two external signals to decide if we'll
 	// morph into another resolver, or fully resolve the contract.
 
 	// The output we'll be watching for is the *direct* spend from the HTLC
-	// output. If this isn't our commitment transaction, it'll be right on
-	// the resolution. Otherwise, we fetch this pointer from the input of
-	// the time out transaction.
-	var (
-		outPointToWatch wire.OutPoint
-		scriptToWatch   []byte
-		err             error
-	)
-	if h.htlcResolution.SignedTimeoutTx == nil {
-		outPointToWatch = h.htlcResolution.ClaimOutpoint
-		scriptToWatch = h.htlcResolution.SweepSignDesc.Output.PkScript
-	} else {
-		// If this is the remote party's commitment, then we'll need to
-		// grab watch the output that our timeout transaction points
-		// to. We can directly grab the outpoint, then also extract the
-		// witness script (the last element of the witness stack) to
-		// re-construct the pkScipt we need to watch.
-		outPointToWatch = h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
-		witness := h.htlcResolution.SignedTimeoutTx.TxIn[0].Witness
-		scriptToWatch, err = lnwallet.WitnessScriptHash(
-			witness[len(witness)-1],
-		)
-		if err != nil {
-			return nil, err
-		}
+	// output.
+	outPointToWatch, scriptToWatch, err := h.htlcOutputToWatch()
+	if err != nil {
+		return nil, err
 	}
 
 	// First, we'll register for a spend notification for this output. If
@@ -829,7 +1705,7 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		}
 
 		// TODO(roasbeef): Checkpoint?
-		return claimCleanUp(commitSpend)
+		return h.claimCleanUp(commitSpend)
 
 	// If it hasn't, then we'll watch for both the expiration, and the
 	// sweeping out this output.
@@ -899,7 +1775,7 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 			// party is by revealing the preimage. So we'll perform
 			// our duties to clean up the contract once it has been
 			// claimed.
-			return claimCleanUp(commitSpend)
+			return h.claimCleanUp(commitSpend)
 
 		case <-h.Quit:
 			return nil, fmt.Errorf("resolver cancelled")
@@ -948,6 +1824,24 @@ func (h *htlcOutgoingContestResolver) AttachResolverKit(r ResolverKit) {
 	h.ResolverKit = r
 }
 
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcOutgoingContestResolver) report() *ContractReport {
+	report := h.htlcTimeoutResolver.report()
+
+	// While contested, we haven't broadcast anything yet. If the contest
+	// is resolved, then the remote party swept the output with the
+	// preimage, so nothing was recovered on-chain.
+	report.SweepTxid = nil
+	report.RecoveredBalance = 0
+	if h.resolved {
+		report.LimboBalance = 0
+	}
+
+	return report
+}
+
 // A compile time assertion to ensure htlcOutgoingContestResolver meets the
 // ContractResolver interface.
 var _ ContractResolver = (*htlcOutgoingContestResolver)(nil)
@@ -1037,6 +1931,10 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		}
 
 		copy(h.htlcResolution.Preimage[:], preimage[:])
+
+		// The inner resolver will need to claim the HTLC before it
+		// expires.
+		h.expiry = h.htlcExpiry
 	}
 
 	// If the HTLC hasn't expired yet, then we may still be able to claim
@@ -1145,13 +2043,23 @@ func (h *htlcIncomingContestResolver) Encode(w io.Writer) error {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcIncomingContestResolver) Decode(r io.Reader) error {
+	return h.decodeVersion(r, resolverRecordVersion)
+}
+
+// decodeVersion decodes the resolver from the body of a resolver record of the
+// passed version.
+//
+// NOTE: Part of the versionedContractResolver interface.
+func (h *htlcIncomingContestResolver) decodeVersion(r io.Reader,
+	version uint8) error {
+
 	// We'll first read the one field unique to this resolver.
 	if err := binary.Read(r, endian, &h.htlcExpiry); err != nil {
 		return err
 	}
 
 	// Then we'll decode our internal resolver.
-	return h.htlcSuccessResolver.Decode(r)
+	return h.htlcSuccessResolver.decodeVersion(r, version)
 }
 
 // AttachResolverKit should be called once a resolved is successfully decoded
@@ -1163,6 +2071,26 @@ func (h *htlcIncomingContestResolver) AttachResolverKit(r ResolverKit) {
 	h.ResolverKit = r
 }
 
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcIncomingContestResolver) report() *ContractReport {
+	report := h.htlcSuccessResolver.report()
+
+	// Until we learn of the preimage, the expiry of the HTLC is the
+	// deadline after which we can no longer claim it. If the contest is
+	// resolved, then the HTLC timed out and the funds went to the remote
+	// party.
+	report.MaturityHeight = h.htlcExpiry
+	report.SweepTxid = nil
+	report.RecoveredBalance = 0
+	if h.resolved {
+		report.LimboBalance = 0
+	}
+
+	return report
+}
+
 // A compile time assertion to ensure htlcIncomingContestResolver meets the
 // ContractResolver interface.
 var _ ContractResolver = (*htlcIncomingContestResolver)(nil)
@@ -1187,11 +2115,23 @@ type commitSweepResolver struct {
 	// chanPoint is the channel point of the original contract.
 	chanPoint wire.OutPoint
 
+	// outputIncubating is true if the commitment output on the remote
+	// party's commitment has been handed to the utxo nursery, rather than
+	// being swept by the resolver itself.
+	outputIncubating bool
+
 	// sweepTx is the fully signed transaction which when broadcast, will
 	// sweep the commitment output into an output under control by the
 	// source wallet.
 	sweepTx *wire.MsgTx
 
+	// sweepFee is the portion of the fee of sweepTx that's attributed to
+	// the commitment output.
+	sweepFee btcutil.Amount
+
+	// policy bounds the resources spent sweeping the commitment output.
+	policy resolverPolicy
+
 	ResolverKit
 }
 
@@ -1246,50 +2186,35 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	// resolution isn't zero.
 	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
 
-	switch {
-	// If the sweep transaction isn't already generated, and the remote
-	// party broadcast the commitment transaction then we'll create it now.
-	case c.sweepTx == nil && !isLocalCommitTx:
-		// Now that the commitment transaction has confirmed, we'll
-		// craft a transaction to sweep this output into the wallet.
-		signDesc := c.commitResolution.SelfOutputSignDesc
+	// We'll sweep the output on the remote party's commitment ourselves,
+	// unless it's been, or should be, handed to the nursery.
+	selfSweep := !isLocalCommitTx && !c.outputIncubating &&
+		!c.sweepViaNursery()
 
-		// First, we'll estimate the total weight so we can compute
-		// fees properly. We'll use a lax estimate, as this output is
-		// in no immediate danger.
-		feePerKw, err := c.FeeEstimator.EstimateFeePerKW(6)
+	// Should our sweep conflict with the spend of another output it was
+	// batched with, then we'll rebuild it to sweep our output alone.
+	sweepFees := make(map[*wire.MsgTx]btcutil.Amount)
+	rebuildSweep := func() (*wire.MsgTx, error) {
+		tx, fee, err := c.craftSweepTx(true)
 		if err != nil {
 			return nil, err
 		}
+		sweepFees[tx] = fee
 
-		log.Debugf("%T(%v): using %v sat/kw for sweep tx", c,
-			c.chanPoint, int64(feePerKw))
+		log.Infof("%T(%v): rebuilt commit sweep tx=%v", c, c.chanPoint,
+			spew.Sdump(tx))
 
-		totalWeight := (&lnwallet.TxWeightEstimator{}).
-			AddP2WKHInput().
-			AddP2WKHOutput().Weight()
-		totalFees := feePerKw.FeeForWeight(int64(totalWeight))
-		sweepAmt := signDesc.Output.Value - int64(totalFees)
+		return tx, nil
+	}
 
-		c.sweepTx = wire.NewMsgTx(2)
-		c.sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: c.commitResolution.SelfOutPoint,
-		})
-		sweepAddr, err := c.NewSweepAddr()
-		if err != nil {
-			return nil, err
-		}
-		c.sweepTx.AddTxOut(&wire.TxOut{
-			PkScript: sweepAddr,
-			Value:    sweepAmt,
-		})
-
-		// With the transaction fully assembled, we can now generate a
-		// valid witness for the transaction.
-		signDesc.SigHashes = txscript.NewTxSigHashes(c.sweepTx)
-		c.sweepTx.TxIn[0].Witness, err = lnwallet.CommitSpendNoDelay(
-			c.Signer, &signDesc, c.sweepTx,
-		)
+	switch {
+	// If the sweep transaction isn't already generated, and the remote
+	// party broadcast the commitment transaction then we'll create it now.
+	case c.sweepTx == nil && selfSweep:
+		// Now that the commitment transaction has confirmed, we'll
+		// craft a transaction to sweep this output into the wallet,
+		// along with any others that are maturing at the same time.
+		c.sweepTx, c.sweepFee, err = c.craftSweepTx(false)
 		if err != nil {
 			return nil, err
 		}
@@ -1299,25 +2224,62 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 		// Finally, we'll broadcast the sweep transaction to the
 		// network.
-		if err := c.PublishTx(c.sweepTx); err != nil {
+		sweepFees[c.sweepTx] = c.sweepFee
+		sweepTx, spend, err := c.publishSweep(
+			c.sweepTx, 0, &c.commitResolution.SelfOutPoint,
+			c.commitResolution.SelfOutputSignDesc.Output.PkScript,
+			c.broadcastHeight, rebuildSweep,
+		)
+		if err != nil {
 			log.Errorf("%T(%v): unable to publish sweep tx: %v",
 				c, c.chanPoint, err)
 			return nil, err
 		}
 
+		// As the output pays to a key of ours without a delay, a
+		// foreign spend can only be one of our own making, which
+		// we'll consider to be our sweep transaction.
+		if spend != nil {
+			log.Infof("%T(%v): commit output swept by txid=%v",
+				c, c.chanPoint, spend.SpenderTxHash)
+
+			sweepTx = spend.SpendingTx
+		}
+		c.sweepTx, c.sweepFee = sweepTx, sweepFees[sweepTx]
+
 		// With the sweep transaction confirmed, we'll now Checkpoint
 		// our state.
 		if err := c.Checkpoint(c); err != nil {
 			log.Errorf("unable to Checkpoint: %v", err)
 		}
 
-	// Otherwise, this is our commitment transaction, So we'll obtain the
-	// sweep transaction once the commitment output has been spent.
-	case c.sweepTx == nil && isLocalCommitTx:
-		// Otherwise, if we're dealing with our local commitment
-		// transaction, then the output we need to sweep has been sent
-		// to the nursery for incubation. In this case, we'll wait
-		// until the commitment output has been spent.
+	// Otherwise, this is our commitment transaction, or the output is
+	// swept by the nursery, so we'll obtain the sweep transaction once the
+	// commitment output has been spent.
+	case c.sweepTx == nil:
+		// If this is the remote party's commitment, then we'll first
+		// hand the output to the nursery, unless we already did so.
+		if !isLocalCommitTx && !c.outputIncubating {
+			log.Infof("%T(%v): incubating commit output", c,
+				c.chanPoint)
+
+			err := c.IncubateOutputs(
+				c.chanPoint, &c.commitResolution, nil, nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			c.outputIncubating = true
+
+			if err := c.Checkpoint(c); err != nil {
+				log.Errorf("unable to Checkpoint: %v", err)
+			}
+		}
+
+		// The output we need to sweep has been sent to the nursery
+		// for incubation. In this case, we'll wait until the
+		// commitment output has been spent.
 		spendNtfn, err := c.Notifier.RegisterSpendNtfn(
 			&c.commitResolution.SelfOutPoint,
 			c.commitResolution.SelfOutputSignDesc.Output.PkScript,
@@ -1357,26 +2319,15 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	// Now we'll wait until the sweeping transaction has been fully
 	// confirmed.  Once it's confirmed, we can mark this contract resolved.
-	sweepTXID := c.sweepTx.TxHash()
-	sweepingScript := c.sweepTx.TxOut[0].PkScript
-	confNtfn, err = c.Notifier.RegisterConfirmationsNtfn(
-		&sweepTXID, sweepingScript, 1, c.broadcastHeight,
+	confInfo, err := c.waitForCommitSweepConf(
+		selfSweep, sweepFees, rebuildSweep,
 	)
 	if err != nil {
 		return nil, err
 	}
-	select {
-	case confInfo, ok := <-confNtfn.Confirmed:
-		if !ok {
-			return nil, fmt.Errorf("quitting")
-		}
 
-		log.Infof("ChannelPoint(%v) commit tx is fully resolved, at height: %v",
-			c.chanPoint, confInfo.BlockHeight)
-
-	case <-c.Quit:
-		return nil, fmt.Errorf("quitting")
-	}
+	log.Infof("ChannelPoint(%v) commit tx is fully resolved, at height: %v",
+		c.chanPoint, confInfo.BlockHeight)
 
 	// Once the transaction has received a sufficient number of
 	// confirmations, we'll mark ourselves as fully resolved and exit.
@@ -1384,6 +2335,83 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	return nil, c.Checkpoint(c)
 }
 
+// waitForCommitSweepConf waits for the sweep of the commitment output to be
+// sufficiently confirmed. If we swept the output ourselves, then the sweep is
+// rebuilt with rebuild should it conflict with the spend of another output it
+// was batched with.
+func (c *commitSweepResolver) waitForCommitSweepConf(selfSweep bool,
+	sweepFees map[*wire.MsgTx]btcutil.Amount,
+	rebuild func() (*wire.MsgTx, error)) (*chainntnfs.TxConfirmation,
+	error) {
+
+	// A sweep obtained from the spend of the output already spends it, so
+	// there's nothing for us to rebuild.
+	if !selfSweep {
+		sweepTXID := c.sweepTx.TxHash()
+		confInfo, _, err := c.waitForClaimConf(
+			&sweepTXID, c.sweepTx.TxOut[0].PkScript,
+			c.broadcastHeight, nil, nil, func() error {
+				return c.publishClaim(c.sweepTx, 0)
+			},
+		)
+		return confInfo, err
+	}
+
+	for {
+		sweepFees[c.sweepTx] = c.sweepFee
+		sweepTx, confInfo, spend, err := c.waitForSweepConf(
+			c.sweepTx, 0, &c.commitResolution.SelfOutPoint,
+			c.commitResolution.SelfOutputSignDesc.Output.PkScript,
+			c.broadcastHeight, rebuild,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// As the output pays to a key of ours without a delay, a
+		// foreign spend can only be one of our own making, which we'll
+		// consider to be our sweep transaction, and wait for it to
+		// confirm in turn.
+		if spend != nil {
+			log.Infof("%T(%v): commit output swept by txid=%v",
+				c, c.chanPoint, spend.SpenderTxHash)
+
+			sweepTx = spend.SpendingTx
+		}
+		if sweepTx != c.sweepTx {
+			c.sweepTx, c.sweepFee = sweepTx, sweepFees[sweepTx]
+			if err := c.Checkpoint(c); err != nil {
+				log.Errorf("unable to Checkpoint: %v", err)
+			}
+		}
+
+		if spend == nil {
+			return confInfo, nil
+		}
+	}
+}
+
+// craftSweepTx crafts a transaction sweeping the commitment output on the
+// remote party's commitment. Unless alone is set, the output is batched with
+// those of other resolvers.
+func (c *commitSweepResolver) craftSweepTx(alone bool) (*wire.MsgTx,
+	btcutil.Amount, error) {
+
+	return c.sweepOutput(
+		c.commitResolution.SelfOutPoint,
+		&c.commitResolution.SelfOutputSignDesc,
+		lnwallet.P2WKHWitnessSize,
+		func(tx *wire.MsgTx, signDesc *lnwallet.SignDescriptor) (
+			wire.TxWitness, error) {
+
+			return lnwallet.CommitSpendNoDelay(
+				c.Signer, signDesc, tx,
+			)
+		},
+		c.policy.maxFee, 0, alone,
+	)
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -1415,11 +2443,13 @@ func (c *commitSweepResolver) Encode(w io.Writer) error {
 	if err := binary.Write(w, endian, c.broadcastHeight); err != nil {
 		return err
 	}
-	if _, err := w.Write(c.chanPoint.Hash[:]); err != nil {
+	if err := lnwallet.WriteOutPoint(w, &c.chanPoint); err != nil {
 		return err
 	}
-	err := binary.Write(w, endian, c.chanPoint.Index)
-	if err != nil {
+	if err := binary.Write(w, endian, int64(c.sweepFee)); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, c.outputIncubating); err != nil {
 		return err
 	}
 
@@ -1435,6 +2465,14 @@ func (c *commitSweepResolver) Encode(w io.Writer) error {
 //
 // NOTE: Part of the ContractResolver interface.
 func (c *commitSweepResolver) Decode(r io.Reader) error {
+	return c.decodeVersion(r, resolverRecordVersion)
+}
+
+// decodeVersion decodes the resolver from the body of a resolver record of the
+// passed version.
+//
+// NOTE: Part of the versionedContractResolver interface.
+func (c *commitSweepResolver) decodeVersion(r io.Reader, version uint8) error {
 	if err := decodeCommitResolution(r, &c.commitResolution); err != nil {
 		return err
 	}
@@ -1445,13 +2483,23 @@ func (c *commitSweepResolver) Decode(r io.Reader) error {
 	if err := binary.Read(r, endian, &c.broadcastHeight); err != nil {
 		return err
 	}
-	_, err := io.ReadFull(r, c.chanPoint.Hash[:])
-	if err != nil {
+	if err := lnwallet.ReadOutPoint(r, &c.chanPoint); err != nil {
 		return err
 	}
-	err = binary.Read(r, endian, &c.chanPoint.Index)
-	if err != nil {
-		return err
+
+	// Only records newer than the legacy ones carry the fee we paid to
+	// sweep the output, and whether we handed it to the nursery.
+	if version > legacyResolverRecordVersion {
+		var sweepFee int64
+		if err := binary.Read(r, endian, &sweepFee); err != nil {
+			return err
+		}
+		c.sweepFee = btcutil.Amount(sweepFee)
+
+		err := binary.Read(r, endian, &c.outputIncubating)
+		if err != nil {
+			return err
+		}
 	}
 
 	txBytes, err := ioutil.ReadAll(r)
@@ -1482,6 +2530,38 @@ func (c *commitSweepResolver) AttachResolverKit(r ResolverKit) {
 	c.ResolverKit = r
 }
 
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (c *commitSweepResolver) report() *ContractReport {
+	amt := btcutil.Amount(
+		c.commitResolution.SelfOutputSignDesc.Output.Value,
+	)
+	report := &ContractReport{
+		Outpoint: c.commitResolution.SelfOutPoint,
+		Type:     ReportOutputCommit,
+		Amount:   amt,
+
+		// Outputs on our commitment are always swept by the nursery.
+		Delegated: c.commitResolution.MaturityDelay != 0 ||
+			c.outputIncubating,
+	}
+
+	if c.sweepTx != nil {
+		txid := c.sweepTx.TxHash()
+		report.SweepTxid = &txid
+		report.SweepFee = c.sweepFee
+	}
+
+	if c.resolved {
+		report.RecoveredBalance = amt
+	} else {
+		report.LimboBalance = amt
+	}
+
+	return report
+}
+
 // A compile time assertion to ensure commitSweepResolver meets the
 // ContractResolver interface.
 var _ ContractResolver = (*commitSweepResolver)(nil)
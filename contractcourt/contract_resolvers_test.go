@@ -1 +1,950 @@
 package contractcourt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// mockConfNotifier is a mockNotifier that hands out a single confirmation
+// event and block epoch stream, both driven by the test.
+type mockConfNotifier struct {
+	mockNotifier
+
+	confEvent *chainntnfs.ConfirmationEvent
+	epochChan chan *chainntnfs.BlockEpoch
+}
+
+func (m *mockConfNotifier) RegisterConfirmationsNtfn(*chainhash.Hash, []byte,
+	uint32, uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	return m.confEvent, nil
+}
+
+func (m *mockConfNotifier) RegisterBlockEpochNtfn(
+	*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochChan,
+		Cancel: func() {},
+	}, nil
+}
+
+// mockHintCache is an in-memory chainntnfs.ConfirmHintCache.
+type mockHintCache struct {
+	sync.Mutex
+	hints map[chainhash.Hash]uint32
+}
+
+func newMockHintCache() *mockHintCache {
+	return &mockHintCache{
+		hints: make(map[chainhash.Hash]uint32),
+	}
+}
+
+func (m *mockHintCache) CommitConfirmHint(height uint32,
+	txids ...chainhash.Hash) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, txid := range txids {
+		m.hints[txid] = height
+	}
+	return nil
+}
+
+func (m *mockHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	hint, ok := m.hints[txid]
+	if !ok {
+		return 0, chainntnfs.ErrConfirmHintNotFound
+	}
+	return hint, nil
+}
+
+func (m *mockHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, txid := range txids {
+		delete(m.hints, txid)
+	}
+	return nil
+}
+
+// TestWaitForClaimConfReorg tests that a claim transaction that's reorged out
+// of the chain before reaching a sufficient depth is rebroadcast, and only
+// considered confirmed once it has re-confirmed deep enough. The height at
+// which it confirmed should be cached meanwhile.
+func TestWaitForClaimConfReorg(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockConfNotifier{
+		confEvent: &chainntnfs.ConfirmationEvent{
+			Confirmed:    make(chan *chainntnfs.TxConfirmation, 1),
+			NegativeConf: make(chan int32, 1),
+		},
+		epochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+	rebroadcasts := make(chan struct{}, 1)
+	hintCache := newMockHintCache()
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				Notifier:       notifier,
+				ClaimConfDepth: 3,
+				ConfirmHints:   hintCache,
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	type confResult struct {
+		conf *chainntnfs.TxConfirmation
+		err  error
+	}
+	results := make(chan confResult, 1)
+	claimTx := wire.NewMsgTx(2)
+	claimTxid := claimTx.TxHash()
+	go func() {
+		conf, _, err := kit.waitForClaimConf(
+			&claimTxid, nil, 0, nil, nil, func() error {
+				rebroadcasts <- struct{}{}
+				return nil
+			},
+		)
+		results <- confResult{conf, err}
+	}()
+
+	sendEpoch := func(height int32) {
+		select {
+		case notifier.epochChan <- &chainntnfs.BlockEpoch{
+			Height: height,
+		}:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block epoch for height %v not consumed", height)
+		}
+	}
+	assertPending := func() {
+		select {
+		case res := <-results:
+			t.Fatalf("claim considered confirmed prematurely: %v",
+				res.err)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	assertHint := func(expected uint32) {
+		t.Helper()
+
+		hint := kit.claimConfHint(&claimTxid, 10)
+		if hint != expected {
+			t.Fatalf("expected claim height hint %v, got %v",
+				expected, hint)
+		}
+	}
+
+	// The claim confirms at height 100, and is then reorged out of the
+	// chain at height 101, before reaching the required depth.
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: 100,
+	}
+	sendEpoch(101)
+	assertPending()
+	assertHint(100)
+
+	notifier.confEvent.NegativeConf <- 2
+	select {
+	case <-rebroadcasts:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("claim tx not rebroadcast after reorg")
+	}
+
+	// The claim is now pending again, so reaching the original depth
+	// shouldn't resolve it, and the height it confirmed at should no
+	// longer be used as its hint.
+	sendEpoch(102)
+	assertPending()
+	assertHint(10)
+
+	// Once it re-confirms, it should only be considered confirmed after
+	// reaching the required depth.
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: 102,
+	}
+	sendEpoch(103)
+	assertPending()
+	sendEpoch(104)
+
+	select {
+	case res := <-results:
+		if res.err != nil {
+			t.Fatalf("unable to wait for claim conf: %v", res.err)
+		}
+		if res.conf.BlockHeight != 102 {
+			t.Fatalf("expected conf at height 102, got %v",
+				res.conf.BlockHeight)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("claim not considered confirmed")
+	}
+
+	// With the claim buried deep enough, its hint is no longer needed.
+	assertHint(10)
+}
+
+// mockMempoolNotifier is a mockConfNotifier that's also able to notify of
+// transactions accepted into the mempool.
+type mockMempoolNotifier struct {
+	mockConfNotifier
+
+	mempoolEvent *chainntnfs.MempoolEvent
+	mempoolErr   error
+}
+
+func (m *mockMempoolNotifier) RegisterMempoolNtfn(
+	*chainhash.Hash) (*chainntnfs.MempoolEvent, error) {
+
+	if m.mempoolErr != nil {
+		return nil, m.mempoolErr
+	}
+
+	return m.mempoolEvent, nil
+}
+
+// TestWaitForClaimConfMempool tests that a claim transaction being accepted
+// into the mempool is recorded, but isn't considered confirmed until it has
+// actually confirmed.
+func TestWaitForClaimConfMempool(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockMempoolNotifier{
+		mockConfNotifier: mockConfNotifier{
+			confEvent: &chainntnfs.ConfirmationEvent{
+				Confirmed:    make(chan *chainntnfs.TxConfirmation, 1),
+				NegativeConf: make(chan int32, 1),
+			},
+			epochChan: make(chan *chainntnfs.BlockEpoch),
+		},
+		mempoolEvent: &chainntnfs.MempoolEvent{
+			Seen:   make(chan struct{}, 1),
+			Cancel: func() {},
+		},
+	}
+	events := make(chan ArbitratorEventType, 1)
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				Notifier:       notifier,
+				ClaimConfDepth: 1,
+			},
+		},
+		LogEvent: func(eventType ArbitratorEventType,
+			_ *chainhash.Hash, _ string, _ ...interface{}) {
+
+			events <- eventType
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	results := make(chan error, 1)
+	claimTx := wire.NewMsgTx(2)
+	claimTxid := claimTx.TxHash()
+	go func() {
+		_, _, err := kit.waitForClaimConf(
+			&claimTxid, nil, 0, nil, nil, nil,
+		)
+		results <- err
+	}()
+
+	// Once the claim is seen within the mempool, the event should be
+	// recorded, while the claim is still pending.
+	notifier.mempoolEvent.Seen <- struct{}{}
+	select {
+	case eventType := <-events:
+		if eventType != EventClaimInMempool {
+			t.Fatalf("expected mempool event, got %v", eventType)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("mempool event not recorded")
+	}
+
+	select {
+	case err := <-results:
+		t.Fatalf("claim considered confirmed while in mempool: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: 100,
+	}
+	select {
+	case err := <-results:
+		if err != nil {
+			t.Fatalf("unable to wait for claim conf: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("claim not considered confirmed")
+	}
+}
+
+// TestWaitForClaimConfMempoolRebroadcast tests that an unconfirmed claim
+// transaction that goes without being seen entering the mempool is
+// rebroadcast, and that failing to watch the mempool doesn't prevent waiting
+// for the claim to confirm.
+func TestWaitForClaimConfMempoolRebroadcast(t *testing.T) {
+	t.Parallel()
+
+	newNotifier := func(mempoolErr error) *mockMempoolNotifier {
+		return &mockMempoolNotifier{
+			mockConfNotifier: mockConfNotifier{
+				confEvent: &chainntnfs.ConfirmationEvent{
+					Confirmed: make(
+						chan *chainntnfs.TxConfirmation, 1,
+					),
+					NegativeConf: make(chan int32, 1),
+				},
+				epochChan: make(chan *chainntnfs.BlockEpoch),
+			},
+			mempoolEvent: &chainntnfs.MempoolEvent{
+				Seen:   make(chan struct{}, 1),
+				Cancel: func() {},
+			},
+			mempoolErr: mempoolErr,
+		}
+	}
+
+	waitForClaimConf := func(notifier *mockMempoolNotifier,
+		rebroadcasts chan struct{}) chan error {
+
+		kit := &ResolverKit{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ChainArbitratorConfig: ChainArbitratorConfig{
+					Notifier:       notifier,
+					ClaimConfDepth: 1,
+				},
+			},
+			Quit: make(chan struct{}),
+		}
+
+		results := make(chan error, 1)
+		claimTxid := wire.NewMsgTx(2).TxHash()
+		go func() {
+			_, _, err := kit.waitForClaimConf(
+				&claimTxid, nil, 0, nil, nil, func() error {
+					rebroadcasts <- struct{}{}
+					return nil
+				},
+			)
+			results <- err
+		}()
+
+		return results
+	}
+
+	sendEpochs := func(notifier *mockMempoolNotifier, num int) {
+		for i := 0; i < num; i++ {
+			select {
+			case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block epoch not consumed")
+			}
+		}
+	}
+	sendSeen := func(notifier *mockMempoolNotifier) {
+		t.Helper()
+
+		// We'll wait for the notification to be consumed, such that
+		// it's handled before any block epoch sent afterwards.
+		notifier.mempoolEvent.Seen <- struct{}{}
+		for i := 0; len(notifier.mempoolEvent.Seen) != 0; i++ {
+			if i == 500 {
+				t.Fatalf("mempool notification not consumed")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	assertRebroadcasts := func(rebroadcasts chan struct{}, num int) {
+		t.Helper()
+
+		// The final epoch sent may still be processed, so we'll give
+		// it time to trigger a rebroadcast.
+		time.Sleep(50 * time.Millisecond)
+		if len(rebroadcasts) != num {
+			t.Fatalf("expected %v rebroadcasts, got %v", num,
+				len(rebroadcasts))
+		}
+	}
+	assertConfirmed := func(notifier *mockMempoolNotifier,
+		results chan error) {
+
+		t.Helper()
+
+		notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+			BlockHeight: 100,
+		}
+		select {
+		case err := <-results:
+			if err != nil {
+				t.Fatalf("unable to wait for claim conf: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("claim not considered confirmed")
+		}
+	}
+
+	// The claim goes unseen within the mempool, so it should be
+	// rebroadcast once it's been missing for long enough.
+	notifier := newNotifier(nil)
+	rebroadcasts := make(chan struct{}, 10)
+	results := waitForClaimConf(notifier, rebroadcasts)
+
+	sendEpochs(notifier, claimMempoolRebroadcastBlocks-1)
+	assertRebroadcasts(rebroadcasts, 0)
+	sendEpochs(notifier, 1)
+	assertRebroadcasts(rebroadcasts, 1)
+
+	// Once seen within the mempool, the count restarts, so a claim that
+	// remains within the mempool is only rebroadcast each
+	// claimMempoolRebroadcastBlocks blocks, allowing us to detect its
+	// eviction.
+	sendSeen(notifier)
+	sendEpochs(notifier, claimMempoolRebroadcastBlocks-1)
+	assertRebroadcasts(rebroadcasts, 1)
+	sendSeen(notifier)
+	sendEpochs(notifier, claimMempoolRebroadcastBlocks-1)
+	assertRebroadcasts(rebroadcasts, 1)
+	sendEpochs(notifier, 1)
+	assertRebroadcasts(rebroadcasts, 2)
+	assertConfirmed(notifier, results)
+
+	// If we're unable to watch the mempool, we should still wait for the
+	// claim to confirm, without rebroadcasting it.
+	notifier = newNotifier(fmt.Errorf("unable to watch mempool"))
+	rebroadcasts = make(chan struct{}, 10)
+	results = waitForClaimConf(notifier, rebroadcasts)
+
+	select {
+	case err := <-results:
+		t.Fatalf("claim wait aborted: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	assertConfirmed(notifier, results)
+	assertRebroadcasts(rebroadcasts, 0)
+}
+
+// mockWitnessBeacon is a WitnessBeacon that records the preimages added to
+// it.
+type mockWitnessBeacon struct {
+	preimages map[[32]byte][]byte
+}
+
+func (m *mockWitnessBeacon) SubscribeUpdates() *WitnessSubscription {
+	return &WitnessSubscription{
+		WitnessUpdates:     make(chan []byte),
+		CancelSubscription: func() {},
+	}
+}
+
+func (m *mockWitnessBeacon) LookupPreimage(payHash []byte) ([]byte, bool) {
+	var hash [32]byte
+	copy(hash[:], payHash)
+
+	preimage, ok := m.preimages[hash]
+	return preimage, ok
+}
+
+func (m *mockWitnessBeacon) AddPreimage(preimage []byte) error {
+	m.preimages[sha256.Sum256(preimage)] = preimage
+	return nil
+}
+
+// TestHtlcTimeoutResolverPreimageSpend tests that if the remote party sweeps
+// an expired outgoing HTLC with the preimage before we time it out, the
+// preimage is added to the preimage store, the HTLC is settled backwards, and
+// the output is removed from the utxo nursery.
+func TestHtlcTimeoutResolverPreimageSpend(t *testing.T) {
+	t.Parallel()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	beacon := &mockWitnessBeacon{
+		preimages: make(map[[32]byte][]byte),
+	}
+	resolutionMsgs := make(chan ResolutionMsg, 1)
+	var removedOutputs []wire.OutPoint
+
+	resolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:        100,
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+		outputIncubating: true,
+		htlcIndex:        5,
+		ResolverKit: ResolverKit{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ChainArbitratorConfig: ChainArbitratorConfig{
+					Notifier: &mockNotifier{
+						spendChan: spendChan,
+					},
+					PreimageDB: beacon,
+					DeliverResolutionMsg: func(
+						msgs ...ResolutionMsg) error {
+
+						resolutionMsgs <- msgs[0]
+						return nil
+					},
+					RemoveIncubatingOutput: func(_,
+						op wire.OutPoint) error {
+
+						removedOutputs = append(
+							removedOutputs, op,
+						)
+						return nil
+					},
+				},
+			},
+			Checkpoint: func(ContractResolver) error {
+				return nil
+			},
+			Quit: make(chan struct{}),
+		},
+	}
+
+	// The remote party sweeps the HTLC output on their commitment with
+	// their second-level success transaction, revealing the preimage.
+	preimage := bytes.Repeat([]byte{0x01}, 32)
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: resolver.htlcResolution.ClaimOutpoint,
+		Witness: wire.TxWitness{
+			nil, {0x02}, {0x03}, preimage, {0x04},
+		},
+	})
+	spendTxid := spendTx.TxHash()
+	spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &resolver.htlcResolution.ClaimOutpoint,
+		SpenderTxHash: &spendTxid,
+		SpendingTx:    spendTx,
+	}
+
+	nextResolver, err := resolver.Resolve()
+	if err != nil {
+		t.Fatalf("unable to resolve htlc: %v", err)
+	}
+	if nextResolver != nil {
+		t.Fatalf("expected no further resolver, got %T", nextResolver)
+	}
+	if !resolver.IsResolved() {
+		t.Fatalf("expected resolver to be resolved")
+	}
+
+	// The preimage should have been persisted to the preimage store.
+	payHash := sha256.Sum256(preimage)
+	dbPreimage, ok := beacon.LookupPreimage(payHash[:])
+	if !ok || !bytes.Equal(dbPreimage, preimage) {
+		t.Fatalf("preimage not added to preimage store")
+	}
+
+	// And the HTLC should have been settled backwards.
+	select {
+	case msg := <-resolutionMsgs:
+		if msg.HtlcIndex != resolver.htlcIndex {
+			t.Fatalf("expected htlc index %v, got %v",
+				resolver.htlcIndex, msg.HtlcIndex)
+		}
+		if msg.PreImage == nil || !bytes.Equal(msg.PreImage[:],
+			preimage) {
+
+			t.Fatalf("expected settle with preimage, got %v",
+				msg.PreImage)
+		}
+	default:
+		t.Fatalf("no resolution message delivered")
+	}
+
+	// The nursery should no longer be incubating the output.
+	if len(removedOutputs) != 1 ||
+		removedOutputs[0] != resolver.htlcResolution.ClaimOutpoint {
+
+		t.Fatalf("expected output %v removed from nursery, got %v",
+			resolver.htlcResolution.ClaimOutpoint, removedOutputs)
+	}
+
+	// As the remote party claimed the HTLC, nothing was recovered.
+	report := resolver.report()
+	if report.RecoveredBalance != 0 || report.LimboBalance != 0 {
+		t.Fatalf("unexpected report: %v", report)
+	}
+}
+
+// TestCommitSweepResolverViaNursery tests that if outputs should be swept by
+// the nursery, then the commitment output on the remote party's commitment is
+// handed to it rather than being swept by the resolver, and that the report
+// reflects this.
+func TestCommitSweepResolverViaNursery(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockConfNotifier{
+		mockNotifier: mockNotifier{
+			spendChan: make(chan *chainntnfs.SpendDetail, 1),
+		},
+		confEvent: &chainntnfs.ConfirmationEvent{
+			Confirmed: make(chan *chainntnfs.TxConfirmation, 2),
+		},
+	}
+	incubated := make(chan *lnwallet.CommitOutputResolution, 1)
+
+	resolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       randOutPoint(),
+			SelfOutputSignDesc: testSignDesc,
+		},
+		chanPoint: testChanPoint1,
+		ResolverKit: ResolverKit{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ChanPoint: testChanPoint1,
+				ChainArbitratorConfig: ChainArbitratorConfig{
+					Notifier:       notifier,
+					ClaimConfDepth: 1,
+					NurseryChannels: map[wire.OutPoint]struct{}{
+						testChanPoint1: {},
+					},
+					IncubateOutputs: func(_ wire.OutPoint,
+						commitRes *lnwallet.CommitOutputResolution,
+						_ *lnwallet.OutgoingHtlcResolution,
+						_ *lnwallet.IncomingHtlcResolution) error {
+
+						incubated <- commitRes
+						return nil
+					},
+				},
+			},
+			Checkpoint: func(ContractResolver) error {
+				return nil
+			},
+			Quit: make(chan struct{}),
+		},
+	}
+
+	// The commitment confirms, after which the nursery sweeps the output,
+	// and the sweep confirms in turn.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxOut(&wire.TxOut{PkScript: []byte{0x00}})
+	sweepTxid := sweepTx.TxHash()
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{}
+	notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &sweepTxid,
+		SpendingTx:    sweepTx,
+	}
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{}
+
+	if _, err := resolver.Resolve(); err != nil {
+		t.Fatalf("unable to resolve commit output: %v", err)
+	}
+
+	select {
+	case commitRes := <-incubated:
+		if commitRes.SelfOutPoint !=
+			resolver.commitResolution.SelfOutPoint {
+
+			t.Fatalf("wrong output incubated: %v",
+				commitRes.SelfOutPoint)
+		}
+	default:
+		t.Fatalf("commit output not handed to the nursery")
+	}
+
+	if !resolver.resolved || !resolver.outputIncubating {
+		t.Fatalf("expected resolved and incubating resolver")
+	}
+	if resolver.sweepTx.TxHash() != sweepTxid {
+		t.Fatalf("expected sweep tx %v, got %v", sweepTxid,
+			resolver.sweepTx.TxHash())
+	}
+	if !resolver.report().Delegated {
+		t.Fatalf("expected report to reflect delegation")
+	}
+
+	// Whether the output was handed to the nursery should be persisted
+	// along with the resolver.
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	resolver.resolved = false
+	if err := testLog.InsertUnresolvedContracts(resolver); err != nil {
+		t.Fatalf("unable to insert contract: %v", err)
+	}
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts: %v", err)
+	}
+	if !dbContracts[0].(*commitSweepResolver).outputIncubating {
+		t.Fatalf("expected stored resolver to be incubating")
+	}
+}
+
+// TestPublishSweepConflict tests that a batched sweep conflicting with another
+// spend is rebuilt to sweep the output of the resolver alone, and that if the
+// output itself turns out to be spent, the conflicting spend is returned.
+func TestPublishSweepConflict(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail, 1),
+	}
+	conflicts := make(chan ArbitratorEventType, 2)
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				Notifier: notifier,
+			},
+		},
+		LogEvent: func(eventType ArbitratorEventType,
+			_ *chainhash.Hash, _ string, _ ...interface{}) {
+
+			conflicts <- eventType
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	outpoint := randOutPoint()
+	newSweep := func(numInputs int) *wire.MsgTx {
+		sweepTx := wire.NewMsgTx(2)
+		sweepTx.AddTxIn(&wire.TxIn{PreviousOutPoint: outpoint})
+		for i := 1; i < numInputs; i++ {
+			sweepTx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: randOutPoint(),
+			})
+		}
+		sweepTx.AddTxOut(&wire.TxOut{PkScript: []byte{0x00}})
+		return sweepTx
+	}
+
+	// First, the input of another resolver within the batch conflicts,
+	// so only the batched sweep is rejected.
+	kit.PublishTx = func(tx *wire.MsgTx) error {
+		if len(tx.TxIn) > 1 {
+			return lnwallet.ErrDoubleSpend
+		}
+		return nil
+	}
+	rebuiltTx := newSweep(1)
+	sweepTx, spend, err := kit.publishSweep(
+		newSweep(2), 0, &outpoint, nil, 0, func() (*wire.MsgTx, error) {
+			return rebuiltTx, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to publish sweep: %v", err)
+	}
+	if sweepTx != rebuiltTx || spend != nil {
+		t.Fatalf("expected rebuilt sweep to be published")
+	}
+	if eventType := <-conflicts; eventType != EventSweepConflict {
+		t.Fatalf("expected conflict event, got %v", eventType)
+	}
+
+	// Next, our own output conflicts, so the rebuilt sweep is rejected as
+	// well. We should get back the spend of our output by the remote
+	// party.
+	kit.PublishTx = func(*wire.MsgTx) error {
+		return lnwallet.ErrDoubleSpend
+	}
+	remoteTx := newSweep(1)
+	remoteTx.LockTime = 1
+	remoteTxid := remoteTx.TxHash()
+	notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &outpoint,
+		SpenderTxHash: &remoteTxid,
+		SpendingTx:    remoteTx,
+	}
+
+	rebuilt := false
+	sweepTx, spend, err = kit.publishSweep(
+		newSweep(2), 0, &outpoint, nil, 0, func() (*wire.MsgTx, error) {
+			rebuilt = true
+			return newSweep(1), nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to publish sweep: %v", err)
+	}
+	if !rebuilt {
+		t.Fatalf("expected sweep to be rebuilt")
+	}
+	if sweepTx != nil || spend == nil ||
+		*spend.SpenderTxHash != remoteTxid {
+
+		t.Fatalf("expected spend by remote party to be returned")
+	}
+}
+
+// TestWaitForSweepConfConflict tests that a batched sweep that conflicts with
+// another spend upon being rebroadcast is rebuilt to sweep the output of the
+// resolver alone, and that a spend of the output by a foreign transaction
+// while waiting for the sweep to confirm is returned.
+func TestWaitForSweepConfConflict(t *testing.T) {
+	t.Parallel()
+
+	newKit := func(notifier *mockMempoolNotifier) *ResolverKit {
+		return &ResolverKit{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ChainArbitratorConfig: ChainArbitratorConfig{
+					Notifier:       notifier,
+					ClaimConfDepth: 1,
+					PublishTx: func(tx *wire.MsgTx) error {
+						if len(tx.TxIn) > 1 {
+							return lnwallet.ErrDoubleSpend
+						}
+						return nil
+					},
+				},
+			},
+			LogEvent: func(ArbitratorEventType, *chainhash.Hash,
+				string, ...interface{}) {
+			},
+			Quit: make(chan struct{}),
+		}
+	}
+	newNotifier := func() *mockMempoolNotifier {
+		return &mockMempoolNotifier{
+			mockConfNotifier: mockConfNotifier{
+				mockNotifier: mockNotifier{
+					spendChan: make(
+						chan *chainntnfs.SpendDetail, 1,
+					),
+				},
+				confEvent: &chainntnfs.ConfirmationEvent{
+					Confirmed: make(
+						chan *chainntnfs.TxConfirmation, 1,
+					),
+					NegativeConf: make(chan int32, 1),
+				},
+				epochChan: make(chan *chainntnfs.BlockEpoch),
+			},
+			mempoolEvent: &chainntnfs.MempoolEvent{
+				Seen:   make(chan struct{}, 1),
+				Cancel: func() {},
+			},
+		}
+	}
+
+	outpoint := randOutPoint()
+	newSweep := func(numInputs int) *wire.MsgTx {
+		sweepTx := wire.NewMsgTx(2)
+		sweepTx.AddTxIn(&wire.TxIn{PreviousOutPoint: outpoint})
+		for i := 1; i < numInputs; i++ {
+			sweepTx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: randOutPoint(),
+			})
+		}
+		sweepTx.AddTxOut(&wire.TxOut{PkScript: []byte{0x00}})
+		return sweepTx
+	}
+
+	type sweepResult struct {
+		sweepTx *wire.MsgTx
+		spend   *chainntnfs.SpendDetail
+		err     error
+	}
+	waitForSweepConf := func(kit *ResolverKit,
+		rebuiltTx *wire.MsgTx) chan sweepResult {
+
+		results := make(chan sweepResult, 1)
+		go func() {
+			sweepTx, _, spend, err := kit.waitForSweepConf(
+				newSweep(2), 0, &outpoint, nil, 0,
+				func() (*wire.MsgTx, error) {
+					return rebuiltTx, nil
+				},
+			)
+			results <- sweepResult{sweepTx, spend, err}
+		}()
+
+		return results
+	}
+	sendEpochs := func(notifier *mockMempoolNotifier, num int) {
+		for i := 0; i < num; i++ {
+			select {
+			case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block epoch not consumed")
+			}
+		}
+	}
+	waitForResult := func(results chan sweepResult) sweepResult {
+		t.Helper()
+
+		select {
+		case res := <-results:
+			if res.err != nil {
+				t.Fatalf("unable to wait for sweep conf: %v",
+					res.err)
+			}
+			return res
+		case <-time.After(5 * time.Second):
+			t.Fatalf("sweep wait not completed")
+		}
+		return sweepResult{}
+	}
+
+	// The batched sweep goes unseen within the mempool, as the input of
+	// another resolver within the batch has been spent. Its rebroadcast
+	// conflicts, so it should be rebuilt, and the rebuilt sweep should be
+	// returned once confirmed.
+	notifier := newNotifier()
+	kit := newKit(notifier)
+	rebuiltTx := newSweep(1)
+	results := waitForSweepConf(kit, rebuiltTx)
+
+	sendEpochs(notifier, claimMempoolRebroadcastBlocks)
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHeight: 100,
+	}
+	res := waitForResult(results)
+	if res.sweepTx != rebuiltTx || res.spend != nil {
+		t.Fatalf("expected rebuilt sweep to be confirmed")
+	}
+	close(kit.Quit)
+
+	// Next, our own output is spent by the remote party while we're
+	// waiting for the sweep to confirm. We should get back its spend.
+	notifier = newNotifier()
+	kit = newKit(notifier)
+	results = waitForSweepConf(kit, newSweep(1))
+
+	remoteTx := newSweep(1)
+	remoteTx.LockTime = 1
+	remoteTxid := remoteTx.TxHash()
+	notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &outpoint,
+		SpenderTxHash: &remoteTxid,
+		SpendingTx:    remoteTx,
+	}
+	res = waitForResult(results)
+	if res.sweepTx != nil || res.spend == nil ||
+		*res.spend.SpenderTxHash != remoteTxid {
+
+		t.Fatalf("expected spend by remote party to be returned")
+	}
+	close(kit.Quit)
+}
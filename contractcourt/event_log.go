@@ -0,0 +1,322 @@
+package contractcourt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// arbEventLogBucket is the top-level bucket that houses the event log
+	// of each channel arbitrator. Each channel's events are stored in a
+	// sub-bucket keyed by its log scope. This is kept separate from the
+	// main arbitrator log so the events survive WipeHistory.
+	arbEventLogBucket = []byte("arb-event-log")
+)
+
+// ArbitratorEventType denotes the kind of action recorded within the
+// arbitration event log.
+type ArbitratorEventType uint8
+
+const (
+	// EventStateTransition is logged each time the ChannelArbitrator
+	// commits a new state.
+	EventStateTransition ArbitratorEventType = iota
+
+	// EventTxBroadcast is logged each time a transaction is broadcast in
+	// order to resolve the channel.
+	EventTxBroadcast
+
+	// EventTxConfirmed is logged once a closing transaction for the
+	// channel has been confirmed.
+	EventTxConfirmed
+
+	// EventResolverLaunched is logged each time a contract resolver is
+	// launched.
+	EventResolverLaunched
+
+	// EventResolverSwapped is logged when a resolver is swapped out for
+	// the resolver that will carry out the next stage of the resolution.
+	EventResolverSwapped
+
+	// EventResolverResolved is logged once a resolver has fully resolved
+	// its contract.
+	EventResolverResolved
+
+	// EventCounterpartySpend is logged when a resolver detects that the
+	// remote party has spent one of the outputs it was resolving.
+	EventCounterpartySpend
+
+	// EventResolverAbandoned is logged once a resolver has been abandoned
+	// by the user, leaving its output unclaimed.
+	EventResolverAbandoned
+
+	// EventClaimReorged is logged when a transaction claiming one of the
+	// outputs of the channel is reorged out of the chain before reaching
+	// a sufficient depth.
+	EventClaimReorged
+
+	// EventSweepConflict is logged when a transaction sweeping one of the
+	// outputs of the channel conflicts with another spend of its inputs.
+	EventSweepConflict
+
+	// EventClaimInMempool is logged when a transaction claiming one of the
+	// outputs of the channel is seen within the mempool of the backend,
+	// meaning it has been accepted for relay but has yet to confirm.
+	EventClaimInMempool
+)
+
+// String returns a human readable string describing the event type.
+func (e ArbitratorEventType) String() string {
+	switch e {
+	case EventStateTransition:
+		return "StateTransition"
+
+	case EventTxBroadcast:
+		return "TxBroadcast"
+
+	case EventTxConfirmed:
+		return "TxConfirmed"
+
+	case EventResolverLaunched:
+		return "ResolverLaunched"
+
+	case EventResolverSwapped:
+		return "ResolverSwapped"
+
+	case EventResolverResolved:
+		return "ResolverResolved"
+
+	case EventCounterpartySpend:
+		return "CounterpartySpend"
+
+	case EventResolverAbandoned:
+		return "ResolverAbandoned"
+
+	case EventClaimReorged:
+		return "ClaimReorged"
+
+	case EventSweepConflict:
+		return "SweepConflict"
+
+	case EventClaimInMempool:
+		return "ClaimInMempool"
+
+	default:
+		return "UnknownEvent"
+	}
+}
+
+// ArbitratorEvent is a single entry within the append-only arbitration event
+// log of a channel.
+type ArbitratorEvent struct {
+	// Type is the kind of event.
+	Type ArbitratorEventType
+
+	// Timestamp is the time the event was recorded.
+	Timestamp time.Time
+
+	// Txid is the transaction the event pertains to, if any.
+	Txid *chainhash.Hash
+
+	// Details is a free-form human readable description of the event.
+	Details string
+}
+
+// String returns a human readable representation of the event.
+func (e *ArbitratorEvent) String() string {
+	if e.Txid != nil {
+		return fmt.Sprintf("%v %v txid=%v: %v",
+			e.Timestamp.Format(time.RFC3339Nano), e.Type, e.Txid,
+			e.Details)
+	}
+
+	return fmt.Sprintf("%v %v: %v", e.Timestamp.Format(time.RFC3339Nano),
+		e.Type, e.Details)
+}
+
+// encodeArbitratorEvent serializes the passed event to the target writer.
+func encodeArbitratorEvent(w io.Writer, e *ArbitratorEvent) error {
+	if err := binary.Write(w, endian, e.Type); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, e.Timestamp.UnixNano()); err != nil {
+		return err
+	}
+
+	if e.Txid == nil {
+		if err := binary.Write(w, endian, false); err != nil {
+			return err
+		}
+	} else {
+		if err := binary.Write(w, endian, true); err != nil {
+			return err
+		}
+		if _, err := w.Write(e.Txid[:]); err != nil {
+			return err
+		}
+	}
+
+	return wire.WriteVarString(w, 0, e.Details)
+}
+
+// decodeArbitratorEvent deserializes an event from the passed reader.
+func decodeArbitratorEvent(r io.Reader) (*ArbitratorEvent, error) {
+	e := &ArbitratorEvent{}
+	if err := binary.Read(r, endian, &e.Type); err != nil {
+		return nil, err
+	}
+
+	var timestamp int64
+	if err := binary.Read(r, endian, &timestamp); err != nil {
+		return nil, err
+	}
+	e.Timestamp = time.Unix(0, timestamp)
+
+	var txPresent bool
+	if err := binary.Read(r, endian, &txPresent); err != nil {
+		return nil, err
+	}
+	if txPresent {
+		e.Txid = &chainhash.Hash{}
+		if _, err := io.ReadFull(r, e.Txid[:]); err != nil {
+			return nil, err
+		}
+	}
+
+	details, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	e.Details = details
+
+	return e, nil
+}
+
+// LogEvent appends a new event to the arbitration event log of the channel.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) LogEvent(e *ArbitratorEvent) error {
+	return b.db.Batch(func(tx *bolt.Tx) error {
+		eventLog, err := tx.CreateBucketIfNotExists(arbEventLogBucket)
+		if err != nil {
+			return err
+		}
+		scopeBucket, err := eventLog.CreateBucketIfNotExists(
+			b.scopeKey[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		// We key each event by a monotonically increasing sequence
+		// number, so iterating the bucket replays the events in the
+		// order they were logged.
+		seq, err := scopeBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		var eventKey [8]byte
+		endian.PutUint64(eventKey[:], seq)
+
+		var buf bytes.Buffer
+		if err := encodeArbitratorEvent(&buf, e); err != nil {
+			return err
+		}
+
+		return scopeBucket.Put(eventKey[:], buf.Bytes())
+	})
+}
+
+// FetchEvents returns all events within the arbitration event log of the
+// channel, in the order they were logged.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) FetchEvents() ([]*ArbitratorEvent, error) {
+	var events []*ArbitratorEvent
+	err := b.db.View(func(tx *bolt.Tx) error {
+		eventLog := tx.Bucket(arbEventLogBucket)
+		if eventLog == nil {
+			return nil
+		}
+		scopeBucket := eventLog.Bucket(b.scopeKey[:])
+		if scopeBucket == nil {
+			return nil
+		}
+
+		return scopeBucket.ForEach(func(_, eventBytes []byte) error {
+			e, err := decodeArbitratorEvent(
+				bytes.NewReader(eventBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, e)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// logEvent records a new event within the arbitration event log, and
+// delivers it to all active event subscribers. Failing to record an event
+// isn't critical to the resolution of the channel, so errors are only logged.
+func (c *ChannelArbitrator) logEvent(eventType ArbitratorEventType,
+	txid *chainhash.Hash, format string, params ...interface{}) {
+
+	event := &ArbitratorEvent{
+		Type:      eventType,
+		Timestamp: c.cfg.clock().Now(),
+		Txid:      txid,
+		Details:   fmt.Sprintf(format, params...),
+	}
+	if err := c.log.LogEvent(event); err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to log event %v: %v",
+			c.cfg.ChanPoint, eventType, err)
+	}
+
+	c.notifyEventSubscribers(event)
+}
+
+// publishTx broadcasts the passed transaction, and records the broadcast
+// within the arbitration event log. This is handed to resolvers in place of
+// the PublishTx closure of the config.
+func (c *ChannelArbitrator) publishTx(tx *wire.MsgTx) error {
+	if err := c.cfg.PublishTx(tx); err != nil {
+		return err
+	}
+
+	txid := tx.TxHash()
+	c.logEvent(EventTxBroadcast, &txid, "resolver broadcast transaction")
+
+	return nil
+}
+
+// ArbitrationEvents returns the arbitration event log of the target channel,
+// in the order the events were logged. As the event log outlives the channel
+// arbitrator, this may also be used to inspect channels that have since been
+// fully resolved.
+func (c *ChainArbitrator) ArbitrationEvents(
+	chanPoint wire.OutPoint) ([]*ArbitratorEvent, error) {
+
+	chanLog, err := newBoltArbitratorLog(
+		c.chanSource.DB, ChannelArbitratorConfig{}, c.cfg.ChainHash,
+		chanPoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chanLog.FetchEvents()
+}
@@ -0,0 +1,74 @@
+package contractcourt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestArbitratorEventLog tests that events written to the arbitration event
+// log are replayed in order, and that they survive the wiping of the rest of
+// the arbitrator log.
+func TestArbitratorEventLog(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	// With no events logged, we should get an empty log back.
+	events, err := testLog.FetchEvents()
+	if err != nil {
+		t.Fatalf("unable to fetch events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no events, got %v", len(events))
+	}
+
+	txid := testChanPoint2.Hash
+	now := time.Unix(0, time.Now().UnixNano())
+	testEvents := []*ArbitratorEvent{
+		{
+			Type:      EventStateTransition,
+			Timestamp: now,
+			Details:   "StateDefault -> StateBroadcastCommit",
+		},
+		{
+			Type:      EventTxBroadcast,
+			Timestamp: now.Add(time.Second),
+			Txid:      &txid,
+			Details:   "force close transaction",
+		},
+		{
+			Type:      EventResolverResolved,
+			Timestamp: now.Add(time.Minute),
+		},
+	}
+	for _, event := range testEvents {
+		if err := testLog.LogEvent(event); err != nil {
+			t.Fatalf("unable to log event: %v", err)
+		}
+	}
+
+	// Wiping the history of the arbitrator log should leave the event log
+	// untouched.
+	if err := testLog.CommitState(StateFullyResolved); err != nil {
+		t.Fatalf("unable to commit state: %v", err)
+	}
+	if err := testLog.WipeHistory(); err != nil {
+		t.Fatalf("unable to wipe history: %v", err)
+	}
+
+	events, err = testLog.FetchEvents()
+	if err != nil {
+		t.Fatalf("unable to fetch events: %v", err)
+	}
+	if !reflect.DeepEqual(events, testEvents) {
+		t.Fatalf("event mismatch: expected %v, got %v", testEvents,
+			events)
+	}
+}
@@ -0,0 +1,136 @@
+package contractcourt
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// ArbitratorEventSubscription is a subscription to the arbitration events of
+// a particular channel. Each event recorded within the arbitration event log
+// of the channel is also delivered over the subscription as it happens.
+type ArbitratorEventSubscription struct {
+	// ChanPoint is the channel that the events pertain to.
+	ChanPoint wire.OutPoint
+
+	// Events is a channel that each new arbitration event of the channel
+	// will be sent over.
+	Events <-chan *ArbitratorEvent
+
+	// Cancel cancels the subscription. This should be called once the
+	// client is no longer interested in events, as they're queued for the
+	// client until then.
+	Cancel func()
+}
+
+// eventSubscriber is the arbitrator's side of an event subscription. Events
+// are appended to a queue, from which they're delivered to the client by a
+// dedicated goroutine, such that a slow client never blocks the arbitrator.
+type eventSubscriber struct {
+	queue  *chainntnfs.ConcurrentQueue
+	events chan *ArbitratorEvent
+	cancel chan struct{}
+}
+
+// SubscribeEvents returns a subscription that will be sent each new
+// arbitration event of the channel, such as resolvers being launched, the
+// broadcast of transactions, and spends by the remote party.
+func (c *ChannelArbitrator) SubscribeEvents() *ArbitratorEventSubscription {
+	sub := &eventSubscriber{
+		queue:  chainntnfs.NewConcurrentQueue(20),
+		events: make(chan *ArbitratorEvent),
+		cancel: make(chan struct{}),
+	}
+	sub.queue.Start()
+
+	c.wg.Add(1)
+	go c.deliverEvents(sub)
+
+	c.eventSubMtx.Lock()
+	clientID := c.eventSubID
+	c.eventSubID++
+	c.eventSubs[clientID] = sub
+	c.eventSubMtx.Unlock()
+
+	log.Debugf("New ArbitratorEventSubscription(id=%v) for "+
+		"ChannelPoint(%v)", clientID, c.cfg.ChanPoint)
+
+	return &ArbitratorEventSubscription{
+		ChanPoint: c.cfg.ChanPoint,
+		Events:    sub.events,
+		Cancel: func() {
+			c.eventSubMtx.Lock()
+			if _, ok := c.eventSubs[clientID]; ok {
+				delete(c.eventSubs, clientID)
+				close(sub.cancel)
+			}
+			c.eventSubMtx.Unlock()
+		},
+	}
+}
+
+// deliverEvents proxies the events appended to the queue of the passed
+// subscriber to the client, until it cancels or the arbitrator exits.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *ChannelArbitrator) deliverEvents(sub *eventSubscriber) {
+	defer c.wg.Done()
+	defer sub.queue.Stop()
+
+	for {
+		select {
+		case event := <-sub.queue.ChanOut():
+			select {
+			case sub.events <- event.(*ArbitratorEvent):
+			case <-sub.cancel:
+				return
+			case <-c.quit:
+				return
+			}
+
+		case <-sub.cancel:
+			return
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// notifyEventSubscribers queues the passed event for delivery to all active
+// event subscribers. As each subscriber has an unbounded queue, this never
+// blocks on a slow subscriber.
+func (c *ChannelArbitrator) notifyEventSubscribers(event *ArbitratorEvent) {
+	// We copy the set of subscribers so that we don't hold the mutex
+	// while queueing the event.
+	c.eventSubMtx.Lock()
+	subs := make([]*eventSubscriber, 0, len(c.eventSubs))
+	for _, sub := range c.eventSubs {
+		subs = append(subs, sub)
+	}
+	c.eventSubMtx.Unlock()
+
+	for _, sub := range subs {
+		select {
+		case sub.queue.ChanIn() <- event:
+		case <-sub.cancel:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// SubscribeArbitrationEvents returns a subscription to the arbitration events
+// of the target channel. If the channel isn't being watched by an arbitrator,
+// then ErrArbitratorNotFound is returned.
+func (c *ChainArbitrator) SubscribeArbitrationEvents(
+	chanPoint wire.OutPoint) (*ArbitratorEventSubscription, error) {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return nil, ErrArbitratorNotFound
+	}
+
+	return arbitrator.SubscribeEvents(), nil
+}
@@ -0,0 +1,86 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// assertArbitratorEvent asserts that the next event delivered over the
+// subscription is of the expected type.
+func assertArbitratorEvent(t *testing.T, sub *ArbitratorEventSubscription,
+	expected ArbitratorEventType) *ArbitratorEvent {
+
+	select {
+	case event := <-sub.Events:
+		if event.Type != expected {
+			t.Fatalf("expected event %v, got %v", expected,
+				event.Type)
+		}
+		return event
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("event %v not received", expected)
+	}
+
+	return nil
+}
+
+// TestChannelArbitratorEventSubscription tests that subscribers to the
+// arbitration events of a channel are notified as the channel is resolved,
+// and no longer receive events once they've cancelled. A subscriber that
+// doesn't consume its events shouldn't hold up the others, nor the arbitrator.
+func TestChannelArbitratorEventSubscription(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	sub := chanArb.SubscribeEvents()
+	cancelledSub := chanArb.SubscribeEvents()
+	cancelledSub.Cancel()
+	idleSub := chanArb.SubscribeEvents()
+	defer idleSub.Cancel()
+
+	// Send a remote force close event, which should be delivered to the
+	// subscriber as a confirmation of the remote commitment.
+	spenderTxid := chainhash.Hash{1}
+	uniClose := &lnwallet.UnilateralCloseSummary{
+		SpendDetail: &chainntnfs.SpendDetail{
+			SpenderTxHash: &spenderTxid,
+		},
+		HtlcResolutions: &lnwallet.HtlcResolutions{},
+	}
+	chanArb.cfg.ChainEvents.RemoteUnilateralClosure <- uniClose
+
+	event := assertArbitratorEvent(t, sub, EventTxConfirmed)
+	if event.Txid == nil || *event.Txid != spenderTxid {
+		t.Fatalf("expected txid %v, got %v", spenderTxid, event.Txid)
+	}
+
+	// Followed by the state transitions as the channel is resolved.
+	assertArbitratorEvent(t, sub, EventStateTransition)
+	assertArbitratorEvent(t, sub, EventStateTransition)
+
+	// The cancelled subscription shouldn't have received any events.
+	select {
+	case event := <-cancelledSub.Events:
+		t.Fatalf("cancelled subscription received event: %v", event)
+	default:
+	}
+
+	sub.Cancel()
+}
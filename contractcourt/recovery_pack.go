@@ -0,0 +1,380 @@
+package contractcourt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// RecoveryOutput is a pending output of a channel, along with everything
+// needed to sweep it using external tools. This allows a user abandoning a
+// broken node to recover its funds manually.
+type RecoveryOutput struct {
+	// Outpoint is the output to be swept.
+	Outpoint wire.OutPoint
+
+	// ChanPoint is the channel point of the channel the output originates
+	// from. It's set by the ChannelArbitrator.
+	ChanPoint wire.OutPoint
+
+	// WitnessType determines the script path that spends the output.
+	WitnessType lnwallet.WitnessType
+
+	// SignDesc describes the key, tweak and witness script needed to sign
+	// for the output.
+	SignDesc lnwallet.SignDescriptor
+
+	// CsvDelay is the relative lock time that needs to have passed since
+	// the output confirmed before it can be swept.
+	CsvDelay uint32
+
+	// LockTime is the absolute lock time the sweep needs to carry. A
+	// value of zero means there's none.
+	LockTime uint32
+
+	// Preimage is the preimage needed to sweep an incoming HTLC. It's nil
+	// for other outputs, or if we've yet to learn the preimage.
+	Preimage []byte
+
+	// ParentTx is the fully signed second-level transaction creating the
+	// output, which needs to be confirmed before the output can be swept.
+	// Resolvers set it for every output of a second-level transaction,
+	// even once the transaction has confirmed, in which case broadcasting
+	// it again is harmless.
+	ParentTx *wire.MsgTx
+}
+
+// witnessSize returns the estimated size of the witness spending the output.
+func (r *RecoveryOutput) witnessSize() int {
+	switch r.WitnessType {
+	case lnwallet.CommitmentNoDelay:
+		return lnwallet.P2WKHWitnessSize
+
+	case lnwallet.HtlcOfferedRemoteTimeout:
+		return lnwallet.AcceptedHtlcTimeoutWitnessSize
+
+	case lnwallet.HtlcAcceptedRemoteSuccess:
+		return lnwallet.OfferedHtlcSuccessWitnessSize
+
+	default:
+		return lnwallet.ToLocalTimeoutWitnessSize
+	}
+}
+
+// recoveryContractResolver is a ContractResolver that's able to describe the
+// outputs it has yet to sweep, such that they can be swept manually.
+type recoveryContractResolver interface {
+	ContractResolver
+
+	// recoveryOutputs returns the outputs the resolver has yet to sweep.
+	recoveryOutputs() []*RecoveryOutput
+}
+
+// recoveryOutputs returns the HTLC output on the remote party's commitment,
+// or the output of the second-level timeout transaction on ours.
+//
+// NOTE: Part of the recoveryContractResolver interface.
+func (h *htlcTimeoutResolver) recoveryOutputs() []*RecoveryOutput {
+	if h.resolved {
+		return nil
+	}
+
+	output := &RecoveryOutput{
+		Outpoint:    h.htlcResolution.ClaimOutpoint,
+		WitnessType: lnwallet.HtlcOfferedRemoteTimeout,
+		SignDesc:    h.htlcResolution.SweepSignDesc,
+		LockTime:    h.htlcResolution.Expiry,
+	}
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		output.WitnessType = lnwallet.HtlcOfferedTimeoutSecondLevel
+		output.CsvDelay = h.htlcResolution.CsvDelay
+		output.LockTime = 0
+		output.ParentTx = h.htlcResolution.SignedTimeoutTx
+	}
+
+	return []*RecoveryOutput{output}
+}
+
+// recoveryOutputs returns the HTLC output on the remote party's commitment,
+// or the output of the second-level success transaction on ours.
+//
+// NOTE: Part of the recoveryContractResolver interface.
+func (h *htlcSuccessResolver) recoveryOutputs() []*RecoveryOutput {
+	if h.resolved {
+		return nil
+	}
+
+	output := &RecoveryOutput{
+		Outpoint:    h.htlcResolution.ClaimOutpoint,
+		WitnessType: lnwallet.HtlcAcceptedRemoteSuccess,
+		SignDesc:    h.htlcResolution.SweepSignDesc,
+	}
+	if h.htlcResolution.SignedSuccessTx != nil {
+		output.WitnessType = lnwallet.HtlcAcceptedSuccessSecondLevel
+		output.CsvDelay = h.htlcResolution.CsvDelay
+		output.ParentTx = h.htlcResolution.SignedSuccessTx
+	}
+	if h.htlcResolution.Preimage != [32]byte{} {
+		output.Preimage = h.htlcResolution.Preimage[:]
+	}
+
+	return []*RecoveryOutput{output}
+}
+
+// recoveryOutputs returns the commitment output paying to us.
+//
+// NOTE: Part of the recoveryContractResolver interface.
+func (c *commitSweepResolver) recoveryOutputs() []*RecoveryOutput {
+	if c.resolved {
+		return nil
+	}
+
+	output := &RecoveryOutput{
+		Outpoint:    c.commitResolution.SelfOutPoint,
+		WitnessType: lnwallet.CommitmentNoDelay,
+		SignDesc:    c.commitResolution.SelfOutputSignDesc,
+	}
+	if c.commitResolution.MaturityDelay != 0 {
+		output.WitnessType = lnwallet.CommitmentTimeLock
+		output.CsvDelay = c.commitResolution.MaturityDelay
+	}
+
+	return []*RecoveryOutput{output}
+}
+
+// A compile time assertion to ensure each resolver meets the
+// recoveryContractResolver interface.
+var (
+	_ recoveryContractResolver = (*htlcTimeoutResolver)(nil)
+	_ recoveryContractResolver = (*htlcSuccessResolver)(nil)
+	_ recoveryContractResolver = (*htlcOutgoingContestResolver)(nil)
+	_ recoveryContractResolver = (*htlcIncomingContestResolver)(nil)
+	_ recoveryContractResolver = (*commitSweepResolver)(nil)
+)
+
+// RecoveryOutputs returns the outputs that the unresolved contracts of the
+// channel have yet to sweep, as last checkpointed to disk by each resolver.
+func (c *ChannelArbitrator) RecoveryOutputs() ([]*RecoveryOutput, error) {
+	contracts, err := c.log.FetchUnresolvedContracts()
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []*RecoveryOutput
+	for _, contract := range contracts {
+		recovery, ok := contract.(recoveryContractResolver)
+		if !ok {
+			continue
+		}
+
+		for _, output := range recovery.recoveryOutputs() {
+			output.ChanPoint = c.cfg.ChanPoint
+			outputs = append(outputs, output)
+		}
+	}
+
+	return outputs, nil
+}
+
+// RecoveryOutputs returns the outputs that the unresolved contracts of all
+// channels watched by the ChainArbitrator have yet to sweep.
+func (c *ChainArbitrator) RecoveryOutputs() ([]*RecoveryOutput, error) {
+	c.Lock()
+	arbitrators := make([]*ChannelArbitrator, 0, len(c.activeChannels))
+	for _, arbitrator := range c.activeChannels {
+		arbitrators = append(arbitrators, arbitrator)
+	}
+	c.Unlock()
+
+	var outputs []*RecoveryOutput
+	for _, arbitrator := range arbitrators {
+		chanOutputs, err := arbitrator.RecoveryOutputs()
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, chanOutputs...)
+	}
+
+	return outputs, nil
+}
+
+// RecoverySweep is an unsigned transaction sweeping a RecoveryOutput to an
+// address of the user's choosing, serialized as a BIP 174 PSBT.
+type RecoverySweep struct {
+	*RecoveryOutput
+
+	// Psbt is the unsigned sweep of the output. It's nil if the output
+	// isn't worth the fee of sweeping it.
+	Psbt []byte
+
+	// Fee is the fee paid by the sweep.
+	Fee btcutil.Amount
+}
+
+// RecoveryPack is a bundle of unsigned sweeps of all pending outputs of a
+// node, which can be signed and broadcast using external tools.
+type RecoveryPack struct {
+	// SweepFeePerKw is the fee rate paid by each sweep.
+	SweepFeePerKw lnwallet.SatPerKWeight
+
+	// Sweeps holds the sweep of each pending output.
+	Sweeps []*RecoverySweep
+}
+
+// NewRecoveryPack crafts an unsigned sweep of each of the passed outputs to
+// the passed script, paying the passed fee rate. If an output is passed more
+// than once, such as when it's tracked by both a resolver and the utxo
+// nursery, then only its first occurrence is kept.
+func NewRecoveryPack(outputs []*RecoveryOutput, sweepScript []byte,
+	feePerKw lnwallet.SatPerKWeight) (*RecoveryPack, error) {
+
+	pack := &RecoveryPack{
+		SweepFeePerKw: feePerKw,
+	}
+
+	seen := make(map[wire.OutPoint]struct{})
+	for _, output := range outputs {
+		if _, ok := seen[output.Outpoint]; ok {
+			continue
+		}
+		seen[output.Outpoint] = struct{}{}
+
+		sweep, err := newRecoverySweep(output, sweepScript, feePerKw)
+		if err != nil {
+			return nil, err
+		}
+		pack.Sweeps = append(pack.Sweeps, sweep)
+	}
+
+	return pack, nil
+}
+
+// newRecoverySweep crafts an unsigned transaction sweeping the passed output
+// to the passed script.
+func newRecoverySweep(output *RecoveryOutput, sweepScript []byte,
+	feePerKw lnwallet.SatPerKWeight) (*RecoverySweep, error) {
+
+	sweep := &RecoverySweep{
+		RecoveryOutput: output,
+	}
+
+	var weightEstimate lnwallet.TxWeightEstimator
+	weightEstimate.AddWitnessInput(output.witnessSize())
+	weightEstimate.AddP2WKHOutput()
+	fee := feePerKw.FeeForWeight(int64(weightEstimate.Weight()))
+
+	value := btcutil.Amount(output.SignDesc.Output.Value)
+	if value <= fee {
+		return sweep, nil
+	}
+
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.LockTime = output.LockTime
+
+	sequence := uint32(wire.MaxTxInSequenceNum)
+	switch {
+	case output.CsvDelay != 0:
+		sequence = output.CsvDelay
+	case output.LockTime != 0:
+		sequence = wire.MaxTxInSequenceNum - 1
+	}
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: output.Outpoint,
+		Sequence:         sequence,
+	})
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: sweepScript,
+		Value:    int64(value - fee),
+	})
+
+	psbt, err := lnwallet.NewSweepPsbt(
+		sweepTx, []*lnwallet.SignDescriptor{&output.SignDesc},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep.Psbt = psbt
+	sweep.Fee = fee
+
+	return sweep, nil
+}
+
+// jsonRecoverySweep is the JSON representation of a RecoverySweep.
+type jsonRecoverySweep struct {
+	Outpoint      string `json:"outpoint"`
+	ChanPoint     string `json:"chan_point"`
+	WitnessType   string `json:"witness_type"`
+	Value         int64  `json:"value"`
+	KeyFamily     uint32 `json:"key_family"`
+	KeyIndex      uint32 `json:"key_index"`
+	PubKey        string `json:"pub_key,omitempty"`
+	SingleTweak   string `json:"single_tweak,omitempty"`
+	WitnessScript string `json:"witness_script,omitempty"`
+	CsvDelay      uint32 `json:"csv_delay,omitempty"`
+	LockTime      uint32 `json:"lock_time,omitempty"`
+	Preimage      string `json:"preimage,omitempty"`
+	ParentTx      string `json:"parent_tx,omitempty"`
+	Psbt          string `json:"psbt,omitempty"`
+	Fee           int64  `json:"fee"`
+}
+
+// jsonRecoveryPack is the JSON representation of a RecoveryPack.
+type jsonRecoveryPack struct {
+	SweepFeePerKw int64                `json:"sweep_fee_per_kw"`
+	Sweeps        []*jsonRecoverySweep `json:"sweeps"`
+}
+
+// WriteJSON writes the recovery pack to the passed io.Writer as JSON. Each
+// PSBT is encoded in base64, as expected by most external tools, and all
+// other binary data is hex encoded.
+func (p *RecoveryPack) WriteJSON(w io.Writer) error {
+	pack := &jsonRecoveryPack{
+		SweepFeePerKw: int64(p.SweepFeePerKw),
+		Sweeps:        make([]*jsonRecoverySweep, 0, len(p.Sweeps)),
+	}
+	for _, sweep := range p.Sweeps {
+		signDesc := &sweep.SignDesc
+		jsonSweep := &jsonRecoverySweep{
+			Outpoint:      sweep.Outpoint.String(),
+			ChanPoint:     sweep.ChanPoint.String(),
+			WitnessType:   sweep.WitnessType.String(),
+			Value:         signDesc.Output.Value,
+			KeyFamily:     uint32(signDesc.KeyDesc.Family),
+			KeyIndex:      signDesc.KeyDesc.Index,
+			SingleTweak:   hex.EncodeToString(signDesc.SingleTweak),
+			WitnessScript: hex.EncodeToString(signDesc.WitnessScript),
+			CsvDelay:      sweep.CsvDelay,
+			LockTime:      sweep.LockTime,
+			Preimage:      hex.EncodeToString(sweep.Preimage),
+			Psbt:          base64.StdEncoding.EncodeToString(sweep.Psbt),
+			Fee:           int64(sweep.Fee),
+		}
+		if signDesc.KeyDesc.PubKey != nil {
+			jsonSweep.PubKey = hex.EncodeToString(
+				signDesc.KeyDesc.PubKey.SerializeCompressed(),
+			)
+		}
+		if sweep.ParentTx != nil {
+			var b bytes.Buffer
+			if err := sweep.ParentTx.Serialize(&b); err != nil {
+				return err
+			}
+			jsonSweep.ParentTx = hex.EncodeToString(b.Bytes())
+		}
+
+		pack.Sweeps = append(pack.Sweeps, jsonSweep)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(pack)
+}
@@ -0,0 +1,138 @@
+package contractcourt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestRecoveryPack tests that the pending outputs of resolvers are described
+// such that they can be swept manually, and that the recovery pack holds an
+// unsigned sweep of each distinct output worth sweeping.
+func TestRecoveryPack(t *testing.T) {
+	t.Parallel()
+
+	timeoutTx := wire.NewMsgTx(2)
+	timeoutTx.AddTxIn(&wire.TxIn{PreviousOutPoint: randOutPoint()})
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:          200,
+			SignedTimeoutTx: timeoutTx,
+			CsvDelay:        144,
+			ClaimOutpoint:   randOutPoint(),
+			SweepSignDesc:   testSignDesc,
+		},
+	}
+	successResolver := &htlcSuccessResolver{
+		htlcResolution: lnwallet.IncomingHtlcResolution{
+			Preimage:      [32]byte{0x01},
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+	}
+
+	// The commitment output is only worth a single satoshi, so it isn't
+	// worth sweeping.
+	dustSignDesc := testSignDesc
+	dustSignDesc.Output = &wire.TxOut{Value: 1}
+	commitResolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       randOutPoint(),
+			SelfOutputSignDesc: dustSignDesc,
+		},
+		resolved: true,
+	}
+
+	var outputs []*RecoveryOutput
+	for _, resolver := range []recoveryContractResolver{
+		timeoutResolver, successResolver, commitResolver,
+	} {
+		outputs = append(outputs, resolver.recoveryOutputs()...)
+	}
+
+	// Resolved contracts have nothing left to sweep.
+	if len(outputs) != 2 {
+		t.Fatalf("expected 2 outputs, got %v", len(outputs))
+	}
+	commitResolver.resolved = false
+	outputs = append(outputs, commitResolver.recoveryOutputs()...)
+
+	timeoutOutput := outputs[0]
+	switch {
+	case timeoutOutput.WitnessType != lnwallet.HtlcOfferedTimeoutSecondLevel:
+		t.Fatalf("unexpected witness type: %v",
+			timeoutOutput.WitnessType)
+
+	case timeoutOutput.ParentTx != timeoutTx:
+		t.Fatalf("expected timeout tx as parent")
+
+	case timeoutOutput.CsvDelay != 144 || timeoutOutput.LockTime != 0:
+		t.Fatalf("unexpected timing: csv=%v, locktime=%v",
+			timeoutOutput.CsvDelay, timeoutOutput.LockTime)
+	}
+
+	successOutput := outputs[1]
+	if successOutput.WitnessType != lnwallet.HtlcAcceptedRemoteSuccess ||
+		!bytes.Equal(successOutput.Preimage,
+			successResolver.htlcResolution.Preimage[:]) {
+
+		t.Fatalf("unexpected success output: %v", successOutput)
+	}
+
+	// An output tracked by both a resolver and the nursery is only swept
+	// once.
+	outputs = append(outputs, &RecoveryOutput{
+		Outpoint: timeoutOutput.Outpoint,
+		SignDesc: testSignDesc,
+	})
+
+	const feePerKw = lnwallet.SatPerKWeight(1000)
+	sweepScript := []byte{0x00, 0x14}
+	pack, err := NewRecoveryPack(outputs, sweepScript, feePerKw)
+	if err != nil {
+		t.Fatalf("unable to create recovery pack: %v", err)
+	}
+	if len(pack.Sweeps) != 3 {
+		t.Fatalf("expected 3 sweeps, got %v", len(pack.Sweeps))
+	}
+	for _, sweep := range pack.Sweeps[:2] {
+		if !bytes.HasPrefix(sweep.Psbt, []byte("psbt\xff")) {
+			t.Fatalf("sweep of %v lacks psbt", sweep.Outpoint)
+		}
+		if sweep.Fee == 0 {
+			t.Fatalf("sweep of %v pays no fee", sweep.Outpoint)
+		}
+	}
+	if pack.Sweeps[2].Psbt != nil {
+		t.Fatalf("expected dust output to lack psbt")
+	}
+
+	// The pack should be encoded as JSON, with base64 encoded PSBTs.
+	var b bytes.Buffer
+	if err := pack.WriteJSON(&b); err != nil {
+		t.Fatalf("unable to write recovery pack: %v", err)
+	}
+	var jsonPack jsonRecoveryPack
+	if err := json.Unmarshal(b.Bytes(), &jsonPack); err != nil {
+		t.Fatalf("unable to decode recovery pack: %v", err)
+	}
+	if len(jsonPack.Sweeps) != 3 {
+		t.Fatalf("expected 3 sweeps, got %v", len(jsonPack.Sweeps))
+	}
+	psbt, err := base64.StdEncoding.DecodeString(jsonPack.Sweeps[0].Psbt)
+	if err != nil {
+		t.Fatalf("unable to decode psbt: %v", err)
+	}
+	if !bytes.Equal(psbt, pack.Sweeps[0].Psbt) {
+		t.Fatalf("psbt mismatch")
+	}
+	if jsonPack.Sweeps[0].ParentTx == "" ||
+		jsonPack.Sweeps[1].Preimage == "" {
+
+		t.Fatalf("expected parent tx and preimage to be included")
+	}
+}
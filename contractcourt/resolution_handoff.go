@@ -0,0 +1,156 @@
+package contractcourt
+
+import (
+	"github.com/btcsuite/btcd/wire"
+)
+
+// PendingResolution is a fully signed transaction that advances the
+// resolution of an output of a channel, along with the height from which it
+// can be broadcast. A set of these can be handed off to a watchtower, which
+// can then carry on with the resolution of the channel should we go offline
+// before the transactions confirm.
+type PendingResolution struct {
+	// Outpoint is the output whose resolution the transaction advances.
+	Outpoint wire.OutPoint
+
+	// Type is the kind of output being resolved.
+	Type ReportOutputType
+
+	// Tx is the fully signed transaction to broadcast.
+	Tx *wire.MsgTx
+
+	// TriggerHeight is the height from which the transaction can be
+	// included in a block. A value of zero means that it can be
+	// broadcast immediately.
+	TriggerHeight uint32
+}
+
+// handoffContractResolver is a ContractResolver that's able to hand off the
+// fully signed transactions it still needs confirmed.
+type handoffContractResolver interface {
+	ContractResolver
+
+	// pendingResolutions returns the fully signed transactions that still
+	// need to be confirmed in order for the contract to be resolved.
+	pendingResolutions() []*PendingResolution
+}
+
+// pendingResolutions returns the signed second-level timeout transaction of
+// the HTLC, if this is our commitment. For the remote party's commitment, the
+// output is swept by the utxo nursery with a transaction that's only signed
+// once the HTLC expires, so there's nothing to hand off.
+//
+// NOTE: Part of the handoffContractResolver interface.
+func (h *htlcTimeoutResolver) pendingResolutions() []*PendingResolution {
+	timeoutTx := h.htlcResolution.SignedTimeoutTx
+	if h.resolved || timeoutTx == nil {
+		return nil
+	}
+
+	return []*PendingResolution{{
+		Outpoint:      h.htlcResolution.ClaimOutpoint,
+		Type:          ReportOutputOutgoingHtlc,
+		Tx:            timeoutTx,
+		TriggerHeight: timeoutTx.LockTime,
+	}}
+}
+
+// pendingResolutions returns the signed second-level success transaction of
+// the HTLC if this is our commitment, or the transaction sweeping it directly
+// if we've already crafted one.
+//
+// NOTE: Part of the handoffContractResolver interface.
+func (h *htlcSuccessResolver) pendingResolutions() []*PendingResolution {
+	if h.resolved {
+		return nil
+	}
+
+	claimTx := h.htlcResolution.SignedSuccessTx
+	if claimTx == nil {
+		claimTx = h.sweepTx
+	}
+	if claimTx == nil {
+		return nil
+	}
+
+	return []*PendingResolution{{
+		Outpoint: h.htlcResolution.ClaimOutpoint,
+		Type:     ReportOutputIncomingHtlc,
+		Tx:       claimTx,
+	}}
+}
+
+// pendingResolutions returns nothing, as the success transaction lacks the
+// preimage of the HTLC until we learn of it. At that point, the resolver is
+// swapped out for an htlcSuccessResolver.
+//
+// NOTE: Part of the handoffContractResolver interface.
+func (h *htlcIncomingContestResolver) pendingResolutions() []*PendingResolution {
+	return nil
+}
+
+// pendingResolutions returns the transaction sweeping the commitment output,
+// if we've already crafted one.
+//
+// NOTE: Part of the handoffContractResolver interface.
+func (c *commitSweepResolver) pendingResolutions() []*PendingResolution {
+	if c.resolved || c.sweepTx == nil {
+		return nil
+	}
+
+	return []*PendingResolution{{
+		Outpoint: c.commitResolution.SelfOutPoint,
+		Type:     ReportOutputCommit,
+		Tx:       c.sweepTx,
+	}}
+}
+
+// A compile time assertion to ensure each resolver meets the
+// handoffContractResolver interface.
+var (
+	_ handoffContractResolver = (*htlcTimeoutResolver)(nil)
+	_ handoffContractResolver = (*htlcSuccessResolver)(nil)
+	_ handoffContractResolver = (*htlcOutgoingContestResolver)(nil)
+	_ handoffContractResolver = (*htlcIncomingContestResolver)(nil)
+	_ handoffContractResolver = (*commitSweepResolver)(nil)
+)
+
+// PendingResolutions returns the fully signed transactions that still need to
+// be confirmed in order to resolve the contracts of the channel. Like the
+// resolution report, these are assembled from the last state each resolver
+// checkpointed to disk.
+func (c *ChannelArbitrator) PendingResolutions() ([]*PendingResolution, error) {
+	contracts, err := c.log.FetchUnresolvedContracts()
+	if err != nil {
+		return nil, err
+	}
+
+	var resolutions []*PendingResolution
+	for _, contract := range contracts {
+		handoff, ok := contract.(handoffContractResolver)
+		if !ok {
+			continue
+		}
+
+		resolutions = append(resolutions, handoff.pendingResolutions()...)
+	}
+
+	return resolutions, nil
+}
+
+// PendingResolutions returns the fully signed transactions that still need to
+// be confirmed in order to resolve the contracts of the channel identified by
+// the passed channel point. If the channel isn't being watched by an
+// arbitrator, then ErrArbitratorNotFound is returned.
+func (c *ChainArbitrator) PendingResolutions(
+	chanPoint wire.OutPoint) ([]*PendingResolution, error) {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return nil, ErrArbitratorNotFound
+	}
+
+	return arbitrator.PendingResolutions()
+}
@@ -0,0 +1,137 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestChannelArbitratorPendingResolutions tests that the ChannelArbitrator
+// hands off the fully signed transactions its unresolved contracts are still
+// waiting on, along with the height from which they can be broadcast.
+func TestChannelArbitratorPendingResolutions(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	newTx := func(lockTime uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: randOutPoint()})
+		tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x00}})
+		tx.LockTime = lockTime
+
+		return tx
+	}
+
+	// We'll insert an outgoing HTLC on our commitment awaiting its
+	// timeout, an outgoing HTLC on the remote commitment which will be
+	// swept by the nursery, a contested incoming HTLC, and our commitment
+	// output which we've already crafted a sweep for.
+	timeoutTx := newTx(150)
+	localTimeoutResolver := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:          150,
+				SignedTimeoutTx: timeoutTx,
+				ClaimOutpoint:   randOutPoint(),
+				SweepSignDesc:   testSignDesc,
+			},
+		},
+	}
+	remoteTimeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:        160,
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+	}
+	incomingResolver := &htlcIncomingContestResolver{
+		htlcExpiry: 120,
+		htlcSuccessResolver: htlcSuccessResolver{
+			htlcResolution: lnwallet.IncomingHtlcResolution{
+				SignedSuccessTx: newTx(0),
+				ClaimOutpoint:   randOutPoint(),
+				SweepSignDesc:   testSignDesc,
+			},
+		},
+	}
+	sweepTx := newTx(0)
+	commitResolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+		},
+		chanPoint: testChanPoint1,
+		sweepTx:   sweepTx,
+	}
+	err = testLog.InsertUnresolvedContracts(
+		localTimeoutResolver, remoteTimeoutResolver, incomingResolver,
+		commitResolver,
+	)
+	if err != nil {
+		t.Fatalf("unable to insert resolvers: %v", err)
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(testLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	resolutions, err := chanArb.PendingResolutions()
+	if err != nil {
+		t.Fatalf("unable to fetch pending resolutions: %v", err)
+	}
+
+	// Only the timeout transaction and the commitment sweep are fully
+	// signed, so those should be the only ones handed off.
+	if len(resolutions) != 2 {
+		t.Fatalf("expected 2 pending resolutions, got %v",
+			len(resolutions))
+	}
+	byType := make(map[ReportOutputType]*PendingResolution)
+	for _, resolution := range resolutions {
+		byType[resolution.Type] = resolution
+	}
+
+	outgoing := byType[ReportOutputOutgoingHtlc]
+	switch {
+	case outgoing == nil:
+		t.Fatalf("timeout tx not handed off")
+
+	case outgoing.Outpoint !=
+		localTimeoutResolver.htlcResolution.ClaimOutpoint:
+
+		t.Fatalf("expected outpoint %v, got %v",
+			localTimeoutResolver.htlcResolution.ClaimOutpoint,
+			outgoing.Outpoint)
+
+	case outgoing.Tx.TxHash() != timeoutTx.TxHash():
+		t.Fatalf("expected timeout tx %v, got %v", timeoutTx.TxHash(),
+			outgoing.Tx.TxHash())
+
+	case outgoing.TriggerHeight != 150:
+		t.Fatalf("expected trigger height 150, got %v",
+			outgoing.TriggerHeight)
+	}
+
+	commit := byType[ReportOutputCommit]
+	switch {
+	case commit == nil:
+		t.Fatalf("commit sweep not handed off")
+
+	case commit.Tx.TxHash() != sweepTx.TxHash():
+		t.Fatalf("expected sweep tx %v, got %v", sweepTx.TxHash(),
+			commit.Tx.TxHash())
+
+	case commit.TriggerHeight != 0:
+		t.Fatalf("expected trigger height 0, got %v",
+			commit.TriggerHeight)
+	}
+}
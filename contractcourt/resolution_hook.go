@@ -0,0 +1,151 @@
+package contractcourt
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// ResolutionOutcome describes how the resolution of an output concluded.
+type ResolutionOutcome uint8
+
+const (
+	// ResolutionClaimed indicates that we claimed the output back into
+	// our wallet.
+	ResolutionClaimed ResolutionOutcome = iota
+
+	// ResolutionClaimedByRemote indicates that the remote party claimed
+	// the output, either by revealing the preimage of an outgoing HTLC, or
+	// by timing out an incoming HTLC.
+	ResolutionClaimedByRemote
+
+	// ResolutionAbandoned indicates that we gave up on resolving the
+	// output.
+	ResolutionAbandoned
+)
+
+// String returns a human readable string describing the ResolutionOutcome.
+func (r ResolutionOutcome) String() string {
+	switch r {
+	case ResolutionClaimed:
+		return "Claimed"
+
+	case ResolutionClaimedByRemote:
+		return "ClaimedByRemote"
+
+	case ResolutionAbandoned:
+		return "Abandoned"
+
+	default:
+		return "UnknownResolutionOutcome"
+	}
+}
+
+// ResolvedContract describes an output of a channel whose on-chain
+// resolution has concluded. It's handed to the ContractResolved hook of the
+// ChainArbitratorConfig, allowing external accounting systems to book the
+// outcome.
+type ResolvedContract struct {
+	// ChanPoint is the channel point of the channel the output belongs
+	// to.
+	ChanPoint wire.OutPoint
+
+	// Outpoint is the output that was resolved.
+	Outpoint wire.OutPoint
+
+	// Type is the kind of output that was resolved.
+	Type ReportOutputType
+
+	// Outcome describes how the resolution concluded.
+	Outcome ResolutionOutcome
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// ClaimedAmount is the amount that made it back into our wallet,
+	// after fees that are known to us.
+	ClaimedAmount btcutil.Amount
+
+	// Fee is the portion of the fee of the sweep transaction that's
+	// attributed to this output. It's zero if the output was swept by the
+	// utxo nursery, or not swept by us at all.
+	Fee btcutil.Amount
+
+	// SweepTxid is the txid of the transaction we broadcast to claim the
+	// output, if any.
+	SweepTxid *chainhash.Hash
+}
+
+// sweepingContractResolver is a ContractResolver that sweeps its output
+// itself, and tracks the fee it paid to do so.
+type sweepingContractResolver interface {
+	ContractResolver
+
+	// sweepFeeRef returns the fee the resolver paid to sweep its output,
+	// which may be modified in place.
+	sweepFeeRef() *btcutil.Amount
+}
+
+// sweepFeeRef returns the fee the resolver paid to sweep its output.
+//
+// NOTE: Part of the sweepingContractResolver interface.
+func (h *htlcSuccessResolver) sweepFeeRef() *btcutil.Amount {
+	return &h.sweepFee
+}
+
+// sweepFeeRef returns the fee the resolver paid to sweep its output.
+//
+// NOTE: Part of the sweepingContractResolver interface.
+func (c *commitSweepResolver) sweepFeeRef() *btcutil.Amount {
+	return &c.sweepFee
+}
+
+// A compile time assertion to ensure each sweeping resolver meets the
+// sweepingContractResolver interface.
+var (
+	_ sweepingContractResolver = (*htlcSuccessResolver)(nil)
+	_ sweepingContractResolver = (*htlcIncomingContestResolver)(nil)
+	_ sweepingContractResolver = (*commitSweepResolver)(nil)
+)
+
+// notifyContractResolved hands the outcome of the passed resolver, which has
+// reached its terminal state, to the ContractResolved hook, if one is set.
+func (c *ChannelArbitrator) notifyContractResolved(resolver ContractResolver,
+	abandoned bool) {
+
+	if c.cfg.ContractResolved == nil {
+		return
+	}
+
+	reporter, ok := resolver.(reportingContractResolver)
+	if !ok {
+		return
+	}
+	report := reporter.report()
+	if report == nil {
+		return
+	}
+
+	resolved := &ResolvedContract{
+		ChanPoint: c.cfg.ChanPoint,
+		Outpoint:  report.Outpoint,
+		Type:      report.Type,
+		Amount:    report.Amount,
+		SweepTxid: report.SweepTxid,
+	}
+	switch {
+	case abandoned:
+		resolved.Outcome = ResolutionAbandoned
+
+	case report.RecoveredBalance > 0:
+		resolved.Outcome = ResolutionClaimed
+		resolved.Fee = report.SweepFee
+		resolved.ClaimedAmount = report.RecoveredBalance -
+			report.SweepFee
+
+	default:
+		resolved.Outcome = ResolutionClaimedByRemote
+	}
+
+	c.cfg.ContractResolved(resolved)
+}
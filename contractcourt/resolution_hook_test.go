@@ -0,0 +1,192 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestContractResolvedHook tests that the outcome of each resolver is
+// reported through the ContractResolved hook once it reaches its terminal
+// state.
+func TestContractResolvedHook(t *testing.T) {
+	t.Parallel()
+
+	chanArb, _, err := createTestChannelArbitrator(&mockArbitratorLog{})
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	var resolvedContracts []*ResolvedContract
+	chanArb.cfg.ContractResolved = func(r *ResolvedContract) {
+		resolvedContracts = append(resolvedContracts, r)
+	}
+
+	const sweepFee = btcutil.Amount(1000)
+	amt := btcutil.Amount(testSignDesc.Output.Value)
+	sweepTx := wire.NewMsgTx(2)
+	sweepTxid := sweepTx.TxHash()
+
+	commitResolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+		},
+		resolved: true,
+		sweepTx:  sweepTx,
+		sweepFee: sweepFee,
+	}
+	contestResolver := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				ClaimOutpoint: testChanPoint1,
+				SweepSignDesc: testSignDesc,
+			},
+			resolved: true,
+		},
+	}
+
+	chanArb.notifyContractResolved(commitResolver, false)
+	chanArb.notifyContractResolved(contestResolver, false)
+	chanArb.notifyContractResolved(contestResolver, true)
+
+	expected := []*ResolvedContract{
+		{
+			ChanPoint:     chanArb.cfg.ChanPoint,
+			Outpoint:      testChanPoint2,
+			Type:          ReportOutputCommit,
+			Outcome:       ResolutionClaimed,
+			Amount:        amt,
+			ClaimedAmount: amt - sweepFee,
+			Fee:           sweepFee,
+			SweepTxid:     &sweepTxid,
+		},
+		{
+			ChanPoint: chanArb.cfg.ChanPoint,
+			Outpoint:  testChanPoint1,
+			Type:      ReportOutputOutgoingHtlc,
+			Outcome:   ResolutionClaimedByRemote,
+			Amount:    amt,
+		},
+		{
+			ChanPoint: chanArb.cfg.ChanPoint,
+			Outpoint:  testChanPoint1,
+			Type:      ReportOutputOutgoingHtlc,
+			Outcome:   ResolutionAbandoned,
+			Amount:    amt,
+		},
+	}
+
+	if len(resolvedContracts) != len(expected) {
+		t.Fatalf("expected %v resolved contracts, got %v",
+			len(expected), len(resolvedContracts))
+	}
+	for i, resolved := range resolvedContracts {
+		exp := expected[i]
+		if (resolved.SweepTxid == nil) != (exp.SweepTxid == nil) ||
+			(resolved.SweepTxid != nil &&
+				*resolved.SweepTxid != *exp.SweepTxid) {
+
+			t.Fatalf("#%v: expected sweep txid %v, got %v", i,
+				exp.SweepTxid, resolved.SweepTxid)
+		}
+
+		resolvedCopy, expCopy := *resolved, *exp
+		resolvedCopy.SweepTxid, expCopy.SweepTxid = nil, nil
+		if resolvedCopy != expCopy {
+			t.Fatalf("#%v: expected %+v, got %+v", i, expCopy,
+				resolvedCopy)
+		}
+	}
+}
+
+// TestSweepFeeAttribution tests that the fee of a sweep is attributed to
+// each of its outputs, and that the attribution is persisted along with the
+// resolver.
+func TestSweepFeeAttribution(t *testing.T) {
+	t.Parallel()
+
+	const feePerKw = lnwallet.SatPerKWeight(1000)
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+	reqs := []*sweepRequest{
+		{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.P2WKHWitnessSize,
+			genWitness:  genWitness,
+		},
+		{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.OfferedHtlcSuccessWitnessSize,
+			genWitness:  genWitness,
+		},
+	}
+
+	sweepTx, err := craftSweepTx(
+		lnwallet.StaticFeeEstimator{FeePerKW: feePerKw},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		}, reqs,
+	)
+	if err != nil {
+		t.Fatalf("unable to craft sweep tx: %v", err)
+	}
+
+	// The attributed fees should add up to the fee of the transaction,
+	// barring rounding, with the larger input paying more.
+	totalFee := 2*testSignDesc.Output.Value - sweepTx.TxOut[0].Value
+	attributed := int64(reqs[0].fee + reqs[1].fee)
+	if attributed > totalFee || totalFee-attributed > 1 {
+		t.Fatalf("expected attributed fees to add up to %v, got %v",
+			totalFee, attributed)
+	}
+	if reqs[0].fee >= reqs[1].fee {
+		t.Fatalf("expected larger input to pay more: %v vs %v",
+			reqs[0].fee, reqs[1].fee)
+	}
+
+	// The fee a resolver paid should survive a round trip through the
+	// arbitrator log.
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	resolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+		},
+		chanPoint: testChanPoint1,
+		sweepTx:   sweepTx,
+		sweepFee:  reqs[0].fee,
+	}
+	if err := testLog.InsertUnresolvedContracts(resolver); err != nil {
+		t.Fatalf("unable to insert contract: %v", err)
+	}
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("expected 1 contract, instead got %v",
+			len(dbContracts))
+	}
+
+	dbFee := dbContracts[0].(*commitSweepResolver).sweepFee
+	if dbFee != resolver.sweepFee {
+		t.Fatalf("expected sweep fee %v, got %v", resolver.sweepFee,
+			dbFee)
+	}
+}
@@ -0,0 +1,287 @@
+package contractcourt
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// PlannedTxType describes the role of a transaction within a resolution plan.
+type PlannedTxType uint8
+
+const (
+	// PlannedCommitTx is our commitment transaction, which is broadcast
+	// to close the channel.
+	PlannedCommitTx PlannedTxType = iota
+
+	// PlannedHtlcTimeoutTx is the second-level transaction that times out
+	// an outgoing HTLC on our commitment.
+	PlannedHtlcTimeoutTx
+
+	// PlannedHtlcSuccessTx is the second-level transaction that claims an
+	// incoming HTLC on our commitment using its preimage.
+	PlannedHtlcSuccessTx
+
+	// PlannedSweepTx is a transaction sweeping a time-locked output back
+	// into our wallet once it matures.
+	PlannedSweepTx
+)
+
+// String returns a human readable string describing the PlannedTxType.
+func (p PlannedTxType) String() string {
+	switch p {
+	case PlannedCommitTx:
+		return "CommitTx"
+
+	case PlannedHtlcTimeoutTx:
+		return "HtlcTimeoutTx"
+
+	case PlannedHtlcSuccessTx:
+		return "HtlcSuccessTx"
+
+	case PlannedSweepTx:
+		return "SweepTx"
+
+	default:
+		return "UnknownPlannedTx"
+	}
+}
+
+// PlannedTx is a transaction that would be broadcast in the course of
+// resolving a channel on-chain.
+type PlannedTx struct {
+	// Type is the role of the transaction within the plan.
+	Type PlannedTxType
+
+	// Outpoint is the output the transaction claims. For the commitment
+	// transaction, this is the funding outpoint of the channel.
+	Outpoint wire.OutPoint
+
+	// Txid is the txid of the transaction. It's only known for
+	// transactions that are signed ahead of time, sweeps are only signed
+	// once their inputs mature.
+	Txid *chainhash.Hash
+
+	// Parent is the txid of the transaction whose output is claimed.
+	Parent chainhash.Hash
+
+	// TriggerHeight is the absolute height from which the transaction can
+	// be included in a block. A value of zero means there's no absolute
+	// lock time.
+	TriggerHeight uint32
+
+	// CsvDelay is the number of blocks that need to pass after the parent
+	// confirms before the transaction can be included in a block.
+	CsvDelay uint32
+
+	// Weight is the weight of the transaction, estimated for sweeps.
+	Weight int64
+
+	// Fee is the fee the transaction pays. It's exact for transactions
+	// signed ahead of time, and estimated at the current fee rate for
+	// sweeps.
+	Fee btcutil.Amount
+
+	// NeedsPreimage is true if the transaction can only be broadcast once
+	// we learn the preimage of the incoming HTLC it claims.
+	NeedsPreimage bool
+}
+
+// ResolutionPlan lists the transactions that would be broadcast if we were to
+// force close a channel at the height the plan was made.
+type ResolutionPlan struct {
+	// ChanPoint is the channel point of the channel.
+	ChanPoint wire.OutPoint
+
+	// Height is the height at which the plan was made.
+	Height uint32
+
+	// SweepFeePerKw is the fee rate sweeps are estimated at.
+	SweepFeePerKw lnwallet.SatPerKWeight
+
+	// Txns holds the planned transactions, each following the transaction
+	// it depends on.
+	Txns []*PlannedTx
+
+	// TotalFee is the sum of the fees of all planned transactions.
+	TotalFee btcutil.Amount
+}
+
+// newResolutionPlan derives the resolution plan for a channel from the summary
+// of a local force close. Each sweep is estimated as a standalone
+// transaction, so batching them with others maturing at the same time will
+// only lower the fees paid.
+func newResolutionPlan(summary *lnwallet.LocalForceCloseSummary,
+	sweepFeePerKw lnwallet.SatPerKWeight, height uint32) *ResolutionPlan {
+
+	plan := &ResolutionPlan{
+		ChanPoint:     summary.ChanPoint,
+		Height:        height,
+		SweepFeePerKw: sweepFeePerKw,
+	}
+
+	addTx := func(tx *PlannedTx) {
+		plan.Txns = append(plan.Txns, tx)
+		plan.TotalFee += tx.Fee
+	}
+
+	// signedTx plans a transaction that's already been signed, deriving
+	// its fee from the value of the commitment output it spends.
+	closeTx := summary.CloseTx
+	closeTxid := closeTx.TxHash()
+	signedTx := func(txType PlannedTxType, tx *wire.MsgTx) *PlannedTx {
+		txid := tx.TxHash()
+		prevOut := tx.TxIn[0].PreviousOutPoint
+
+		var fee btcutil.Amount
+		if int(prevOut.Index) < len(closeTx.TxOut) {
+			fee = btcutil.Amount(
+				closeTx.TxOut[prevOut.Index].Value -
+					tx.TxOut[0].Value,
+			)
+		}
+
+		return &PlannedTx{
+			Type:          txType,
+			Outpoint:      prevOut,
+			Txid:          &txid,
+			Parent:        closeTxid,
+			TriggerHeight: tx.LockTime,
+			Weight: blockchain.GetTransactionWeight(
+				btcutil.NewTx(tx),
+			),
+			Fee: fee,
+		}
+	}
+
+	// sweepTx plans the sweep of a time-locked output, estimating its fee
+	// at the current sweep fee rate.
+	sweepTx := func(op wire.OutPoint, csvDelay uint32) *PlannedTx {
+		var weightEstimate lnwallet.TxWeightEstimator
+		weightEstimate.AddWitnessInput(lnwallet.ToLocalTimeoutWitnessSize)
+		weightEstimate.AddP2WKHOutput()
+		weight := int64(weightEstimate.Weight())
+
+		return &PlannedTx{
+			Type:     PlannedSweepTx,
+			Outpoint: op,
+			Parent:   op.Hash,
+			CsvDelay: csvDelay,
+			Weight:   weight,
+			Fee:      sweepFeePerKw.FeeForWeight(weight),
+		}
+	}
+
+	addTx(&PlannedTx{
+		Type:     PlannedCommitTx,
+		Outpoint: summary.ChanPoint,
+		Txid:     &closeTxid,
+		Parent:   summary.ChanPoint.Hash,
+		Weight: blockchain.GetTransactionWeight(
+			btcutil.NewTx(closeTx),
+		),
+		Fee: summary.ChanSnapshot.CommitFee,
+	})
+
+	if summary.CommitResolution != nil {
+		addTx(sweepTx(
+			summary.CommitResolution.SelfOutPoint,
+			summary.CommitResolution.MaturityDelay,
+		))
+	}
+
+	if summary.HtlcResolutions == nil {
+		return plan
+	}
+
+	for _, htlc := range summary.HtlcResolutions.OutgoingHTLCs {
+		if htlc.SignedTimeoutTx == nil {
+			continue
+		}
+
+		addTx(signedTx(PlannedHtlcTimeoutTx, htlc.SignedTimeoutTx))
+		addTx(sweepTx(htlc.ClaimOutpoint, htlc.CsvDelay))
+	}
+
+	for _, htlc := range summary.HtlcResolutions.IncomingHTLCs {
+		if htlc.SignedSuccessTx == nil {
+			continue
+		}
+
+		needsPreimage := htlc.Preimage == [32]byte{}
+
+		successTx := signedTx(PlannedHtlcSuccessTx, htlc.SignedSuccessTx)
+		successTx.NeedsPreimage = needsPreimage
+		addTx(successTx)
+
+		sweep := sweepTx(htlc.ClaimOutpoint, htlc.CsvDelay)
+		sweep.NeedsPreimage = needsPreimage
+		addTx(sweep)
+	}
+
+	return plan
+}
+
+// ResolutionPlan simulates a local force close of the channel identified by
+// the passed channel point, returning the transactions that would be
+// broadcast to resolve it, along with their timing and fees. Nothing is
+// broadcast, and the state of the channel is left untouched. If the channel
+// isn't open, then ErrArbitratorNotFound is returned.
+func (c *ChainArbitrator) ResolutionPlan(
+	chanPoint wire.OutPoint) (*ResolutionPlan, error) {
+
+	dbChannels, err := c.chanSource.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	var channel *channeldb.OpenChannel
+	for _, dbChannel := range dbChannels {
+		if dbChannel.FundingOutpoint == chanPoint {
+			channel = dbChannel
+			break
+		}
+	}
+	if channel == nil {
+		return nil, ErrArbitratorNotFound
+	}
+
+	// We'll create a throwaway channel state machine to derive the close
+	// summary from, as forcing it closed doesn't modify the channel on
+	// disk.
+	chanMachine, err := lnwallet.NewLightningChannel(
+		c.cfg.Signer, c.cfg.PreimageDB, channel,
+	)
+	if err != nil {
+		return nil, err
+	}
+	chanMachine.Stop()
+
+	summary, err := chanMachine.ForceClose()
+	if err != nil {
+		return nil, fmt.Errorf("unable to simulate force close: %v",
+			err)
+	}
+
+	_, bestHeight, err := c.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	feePref := c.cfg.SweepFeePreference
+	if feePref == (lnwallet.FeePreference{}) {
+		feePref = defaultSweepFeePreference
+	}
+	feePerKw, err := lnwallet.DetermineFeePerKw(
+		c.cfg.FeeEstimator, feePref, uint32(bestHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResolutionPlan(summary, feePerKw, uint32(bestHeight)), nil
+}
@@ -0,0 +1,141 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestResolutionPlan tests that the resolution plan derived from a local force
+// close lists each transaction that would be broadcast, along with its timing
+// and fee.
+func TestResolutionPlan(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	summary, err := aliceChannel.ForceClose()
+	if err != nil {
+		t.Fatalf("unable to force close: %v", err)
+	}
+	if summary.CommitResolution == nil {
+		t.Fatalf("expected commit resolution")
+	}
+
+	// We'll add an outgoing HTLC on top of the commitment, which is timed
+	// out by a second-level transaction spending one of its outputs.
+	closeTxid := summary.CloseTx.TxHash()
+	htlcOutpoint := wire.OutPoint{Hash: closeTxid, Index: 0}
+	htlcValue := summary.CloseTx.TxOut[0].Value
+
+	const secondLevelFee = 1000
+	timeoutTx := wire.NewMsgTx(2)
+	timeoutTx.AddTxIn(&wire.TxIn{PreviousOutPoint: htlcOutpoint})
+	timeoutTx.AddTxOut(&wire.TxOut{
+		Value:    htlcValue - secondLevelFee,
+		PkScript: []byte{0x00},
+	})
+	timeoutTx.LockTime = 200
+	timeoutTxid := timeoutTx.TxHash()
+
+	summary.HtlcResolutions = &lnwallet.HtlcResolutions{
+		OutgoingHTLCs: []lnwallet.OutgoingHtlcResolution{{
+			Expiry:          200,
+			SignedTimeoutTx: timeoutTx,
+			CsvDelay:        144,
+			ClaimOutpoint: wire.OutPoint{
+				Hash: timeoutTxid,
+			},
+		}},
+	}
+
+	const (
+		feePerKw = lnwallet.SatPerKWeight(2000)
+		height   = 100
+	)
+	plan := newResolutionPlan(summary, feePerKw, height)
+
+	if plan.Height != height || plan.SweepFeePerKw != feePerKw {
+		t.Fatalf("unexpected plan parameters: %v", plan)
+	}
+	if len(plan.Txns) != 4 {
+		t.Fatalf("expected 4 planned txns, got %v", len(plan.Txns))
+	}
+
+	// The commitment comes first, paying the fee committed to in the
+	// channel state.
+	commitTx := plan.Txns[0]
+	if commitTx.Type != PlannedCommitTx || *commitTx.Txid != closeTxid {
+		t.Fatalf("unexpected commit tx: %v", commitTx)
+	}
+	if commitTx.Fee != summary.ChanSnapshot.CommitFee {
+		t.Fatalf("expected commit fee %v, got %v",
+			summary.ChanSnapshot.CommitFee, commitTx.Fee)
+	}
+
+	// Our commitment output is swept once its CSV delay passes, at the
+	// passed fee rate.
+	commitSweep := plan.Txns[1]
+	switch {
+	case commitSweep.Type != PlannedSweepTx:
+		t.Fatalf("expected sweep tx, got %v", commitSweep.Type)
+
+	case commitSweep.Outpoint != summary.CommitResolution.SelfOutPoint:
+		t.Fatalf("expected sweep of %v, got %v",
+			summary.CommitResolution.SelfOutPoint,
+			commitSweep.Outpoint)
+
+	case commitSweep.CsvDelay != summary.CommitResolution.MaturityDelay:
+		t.Fatalf("expected csv delay %v, got %v",
+			summary.CommitResolution.MaturityDelay,
+			commitSweep.CsvDelay)
+
+	case commitSweep.Txid != nil:
+		t.Fatalf("sweep shouldn't have a txid")
+
+	case commitSweep.Fee != feePerKw.FeeForWeight(commitSweep.Weight):
+		t.Fatalf("expected sweep fee %v, got %v",
+			feePerKw.FeeForWeight(commitSweep.Weight),
+			commitSweep.Fee)
+	}
+
+	// The HTLC is timed out at its expiry, and the second-level output is
+	// swept after its CSV delay.
+	htlcTimeout := plan.Txns[2]
+	switch {
+	case htlcTimeout.Type != PlannedHtlcTimeoutTx:
+		t.Fatalf("expected timeout tx, got %v", htlcTimeout.Type)
+
+	case *htlcTimeout.Txid != timeoutTxid:
+		t.Fatalf("expected txid %v, got %v", timeoutTxid,
+			htlcTimeout.Txid)
+
+	case htlcTimeout.TriggerHeight != 200:
+		t.Fatalf("expected trigger height 200, got %v",
+			htlcTimeout.TriggerHeight)
+
+	case htlcTimeout.Fee != secondLevelFee:
+		t.Fatalf("expected fee %v, got %v", secondLevelFee,
+			htlcTimeout.Fee)
+	}
+
+	htlcSweep := plan.Txns[3]
+	if htlcSweep.Parent != timeoutTxid || htlcSweep.CsvDelay != 144 {
+		t.Fatalf("unexpected htlc sweep: %v", htlcSweep)
+	}
+
+	var totalFee btcutil.Amount
+	for _, tx := range plan.Txns {
+		totalFee += tx.Fee
+	}
+	if plan.TotalFee != totalFee {
+		t.Fatalf("expected total fee %v, got %v", totalFee,
+			plan.TotalFee)
+	}
+}
@@ -0,0 +1,192 @@
+package contractcourt
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// ErrArbitratorNotFound is returned when the ChainArbitrator isn't
+// overseeing the resolution of the target channel.
+var ErrArbitratorNotFound = errors.New("unable to find arbitrator")
+
+// ReportOutputType describes the kind of output a ContractReport refers to.
+type ReportOutputType uint8
+
+const (
+	// ReportOutputCommit is our own output on a commitment transaction.
+	ReportOutputCommit ReportOutputType = iota
+
+	// ReportOutputIncomingHtlc is an HTLC that was extended to us.
+	ReportOutputIncomingHtlc
+
+	// ReportOutputOutgoingHtlc is an HTLC that we extended to the remote
+	// party.
+	ReportOutputOutgoingHtlc
+)
+
+// String returns a human readable string describing the ReportOutputType.
+func (r ReportOutputType) String() string {
+	switch r {
+	case ReportOutputCommit:
+		return "commit"
+
+	case ReportOutputIncomingHtlc:
+		return "incoming htlc"
+
+	case ReportOutputOutgoingHtlc:
+		return "outgoing htlc"
+
+	default:
+		return "unknown output type"
+	}
+}
+
+// ContractReport details the resolution progress of a single output of a
+// channel that has been closed on-chain. It mirrors the per-HTLC portion of
+// the utxo nursery's maturity report, but covers every output handled by a
+// contract resolver.
+type ContractReport struct {
+	// Outpoint is the final output that will be swept back to the wallet.
+	Outpoint wire.OutPoint
+
+	// Type is the kind of output being resolved.
+	Type ReportOutputType
+
+	// Amount is the value of the output being resolved.
+	Amount btcutil.Amount
+
+	// Stage indicates whether an HTLC is in the first stage (awaiting a
+	// second-level transaction), or the second stage (the output can be
+	// swept directly). For commitment outputs this is always zero.
+	Stage uint32
+
+	// MaturityHeight is the absolute height at which the output either
+	// becomes spendable by us, or after which we can no longer claim it.
+	// A value of zero means the height isn't yet known.
+	MaturityHeight uint32
+
+	// SweepTxid is the txid of the transaction that we've broadcast in
+	// order to claim this output, if any.
+	SweepTxid *chainhash.Hash
+
+	// SweepFee is the portion of the fee of the sweep transaction that's
+	// attributed to this output. It's only known for outputs that we
+	// sweep ourselves, rather than through the utxo nursery.
+	SweepFee btcutil.Amount
+
+	// Delegated is true if the output has been handed to the utxo nursery
+	// to be swept, rather than being swept by its resolver.
+	Delegated bool
+
+	// LimboBalance is the amount that's still awaiting resolution.
+	LimboBalance btcutil.Amount
+
+	// RecoveredBalance is the amount that has been claimed back to our
+	// wallet.
+	RecoveredBalance btcutil.Amount
+}
+
+// reportingContractResolver is a ContractResolver that is able to report on
+// the progress of its resolution.
+type reportingContractResolver interface {
+	ContractResolver
+
+	// report returns a report on the resolution state of the contract.
+	report() *ContractReport
+}
+
+// ResolutionReport is a report detailing the progress of all contracts that
+// the ChannelArbitrator is resolving for a particular channel.
+type ResolutionReport struct {
+	// ChanPoint is the channel point of the channel being resolved.
+	ChanPoint wire.OutPoint
+
+	// State is the current state of the ChannelArbitrator.
+	State ArbitratorState
+
+	// Contracts holds a report for each contract, including those that
+	// have already been resolved.
+	Contracts []*ContractReport
+
+	// LimboBalance is the total amount still awaiting resolution.
+	LimboBalance btcutil.Amount
+
+	// RecoveredBalance is the total amount that has been claimed back to
+	// our wallet.
+	RecoveredBalance btcutil.Amount
+}
+
+// Report returns a report on the resolution of all contracts for the channel
+// that the ChannelArbitrator is watching over, including those that have
+// already been resolved. The report is assembled from the last state each
+// resolver checkpointed to disk, so it can safely be called while resolvers
+// are active.
+func (c *ChannelArbitrator) Report() (*ResolutionReport, error) {
+	state, err := c.log.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	unresolved, err := c.log.FetchUnresolvedContracts()
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := c.log.FetchResolvedContracts()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ResolutionReport{
+		ChanPoint: c.cfg.ChanPoint,
+		State:     state,
+	}
+	addContract := func(contract ContractResolver, isResolved bool) {
+		reporter, ok := contract.(reportingContractResolver)
+		if !ok {
+			return
+		}
+
+		contractReport := reporter.report()
+		if contractReport == nil {
+			return
+		}
+
+		// A resolved contract can't be in limbo. If it still reports
+		// so, it was abandoned without being claimed.
+		if isResolved {
+			contractReport.LimboBalance = 0
+		}
+
+		report.Contracts = append(report.Contracts, contractReport)
+		report.LimboBalance += contractReport.LimboBalance
+		report.RecoveredBalance += contractReport.RecoveredBalance
+	}
+	for _, contract := range unresolved {
+		addContract(contract, false)
+	}
+	for _, contract := range resolved {
+		addContract(contract, true)
+	}
+
+	return report, nil
+}
+
+// ResolutionReport returns a report detailing the on-chain resolution
+// progress of the channel identified by the passed channel point. If the
+// channel isn't being watched by an arbitrator, then ErrArbitratorNotFound is
+// returned.
+func (c *ChainArbitrator) ResolutionReport(
+	chanPoint wire.OutPoint) (*ResolutionReport, error) {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return nil, ErrArbitratorNotFound
+	}
+
+	return arbitrator.Report()
+}
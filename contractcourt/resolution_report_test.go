@@ -0,0 +1,121 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestChannelArbitratorReport tests that the ChannelArbitrator properly
+// reports on the resolution progress of each of its contracts, including those
+// already resolved.
+func TestChannelArbitratorReport(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	if err := testLog.CommitState(StateWaitingFullResolution); err != nil {
+		t.Fatalf("unable to commit state: %v", err)
+	}
+
+	// We'll insert an outgoing HTLC that has already been resolved, a
+	// contested incoming HTLC, and our commitment output which is still
+	// awaiting its sweep.
+	amt := btcutil.Amount(testSignDesc.Output.Value)
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:        99,
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+		resolved: true,
+	}
+	incomingResolver := &htlcIncomingContestResolver{
+		htlcExpiry: 120,
+		htlcSuccessResolver: htlcSuccessResolver{
+			htlcResolution: lnwallet.IncomingHtlcResolution{
+				ClaimOutpoint: randOutPoint(),
+				SweepSignDesc: testSignDesc,
+			},
+		},
+	}
+	commitResolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+		},
+		chanPoint: testChanPoint1,
+	}
+	err = testLog.InsertUnresolvedContracts(
+		timeoutResolver, incomingResolver, commitResolver,
+	)
+	if err != nil {
+		t.Fatalf("unable to insert resolvers: %v", err)
+	}
+
+	// The outgoing HTLC is marked resolved, which should keep it within
+	// the report.
+	if err := testLog.ResolveContract(timeoutResolver); err != nil {
+		t.Fatalf("unable to resolve contract: %v", err)
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(testLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	report, err := chanArb.Report()
+	if err != nil {
+		t.Fatalf("unable to fetch report: %v", err)
+	}
+
+	if report.State != StateWaitingFullResolution {
+		t.Fatalf("expected state %v, got %v",
+			StateWaitingFullResolution, report.State)
+	}
+	if len(report.Contracts) != 3 {
+		t.Fatalf("expected 3 contracts, got %v", len(report.Contracts))
+	}
+	if report.LimboBalance != 2*amt {
+		t.Fatalf("expected limbo balance %v, got %v", 2*amt,
+			report.LimboBalance)
+	}
+	if report.RecoveredBalance != amt {
+		t.Fatalf("expected recovered balance %v, got %v", amt,
+			report.RecoveredBalance)
+	}
+
+	// Each contract should be reported with the type and maturity height
+	// of the resolver that is handling it.
+	contracts := make(map[ReportOutputType]*ContractReport)
+	for _, contract := range report.Contracts {
+		contracts[contract.Type] = contract
+	}
+
+	outgoing := contracts[ReportOutputOutgoingHtlc]
+	if outgoing == nil || outgoing.Outpoint !=
+		timeoutResolver.htlcResolution.ClaimOutpoint {
+
+		t.Fatalf("outgoing htlc not reported: %v", outgoing)
+	}
+	if outgoing.MaturityHeight != 99 || outgoing.Stage != 2 {
+		t.Fatalf("unexpected outgoing htlc report: %v", outgoing)
+	}
+
+	incoming := contracts[ReportOutputIncomingHtlc]
+	if incoming == nil || incoming.MaturityHeight != 120 {
+		t.Fatalf("unexpected incoming htlc report: %v", incoming)
+	}
+
+	commit := contracts[ReportOutputCommit]
+	if commit == nil || commit.Outpoint != testChanPoint2 {
+		t.Fatalf("unexpected commit report: %v", commit)
+	}
+}
@@ -0,0 +1,134 @@
+package contractcourt
+
+import (
+	"github.com/btcsuite/btcutil"
+)
+
+// ResolutionAction is the action a ResolutionStrategy selects for an output
+// that may need to be resolved on-chain.
+type ResolutionAction uint8
+
+const (
+	// ResolveSweepNow indicates that we should go on-chain for the output
+	// as soon as it's needed, and sweep it once it has been confirmed.
+	// This is the default action.
+	ResolveSweepNow ResolutionAction = iota
+
+	// ResolveWaitForRemote indicates that the output alone doesn't
+	// warrant going on-chain. We won't broadcast our commitment on its
+	// behalf, but if the channel is closed on-chain, the output is
+	// resolved as usual.
+	ResolveWaitForRemote
+
+	// ResolveAbandon indicates that the output isn't worth resolving at
+	// all. We won't go on-chain for it, and if the channel is closed
+	// on-chain we won't attempt to sweep it. Abandoned outgoing HTLCs are
+	// failed backwards immediately, just as dust HTLCs are.
+	ResolveAbandon
+)
+
+// String returns a human readable string describing the ResolutionAction.
+func (r ResolutionAction) String() string {
+	switch r {
+	case ResolveSweepNow:
+		return "SweepNow"
+
+	case ResolveWaitForRemote:
+		return "WaitForRemote"
+
+	case ResolveAbandon:
+		return "Abandon"
+
+	default:
+		return "UnknownResolutionAction"
+	}
+}
+
+// ResolutionStrategy decides how an output of a channel should be resolved
+// on-chain. This allows operators to express policies such as never going
+// on-chain to claim small HTLCs.
+type ResolutionStrategy interface {
+	// ResolutionAction returns the action to take for an output of the
+	// passed type and value.
+	ResolutionAction(outputType ReportOutputType,
+		amt btcutil.Amount) ResolutionAction
+}
+
+// StaticResolutionStrategy is a ResolutionStrategy that selects the same
+// action for all outputs.
+type StaticResolutionStrategy ResolutionAction
+
+// ResolutionAction returns the action to take for an output of the passed
+// type and value.
+//
+// NOTE: Part of the ResolutionStrategy interface.
+func (s StaticResolutionStrategy) ResolutionAction(_ ReportOutputType,
+	_ btcutil.Amount) ResolutionAction {
+
+	return ResolutionAction(s)
+}
+
+// MinValueResolutionStrategy is a ResolutionStrategy that selects BelowMin for
+// all outputs worth less than MinValue, and ResolveSweepNow otherwise.
+type MinValueResolutionStrategy struct {
+	// MinValue is the smallest output value that we'll sweep right away.
+	MinValue btcutil.Amount
+
+	// BelowMin is the action taken for outputs worth less than MinValue.
+	BelowMin ResolutionAction
+}
+
+// ResolutionAction returns the action to take for an output of the passed
+// type and value.
+//
+// NOTE: Part of the ResolutionStrategy interface.
+func (m *MinValueResolutionStrategy) ResolutionAction(_ ReportOutputType,
+	amt btcutil.Amount) ResolutionAction {
+
+	if amt < m.MinValue {
+		return m.BelowMin
+	}
+
+	return ResolveSweepNow
+}
+
+// PerClassResolutionStrategy is a ResolutionStrategy that delegates the
+// decision to a distinct strategy for each type of output. Outputs without a
+// strategy are swept right away.
+type PerClassResolutionStrategy map[ReportOutputType]ResolutionStrategy
+
+// ResolutionAction returns the action to take for an output of the passed
+// type and value.
+//
+// NOTE: Part of the ResolutionStrategy interface.
+func (p PerClassResolutionStrategy) ResolutionAction(
+	outputType ReportOutputType, amt btcutil.Amount) ResolutionAction {
+
+	strategy, ok := p[outputType]
+	if !ok {
+		return ResolveSweepNow
+	}
+
+	return strategy.ResolutionAction(outputType, amt)
+}
+
+// A compile time assertion to ensure each strategy meets the
+// ResolutionStrategy interface.
+var (
+	_ ResolutionStrategy = StaticResolutionStrategy(ResolveSweepNow)
+	_ ResolutionStrategy = (*MinValueResolutionStrategy)(nil)
+	_ ResolutionStrategy = (PerClassResolutionStrategy)(nil)
+)
+
+// resolutionAction returns the action the configured ResolutionStrategy
+// selects for the passed output. If no strategy is configured, then all
+// outputs are swept right away.
+func (c *ChannelArbitrator) resolutionAction(outputType ReportOutputType,
+	amt btcutil.Amount) ResolutionAction {
+
+	if c.cfg.ResolutionStrategy == nil {
+		return ResolveSweepNow
+	}
+
+	return c.cfg.ResolutionStrategy.ResolutionAction(outputType, amt)
+}
@@ -0,0 +1,115 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestResolutionStrategyChainActions tests that the configured
+// ResolutionStrategy is taken into account when deciding whether to go
+// on-chain, and which action to take for each HTLC.
+func TestResolutionStrategyChainActions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry = 100
+		height = 95
+	)
+	smallHtlc := channeldb.HTLC{
+		RefundTimeout: expiry,
+		Amt:           lnwire.NewMSatFromSatoshis(1000),
+		HtlcIndex:     0,
+	}
+	largeHtlc := channeldb.HTLC{
+		RefundTimeout: expiry + 100,
+		Amt:           lnwire.NewMSatFromSatoshis(100000),
+		HtlcIndex:     1,
+	}
+
+	minValue := func(belowMin ResolutionAction) ResolutionStrategy {
+		return PerClassResolutionStrategy{
+			ReportOutputOutgoingHtlc: &MinValueResolutionStrategy{
+				MinValue: btcutil.Amount(10000),
+				BelowMin: belowMin,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		strategy ResolutionStrategy
+		trigger  transitionTrigger
+		expected map[ChainAction]int
+	}{
+		{
+			// Without a strategy, the expiring small HTLC will
+			// cause us to go on-chain.
+			name:    "no strategy",
+			trigger: chainTrigger,
+			expected: map[ChainAction]int{
+				HtlcTimeoutAction:       1,
+				HtlcOutgoingWatchAction: 1,
+			},
+		},
+		{
+			// If we won't chase the small HTLC, then there's no
+			// reason to go on-chain.
+			name:     "wait for remote",
+			strategy: minValue(ResolveWaitForRemote),
+			trigger:  chainTrigger,
+			expected: map[ChainAction]int{},
+		},
+		{
+			// Once the remote party goes on-chain, we'll still
+			// resolve the small HTLC as usual.
+			name:     "wait for remote, remote close",
+			strategy: minValue(ResolveWaitForRemote),
+			trigger:  remoteCloseTrigger,
+			expected: map[ChainAction]int{
+				HtlcTimeoutAction:       1,
+				HtlcOutgoingWatchAction: 1,
+			},
+		},
+		{
+			// An abandoned HTLC should be failed back immediately
+			// once we're on-chain.
+			name:     "abandon, remote close",
+			strategy: minValue(ResolveAbandon),
+			trigger:  remoteCloseTrigger,
+			expected: map[ChainAction]int{
+				HtlcFailNowAction:       1,
+				HtlcOutgoingWatchAction: 1,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		chanArb, _, err := createTestChannelArbitrator(
+			&mockArbitratorLog{},
+		)
+		if err != nil {
+			t.Fatalf("unable to create ChannelArbitrator: %v", err)
+		}
+		chanArb.cfg.BroadcastDelta = 10
+		chanArb.cfg.ResolutionStrategy = test.strategy
+		chanArb.activeHTLCs = newHtlcSet(
+			[]channeldb.HTLC{smallHtlc, largeHtlc},
+		)
+
+		actions := chanArb.checkChainActions(height, test.trigger)
+		if len(actions) != len(test.expected) {
+			t.Fatalf("%s: expected %v actions, got %v", test.name,
+				len(test.expected), len(actions))
+		}
+		for action, num := range test.expected {
+			if len(actions[action]) != num {
+				t.Fatalf("%s: expected %v htlcs for %v, got %v",
+					test.name, num, action,
+					len(actions[action]))
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package contractcourt
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ErrResolverNotFound is returned when attempting to abandon a resolver that
+// isn't active within the target ChannelArbitrator.
+var ErrResolverNotFound = errors.New("unable to find active resolver")
+
+// AbandonResolver abandons the active resolver of the output identified by
+// the passed outpoint on the commitment transaction. The resolver is stopped
+// and removed from the set of unresolved contracts, allowing the channel to
+// be considered fully resolved without the output ever being claimed. If the
+// output is an outgoing HTLC, then it's failed backwards immediately.
+//
+// NOTE: Outputs that have already been handed off to the utxo nursery will
+// still be swept by it.
+func (c *ChannelArbitrator) AbandonResolver(op wire.OutPoint) error {
+	return c.abandonResolver(newResolverID(op))
+}
+
+// abandonResolver abandons the active resolver with the passed key.
+func (c *ChannelArbitrator) abandonResolver(key resolverID) error {
+	// We'll build a fresh slice of the remaining resolvers, as the
+	// current one may still be in use by the goroutine launching them.
+	c.activeResolversLock.Lock()
+	var (
+		resolver  ContractResolver
+		remaining []ContractResolver
+	)
+	for _, activeResolver := range c.activeResolvers {
+		if bytes.Equal(activeResolver.ResolverKey(), key[:]) {
+			resolver = activeResolver
+			continue
+		}
+
+		remaining = append(remaining, activeResolver)
+	}
+	if resolver == nil {
+		c.activeResolversLock.Unlock()
+		return ErrResolverNotFound
+	}
+	c.activeResolvers = remaining
+	c.abandonedResolvers[key] = struct{}{}
+	c.activeResolversLock.Unlock()
+
+	log.Infof("ChannelArbitrator(%v): abandoning %T(%x)", c.cfg.ChanPoint,
+		resolver, key[:])
+
+	// With the resolver marked as abandoned, we'll stop it. This will
+	// cause the goroutine driving it to finalize the abandonment.
+	resolver.Stop()
+
+	return nil
+}
+
+// isAbandoned returns true if the passed resolver has been abandoned.
+func (c *ChannelArbitrator) isAbandoned(resolver ContractResolver) bool {
+	var key resolverID
+	copy(key[:], resolver.ResolverKey())
+
+	c.activeResolversLock.RLock()
+	defer c.activeResolversLock.RUnlock()
+
+	_, ok := c.abandonedResolvers[key]
+	return ok
+}
+
+// finalizeAbandoned removes an abandoned resolver from the set of unresolved
+// contracts, and fails the HTLC backwards if it's an outgoing one. Afterwards
+// the main goroutine is signalled, such that it can check whether the
+// channel is now fully resolved.
+func (c *ChannelArbitrator) finalizeAbandoned(resolver ContractResolver) {
+	var timeoutResolver *htlcTimeoutResolver
+	switch r := resolver.(type) {
+	case *htlcTimeoutResolver:
+		timeoutResolver = r
+	case *htlcOutgoingContestResolver:
+		timeoutResolver = &r.htlcTimeoutResolver
+	}
+
+	if timeoutResolver != nil {
+		err := c.cfg.DeliverResolutionMsg(ResolutionMsg{
+			SourceChan: c.cfg.ShortChanID,
+			HtlcIndex:  timeoutResolver.htlcIndex,
+			Failure:    &lnwire.FailPermanentChannelFailure{},
+		})
+		if err != nil {
+			log.Errorf("ChannelArbitrator(%v): unable to fail "+
+				"abandoned htlc: %v", c.cfg.ChanPoint, err)
+		}
+	}
+
+	if err := c.log.ResolveContract(resolver); err != nil {
+		log.Errorf("unable to resolve contract: %v", err)
+	}
+
+	c.logEvent(
+		EventResolverAbandoned, nil, "%T(%x)", resolver,
+		resolver.ResolverKey(),
+	)
+	c.notifyContractResolved(resolver, true)
+
+	select {
+	case c.resolutionSignal <- struct{}{}:
+	case <-c.quit:
+	}
+}
+
+// AbandonResolver abandons the resolver of the output identified by op, which
+// lies on the commitment transaction of the passed channel. If the channel
+// isn't being watched by an arbitrator, then ErrArbitratorNotFound is
+// returned.
+func (c *ChainArbitrator) AbandonResolver(chanPoint, op wire.OutPoint) error {
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return ErrArbitratorNotFound
+	}
+
+	return arbitrator.AbandonResolver(op)
+}
@@ -0,0 +1,106 @@
+package contractcourt
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// mockBlockingResolver is a ContractResolver that blocks until it's stopped.
+type mockBlockingResolver struct {
+	op   wire.OutPoint
+	quit chan struct{}
+}
+
+func (m *mockBlockingResolver) ResolverKey() []byte {
+	key := newResolverID(m.op)
+	return key[:]
+}
+
+func (m *mockBlockingResolver) Resolve() (ContractResolver, error) {
+	<-m.quit
+	return nil, fmt.Errorf("quitting")
+}
+
+func (m *mockBlockingResolver) IsResolved() bool {
+	return false
+}
+
+func (m *mockBlockingResolver) Encode(w io.Writer) error {
+	return nil
+}
+
+func (m *mockBlockingResolver) Decode(r io.Reader) error {
+	return nil
+}
+
+func (m *mockBlockingResolver) AttachResolverKit(ResolverKit) {}
+
+func (m *mockBlockingResolver) Stop() {
+	close(m.quit)
+}
+
+// TestChannelArbitratorAbandonResolver tests that abandoning an active
+// resolver stops it, and allows the channel to be marked fully resolved.
+func TestChannelArbitratorAbandonResolver(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, resolved, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	sub := chanArb.SubscribeEvents()
+	defer sub.Cancel()
+
+	// We'll launch a resolver that won't make any progress on its own.
+	resolver := &mockBlockingResolver{
+		op:   wire.OutPoint{Index: 1},
+		quit: make(chan struct{}),
+	}
+	chanArb.activeResolversLock.Lock()
+	chanArb.activeResolvers = []ContractResolver{resolver}
+	chanArb.activeResolversLock.Unlock()
+
+	chanArb.wg.Add(1)
+	go chanArb.resolveContract(resolver)
+
+	assertArbitratorEvent(t, sub, EventResolverLaunched)
+
+	// Attempting to abandon an output without a resolver should fail.
+	err = chanArb.AbandonResolver(wire.OutPoint{Index: 2})
+	if err != ErrResolverNotFound {
+		t.Fatalf("expected ErrResolverNotFound, got %v", err)
+	}
+
+	if err := chanArb.AbandonResolver(resolver.op); err != nil {
+		t.Fatalf("unable to abandon resolver: %v", err)
+	}
+
+	// The abandonment should be recorded, and as it was the last
+	// unresolved contract, the channel should now be fully resolved.
+	assertArbitratorEvent(t, sub, EventResolverAbandoned)
+
+	select {
+	case <-resolved:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("channel not marked resolved")
+	}
+
+	// The resolver is no longer active, so it can't be abandoned twice.
+	err = chanArb.AbandonResolver(resolver.op)
+	if err != ErrResolverNotFound {
+		t.Fatalf("expected ErrResolverNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+package contractcourt
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ResolverPolicy bounds the resources we're willing to spend resolving a
+// class of outputs on-chain.
+type ResolverPolicy struct {
+	// MaxFee is the largest fee we'll pay to sweep a single output of the
+	// class. Until the fee required to sweep the output falls below it,
+	// we'll retry the sweep each block. A value of zero means no limit.
+	MaxFee btcutil.Amount
+
+	// MaxWaitBlocks is the number of blocks after an output becomes
+	// spendable by us that we'll keep trying to resolve it. Once they've
+	// passed, the resolver is abandoned. A value of zero means no limit.
+	MaxWaitBlocks uint32
+}
+
+// resolverPolicy is the policy of a single resolver. It's fixed once the
+// resolver is launched, and persisted along with the resolver itself, such
+// that the same limits apply across restarts.
+type resolverPolicy struct {
+	// maxFee is the largest fee we'll pay to sweep the output.
+	maxFee btcutil.Amount
+
+	// deadline is the height after which the resolver is abandoned, or
+	// zero if there's none.
+	deadline uint32
+}
+
+// encodeResolverPolicy writes the passed resolver policy to w.
+func encodeResolverPolicy(w io.Writer, p *resolverPolicy) error {
+	if err := binary.Write(w, endian, int64(p.maxFee)); err != nil {
+		return err
+	}
+
+	return binary.Write(w, endian, p.deadline)
+}
+
+// decodeResolverPolicy reads a resolver policy written by
+// encodeResolverPolicy from r.
+func decodeResolverPolicy(r io.Reader, p *resolverPolicy) error {
+	var maxFee int64
+	if err := binary.Read(r, endian, &maxFee); err != nil {
+		return err
+	}
+	p.maxFee = btcutil.Amount(maxFee)
+
+	return binary.Read(r, endian, &p.deadline)
+}
+
+// policyContractResolver is a ContractResolver whose resolution is bound by a
+// resolverPolicy.
+type policyContractResolver interface {
+	ContractResolver
+
+	// resolverPolicy returns the policy of the resolver, which may be
+	// modified in place.
+	resolverPolicy() *resolverPolicy
+
+	// maturityHeight returns the earliest height at which the output of
+	// the resolver becomes spendable by us, given the height at which the
+	// resolver is launched.
+	maturityHeight(launchHeight uint32) uint32
+}
+
+// resolverPolicy returns the policy of the resolver.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (h *htlcTimeoutResolver) resolverPolicy() *resolverPolicy {
+	return &h.policy
+}
+
+// maturityHeight returns the earliest height at which the output of the
+// resolver becomes spendable by us. The HTLC can only be timed out once it
+// expires, and if this is our commitment, the output of the second-level
+// transaction is then subject to a relative delay as well.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (h *htlcTimeoutResolver) maturityHeight(launchHeight uint32) uint32 {
+	maturity := h.htlcResolution.Expiry
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		maturity += h.htlcResolution.CsvDelay
+	}
+	if maturity < launchHeight {
+		return launchHeight
+	}
+
+	return maturity
+}
+
+// resolverPolicy returns the policy of the resolver.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (h *htlcSuccessResolver) resolverPolicy() *resolverPolicy {
+	return &h.policy
+}
+
+// maturityHeight returns the earliest height at which the output of the
+// resolver becomes spendable by us. If this is our commitment, then the
+// output of the second-level transaction is subject to a relative delay.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (h *htlcSuccessResolver) maturityHeight(launchHeight uint32) uint32 {
+	return launchHeight + h.htlcResolution.CsvDelay
+}
+
+// resolverPolicy returns the policy of the resolver.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (c *commitSweepResolver) resolverPolicy() *resolverPolicy {
+	return &c.policy
+}
+
+// maturityHeight returns the earliest height at which the output of the
+// resolver becomes spendable by us. If this is our commitment, then the
+// output is subject to a relative delay.
+//
+// NOTE: Part of the policyContractResolver interface.
+func (c *commitSweepResolver) maturityHeight(launchHeight uint32) uint32 {
+	return launchHeight + c.commitResolution.MaturityDelay
+}
+
+// A compile time assertion to ensure each resolver meets the
+// policyContractResolver interface.
+var (
+	_ policyContractResolver = (*htlcTimeoutResolver)(nil)
+	_ policyContractResolver = (*htlcSuccessResolver)(nil)
+	_ policyContractResolver = (*htlcOutgoingContestResolver)(nil)
+	_ policyContractResolver = (*htlcIncomingContestResolver)(nil)
+	_ policyContractResolver = (*commitSweepResolver)(nil)
+)
+
+// applyResolverPolicies fixes the policy of each of the passed resolvers
+// according to the configured policy of its output class. The deadline of
+// each resolver is counted from the height at which its output becomes
+// spendable, derived from the passed height at which they're launched, such
+// that outputs still subject to a timelock aren't abandoned before they can
+// be swept.
+func (c *ChannelArbitrator) applyResolverPolicies(resolvers []ContractResolver,
+	height uint32) {
+
+	if len(c.cfg.ResolverPolicies) == 0 {
+		return
+	}
+
+	for _, resolver := range resolvers {
+		policyResolver, ok := resolver.(policyContractResolver)
+		if !ok {
+			continue
+		}
+		reporter, ok := resolver.(reportingContractResolver)
+		if !ok {
+			continue
+		}
+		report := reporter.report()
+		if report == nil {
+			continue
+		}
+
+		classPolicy, ok := c.cfg.ResolverPolicies[report.Type]
+		if !ok {
+			continue
+		}
+
+		policy := policyResolver.resolverPolicy()
+		policy.maxFee = classPolicy.MaxFee
+		if classPolicy.MaxWaitBlocks != 0 {
+			policy.deadline = policyResolver.maturityHeight(height) +
+				classPolicy.MaxWaitBlocks
+		}
+	}
+}
+
+// abandonable returns true if the passed resolver may be abandoned once its
+// deadline has passed. Incoming HTLCs we know the preimage to are never
+// abandoned, as we'd lose an HTLC we may already have settled backwards.
+// Neither are resolvers that handed their output to the utxo nursery, as the
+// nursery sweeps it regardless, within its own fee limits.
+func abandonable(resolver ContractResolver) bool {
+	switch r := resolver.(type) {
+	case *htlcTimeoutResolver:
+		return !r.outputIncubating
+
+	case *htlcOutgoingContestResolver:
+		return !r.outputIncubating
+
+	case *htlcSuccessResolver:
+		return r.htlcResolution.Preimage == [32]byte{} &&
+			!r.outputIncubating
+
+	case *htlcIncomingContestResolver:
+		return r.htlcResolution.Preimage == [32]byte{} &&
+			!r.outputIncubating
+
+	case *commitSweepResolver:
+		return !r.outputIncubating
+	}
+
+	return true
+}
+
+// abandonExpiredResolvers abandons all active resolvers whose deadline has
+// passed as of the passed height, unless they aren't abandonable.
+func (c *ChannelArbitrator) abandonExpiredResolvers(height uint32) {
+	var expired []resolverID
+
+	c.activeResolversLock.RLock()
+	for _, resolver := range c.activeResolvers {
+		policyResolver, ok := resolver.(policyContractResolver)
+		if !ok || !abandonable(resolver) {
+			continue
+		}
+
+		deadline := policyResolver.resolverPolicy().deadline
+		if deadline == 0 || height < deadline {
+			continue
+		}
+
+		var key resolverID
+		copy(key[:], resolver.ResolverKey())
+		expired = append(expired, key)
+	}
+	c.activeResolversLock.RUnlock()
+
+	for _, key := range expired {
+		log.Infof("ChannelArbitrator(%v): resolver %x reached its "+
+			"deadline at height %v", c.cfg.ChanPoint, key[:], height)
+
+		if err := c.abandonResolver(key); err != nil {
+			log.Errorf("ChannelArbitrator(%v): unable to abandon "+
+				"resolver: %v", c.cfg.ChanPoint, err)
+		}
+	}
+}
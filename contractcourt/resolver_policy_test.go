@@ -0,0 +1,552 @@
+package contractcourt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestResolverPolicyStorage tests that the policy of a resolver is persisted
+// along with it, and that records predating policies decode without one.
+func TestResolverPolicyStorage(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	boltLog := testLog.(*boltArbitratorLog)
+
+	resolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+		},
+		broadcastHeight: 100,
+		chanPoint:       testChanPoint1,
+		policy: resolverPolicy{
+			maxFee:   1000,
+			deadline: 200,
+		},
+	}
+
+	// A resolver written to the log should be read back with its policy
+	// intact.
+	if err := testLog.InsertUnresolvedContracts(resolver); err != nil {
+		t.Fatalf("unable to insert contract: %v", err)
+	}
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("expected 1 contract, instead got %v",
+			len(dbContracts))
+	}
+	assertResolversEqual(t, resolver, dbContracts[0])
+
+	dbPolicy := *dbContracts[0].(*commitSweepResolver).resolverPolicy()
+	if dbPolicy != resolver.policy {
+		t.Fatalf("expected policy %v, got %v", resolver.policy,
+			dbPolicy)
+	}
+
+	// A record written before policies were introduced should decode
+	// without any limits. Its body also lacks the sweep fee of the
+	// resolver.
+	var buf bytes.Buffer
+	buf.Write([]byte{
+		resolverRecordMarker, legacyResolverRecordVersion,
+		resolverUnilateralSweep,
+	})
+	err = encodeCommitResolution(&buf, &resolver.commitResolution)
+	if err != nil {
+		t.Fatalf("unable to encode resolution: %v", err)
+	}
+	binary.Write(&buf, endian, resolver.resolved)
+	binary.Write(&buf, endian, resolver.broadcastHeight)
+	if err := lnwallet.WriteOutPoint(&buf, &resolver.chanPoint); err != nil {
+		t.Fatalf("unable to encode chan point: %v", err)
+	}
+	err = boltLog.db.Update(func(tx *bolt.Tx) error {
+		contractBucket, err := fetchContractWriteBucket(
+			tx, boltLog.scopeKey[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		return contractBucket.Put(resolver.ResolverKey(), buf.Bytes())
+	})
+	if err != nil {
+		t.Fatalf("unable to write record: %v", err)
+	}
+
+	dbContracts, err = testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts: %v", err)
+	}
+	assertResolversEqual(t, resolver, dbContracts[0])
+
+	dbPolicy = *dbContracts[0].(*commitSweepResolver).resolverPolicy()
+	if dbPolicy != (resolverPolicy{}) {
+		t.Fatalf("expected empty policy, got %v", dbPolicy)
+	}
+}
+
+// mockPolicyResolver is a mockBlockingResolver for an outgoing HTLC that is
+// bound by a resolver policy.
+type mockPolicyResolver struct {
+	mockBlockingResolver
+
+	policy resolverPolicy
+
+	maturityDelay uint32
+}
+
+func (m *mockPolicyResolver) resolverPolicy() *resolverPolicy {
+	return &m.policy
+}
+
+func (m *mockPolicyResolver) maturityHeight(launchHeight uint32) uint32 {
+	return launchHeight + m.maturityDelay
+}
+
+func (m *mockPolicyResolver) report() *ContractReport {
+	return &ContractReport{
+		Outpoint: m.op,
+		Type:     ReportOutputOutgoingHtlc,
+	}
+}
+
+// TestResolverPolicyDeadline tests that resolvers are abandoned once the
+// deadline derived from the policy of their class has passed, counting from
+// the height at which their output becomes spendable.
+func TestResolverPolicyDeadline(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, resolved, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb.cfg.ResolverPolicies = map[ReportOutputType]ResolverPolicy{
+		ReportOutputOutgoingHtlc: {
+			MaxFee:        1000,
+			MaxWaitBlocks: 10,
+		},
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	const (
+		launchHeight   = 100
+		maturityHeight = launchHeight + 20
+	)
+	resolver := &mockPolicyResolver{
+		mockBlockingResolver: mockBlockingResolver{
+			op:   wire.OutPoint{Index: 1},
+			quit: make(chan struct{}),
+		},
+		maturityDelay: maturityHeight - launchHeight,
+	}
+	resolvers := []ContractResolver{resolver}
+
+	chanArb.applyResolverPolicies(resolvers, launchHeight)
+	expectedPolicy := resolverPolicy{
+		maxFee:   1000,
+		deadline: maturityHeight + 10,
+	}
+	if resolver.policy != expectedPolicy {
+		t.Fatalf("expected policy %v, got %v", expectedPolicy,
+			resolver.policy)
+	}
+
+	chanArb.activeResolversLock.Lock()
+	chanArb.activeResolvers = resolvers
+	chanArb.activeResolversLock.Unlock()
+
+	chanArb.wg.Add(1)
+	go chanArb.resolveContract(resolver)
+
+	// Before the deadline, the resolver should be left alone, even though
+	// more blocks than its class allows have passed since its launch.
+	chanArb.abandonExpiredResolvers(launchHeight + 10)
+	select {
+	case <-resolved:
+		t.Fatalf("resolver abandoned before its output matured")
+	case <-time.After(50 * time.Millisecond):
+	}
+	chanArb.abandonExpiredResolvers(maturityHeight + 9)
+	select {
+	case <-resolved:
+		t.Fatalf("resolver abandoned before its deadline")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once the deadline is reached, it should be abandoned, leaving the
+	// channel fully resolved.
+	chanArb.abandonExpiredResolvers(maturityHeight + 10)
+	select {
+	case <-resolved:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("resolver not abandoned at its deadline")
+	}
+}
+
+// TestResolverPolicyDeadlineNotAbandonable tests that resolvers of incoming
+// HTLCs we know the preimage to, and resolvers that handed their output to the
+// utxo nursery, aren't abandoned once their deadline has passed.
+func TestResolverPolicyDeadlineNotAbandonable(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	const deadline = 100
+	policy := resolverPolicy{deadline: deadline}
+	newSuccessResolver := func(preimage [32]byte) htlcSuccessResolver {
+		return htlcSuccessResolver{
+			htlcResolution: lnwallet.IncomingHtlcResolution{
+				Preimage:      preimage,
+				ClaimOutpoint: randOutPoint(),
+			},
+			policy:      policy,
+			ResolverKit: ResolverKit{Quit: make(chan struct{})},
+		}
+	}
+	withPreimage := newSuccessResolver(testPreimage)
+	contestWithPreimage := &htlcIncomingContestResolver{
+		htlcSuccessResolver: newSuccessResolver(testPreimage),
+	}
+	incubating := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint: randOutPoint(),
+		},
+		outputIncubating: true,
+		policy:           policy,
+		ResolverKit:      ResolverKit{Quit: make(chan struct{})},
+	}
+	withoutPreimage := newSuccessResolver([32]byte{})
+
+	chanArb.activeResolversLock.Lock()
+	chanArb.activeResolvers = []ContractResolver{
+		&withPreimage, contestWithPreimage, incubating,
+		&withoutPreimage,
+	}
+	chanArb.activeResolversLock.Unlock()
+
+	// Past the deadline, only the resolver of the incoming HTLC we don't
+	// know the preimage to should be abandoned.
+	chanArb.abandonExpiredResolvers(deadline)
+
+	chanArb.activeResolversLock.RLock()
+	activeResolvers := chanArb.activeResolvers
+	chanArb.activeResolversLock.RUnlock()
+
+	if len(activeResolvers) != 3 {
+		t.Fatalf("expected 3 active resolvers, got %v",
+			len(activeResolvers))
+	}
+	for _, resolver := range activeResolvers {
+		if resolver == &withoutPreimage {
+			t.Fatalf("resolver without preimage not abandoned")
+		}
+		if chanArb.isAbandoned(resolver) {
+			t.Fatalf("resolver %T abandoned", resolver)
+		}
+	}
+}
+
+// TestSweepBatcherMaxFee tests that outputs that would contribute more than
+// their max fee to a sweep are left out of the batch.
+func TestSweepBatcherMaxFee(t *testing.T) {
+	t.Parallel()
+
+	feePerKw := lnwallet.SatPerKWeight(1000)
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: feePerKw},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		},
+		defaultSweepBatchWindow, systemClock{},
+	)
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+	newRequest := func() *sweepRequest {
+		return &sweepRequest{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.P2WKHWitnessSize,
+			genWitness:  genWitness,
+			quit:        make(chan struct{}),
+			resp:        make(chan *sweepResponse, 1),
+		}
+	}
+
+	// The first request can afford its share of the fee, while the second
+	// can't.
+	withinBudget := newRequest()
+	withinBudget.maxFee = withinBudget.inputFee(feePerKw)
+	overBudget := newRequest()
+	overBudget.maxFee = overBudget.inputFee(feePerKw) - 1
+
+	batcher.sweepBatch([]*sweepRequest{withinBudget, overBudget})
+
+	resp := <-overBudget.resp
+	if resp.err != lnwallet.ErrFeeExceedsMax {
+		t.Fatalf("expected ErrFeeExceedsMax, got %v", resp.err)
+	}
+
+	resp = <-withinBudget.resp
+	if resp.err != nil {
+		t.Fatalf("unable to sweep output: %v", resp.err)
+	}
+	if len(resp.sweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(resp.sweepTx.TxIn))
+	}
+	if resp.sweepTx.TxIn[0].PreviousOutPoint != withinBudget.outpoint {
+		t.Fatalf("expected input %v, got %v", withinBudget.outpoint,
+			resp.sweepTx.TxIn[0].PreviousOutPoint)
+	}
+}
+
+// mockFeeEstimator is a FeeEstimator whose fee rate, and the updates sent to
+// its subscribers, are controlled by the test.
+type mockFeeEstimator struct {
+	feePerKw lnwallet.SatPerKWeight
+	updates  chan lnwallet.SatPerKWeight
+	mu       sync.Mutex
+}
+
+func (m *mockFeeEstimator) EstimateFeePerKW(
+	uint32) (lnwallet.SatPerKWeight, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.feePerKw, nil
+}
+
+func (m *mockFeeEstimator) RelayFeePerKW() lnwallet.SatPerKWeight {
+	return lnwallet.FeePerKwFloor
+}
+
+func (m *mockFeeEstimator) SubscribeFeeUpdates(
+	uint32) (*lnwallet.FeeSubscription, error) {
+
+	return &lnwallet.FeeSubscription{
+		Updates: m.updates,
+		Cancel:  func() {},
+	}, nil
+}
+
+func (m *mockFeeEstimator) Start() error {
+	return nil
+}
+
+func (m *mockFeeEstimator) Stop() error {
+	return nil
+}
+
+// setFeeRate changes the estimated fee rate, and notifies the subscribers.
+func (m *mockFeeEstimator) setFeeRate(feePerKw lnwallet.SatPerKWeight) {
+	m.mu.Lock()
+	m.feePerKw = feePerKw
+	m.mu.Unlock()
+
+	m.updates <- feePerKw
+}
+
+// TestSweepOutputFeeUpdate tests that a sweep exceeding its max fee is retried
+// as soon as the estimated fee rate drops, rather than only at the next block.
+func TestSweepOutputFeeUpdate(t *testing.T) {
+	t.Parallel()
+
+	estimator := &mockFeeEstimator{
+		feePerKw: 5000,
+		updates:  make(chan lnwallet.SatPerKWeight),
+	}
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				Notifier:     &mockNotifier{},
+				FeeEstimator: estimator,
+				NewSweepAddr: func() ([]byte, error) {
+					return []byte{0x00, 0x14}, nil
+				},
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+
+	// The output can only afford the fee at 1000 sat/kw, so it can't be
+	// swept until the fee rate drops.
+	req := &sweepRequest{witnessSize: lnwallet.P2WKHWitnessSize}
+	maxFee := req.inputFee(1000)
+
+	type sweepResult struct {
+		sweepTx *wire.MsgTx
+		err     error
+	}
+	results := make(chan sweepResult, 1)
+	go func() {
+		sweepTx, _, err := kit.sweepOutput(
+			randOutPoint(), &testSignDesc,
+			lnwallet.P2WKHWitnessSize, genWitness, maxFee, 0,
+			false,
+		)
+		results <- sweepResult{sweepTx, err}
+	}()
+
+	// A drop in the fee rate that still exceeds the max fee shouldn't
+	// result in a sweep.
+	estimator.setFeeRate(2000)
+	select {
+	case result := <-results:
+		t.Fatalf("unexpected sweep result: %v", result.err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once it drops far enough, the output should be swept right away,
+	// as the mock notifier never delivers any blocks.
+	estimator.setFeeRate(1000)
+	select {
+	case result := <-results:
+		if result.err != nil {
+			t.Fatalf("unable to sweep output: %v", result.err)
+		}
+		if len(result.sweepTx.TxIn) != 1 {
+			t.Fatalf("expected 1 input, got %v",
+				len(result.sweepTx.TxIn))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("output not swept after fee rate dropped")
+	}
+}
+
+// TestSweepOutputDeadline tests that a sweep exceeding its max fee is no
+// longer held back once its deadline is closer than our confirmation target.
+func TestSweepOutputDeadline(t *testing.T) {
+	t.Parallel()
+
+	chain := NewSimChain()
+	defer chain.Stop()
+
+	estimator := &mockFeeEstimator{
+		feePerKw: 5000,
+		updates:  make(chan lnwallet.SatPerKWeight),
+	}
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				ChainIO:      chain,
+				Notifier:     chain,
+				FeeEstimator: estimator,
+				NewSweepAddr: func() ([]byte, error) {
+					return []byte{0x00, 0x14}, nil
+				},
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+
+	// The output can only afford the fee at 1000 sat/kw, and the fee rate
+	// won't drop. Once the deadline is within the default confirmation
+	// target, it should be swept regardless.
+	req := &sweepRequest{witnessSize: lnwallet.P2WKHWitnessSize}
+	maxFee := req.inputFee(1000)
+	confTarget := defaultSweepFeePreference.ConfTarget
+	deadline := confTarget + 5
+
+	type sweepResult struct {
+		sweepTx *wire.MsgTx
+		err     error
+	}
+	results := make(chan sweepResult, 1)
+	go func() {
+		sweepTx, _, err := kit.sweepOutput(
+			randOutPoint(), &testSignDesc,
+			lnwallet.P2WKHWitnessSize, genWitness, maxFee,
+			deadline, false,
+		)
+		results <- sweepResult{sweepTx, err}
+	}()
+
+	// We'll wait for the sweep to be retried each block, before mining
+	// up to the block prior to the one where the deadline is near.
+	for i := 0; ; i++ {
+		chain.mu.Lock()
+		numEpochClients := len(chain.epochClients)
+		chain.mu.Unlock()
+
+		if numEpochClients == 1 {
+			break
+		}
+		if i == 500 {
+			t.Fatalf("sweep not retried")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for chain.BestHeight()+confTarget < deadline-1 {
+		chain.MineBlock()
+	}
+	select {
+	case result := <-results:
+		t.Fatalf("swept before deadline was near: %v", result.err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	chain.MineBlock()
+	select {
+	case result := <-results:
+		if result.err != nil {
+			t.Fatalf("unable to sweep output: %v", result.err)
+		}
+		if len(result.sweepTx.TxIn) != 1 {
+			t.Fatalf("expected 1 input, got %v",
+				len(result.sweepTx.TxIn))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("output not swept once deadline was near")
+	}
+}
@@ -0,0 +1,210 @@
+package contractcourt
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// defaultMaxActiveClaims is the default number of claim transactions that
+// resolvers across all channels may broadcast at once.
+const defaultMaxActiveClaims = 4
+
+// claimWaiter is a resolver waiting on the resolverScheduler for its turn to
+// broadcast a claim.
+type claimWaiter struct {
+	// deadline is the height by which the claim needs to confirm, or zero
+	// if it's not bound by one.
+	deadline uint32
+
+	// seq orders waiters with the same deadline by arrival.
+	seq uint64
+
+	// index is the position of the waiter within the claimQueue, or -1
+	// once it's been granted its turn.
+	index int
+
+	// ready is closed once it's the waiter's turn.
+	ready chan struct{}
+}
+
+// claimQueue is a priority queue of claimWaiters, ordered by deadline.
+// Waiters without a deadline sort after all others.
+type claimQueue []*claimWaiter
+
+// Len returns the number of waiters in the priority queue.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (q claimQueue) Len() int { return len(q) }
+
+// Less returns whether the waiter in the priority queue with index i should
+// sort before the waiter with index j.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (q claimQueue) Less(i, j int) bool {
+	return deadlineBefore(q[i].deadline, q[j].deadline) ||
+		(q[i].deadline == q[j].deadline && q[i].seq < q[j].seq)
+}
+
+// Swap swaps the waiters at the passed indices in the priority queue.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (q claimQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+// Push pushes the passed waiter onto the priority queue.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (q *claimQueue) Push(x interface{}) {
+	waiter := x.(*claimWaiter)
+	waiter.index = len(*q)
+	*q = append(*q, waiter)
+}
+
+// Pop removes the highest priority waiter (according to Less) from the
+// priority queue and returns it.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (q *claimQueue) Pop() interface{} {
+	n := len(*q)
+	waiter := (*q)[n-1]
+	waiter.index = -1
+	*q = (*q)[0 : n-1]
+	return waiter
+}
+
+// deadlineBefore returns whether deadline a is more pressing than deadline
+// b, where a deadline of zero means there's none.
+func deadlineBefore(a, b uint32) bool {
+	switch {
+	case a == 0:
+		return false
+	case b == 0:
+		return true
+	default:
+		return a < b
+	}
+}
+
+// resolverScheduler bounds the number of claim transactions that resolvers
+// across all channels broadcast at once. When more resolvers are waiting to
+// broadcast than there are slots, the one whose claim has the most pressing
+// deadline goes first. This ensures that after a mass force close, the most
+// time-critical outputs are claimed first.
+type resolverScheduler struct {
+	mu sync.Mutex
+
+	// freeSlots is the number of claims that may still be broadcast
+	// concurrently.
+	freeSlots int
+
+	// waiters is the queue of resolvers waiting for a free slot.
+	waiters claimQueue
+
+	// nextSeq is the sequence number of the next waiter.
+	nextSeq uint64
+}
+
+// newResolverScheduler creates a new resolverScheduler that allows at most
+// maxActive claims to be broadcast at once.
+func newResolverScheduler(maxActive int) *resolverScheduler {
+	return &resolverScheduler{
+		freeSlots: maxActive,
+	}
+}
+
+// acquire blocks until the caller may broadcast a claim that needs to confirm
+// by the passed deadline. The returned closure MUST be called once the claim
+// has been broadcast, to hand the slot to the next waiter.
+func (s *resolverScheduler) acquire(deadline uint32,
+	quit <-chan struct{}) (func(), error) {
+
+	s.mu.Lock()
+	if s.freeSlots > 0 && s.waiters.Len() == 0 {
+		s.freeSlots--
+		s.mu.Unlock()
+
+		return s.release, nil
+	}
+
+	waiter := &claimWaiter{
+		deadline: deadline,
+		seq:      s.nextSeq,
+		ready:    make(chan struct{}),
+	}
+	s.nextSeq++
+	heap.Push(&s.waiters, waiter)
+	s.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return s.release, nil
+
+	case <-quit:
+		s.mu.Lock()
+		granted := waiter.index == -1
+		if !granted {
+			heap.Remove(&s.waiters, waiter.index)
+		}
+		s.mu.Unlock()
+
+		// If we were granted the slot while quitting, we'll pass it
+		// on to the next waiter.
+		if granted {
+			s.release()
+		}
+
+		return nil, fmt.Errorf("quitting")
+	}
+}
+
+// release hands the slot of a finished claim to the waiter with the most
+// pressing deadline, or frees it if there's none.
+func (s *resolverScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.waiters.Len() == 0 {
+		s.freeSlots++
+		return
+	}
+
+	waiter := heap.Pop(&s.waiters).(*claimWaiter)
+	close(waiter.ready)
+}
+
+// resolverDeadline returns the height by which the output of the passed
+// resolver needs to be claimed, or zero if it's not bound by one.
+func resolverDeadline(resolver ContractResolver) uint32 {
+	switch r := resolver.(type) {
+	case *htlcTimeoutResolver:
+		return r.htlcResolution.Expiry
+
+	case *htlcOutgoingContestResolver:
+		return r.htlcResolution.Expiry
+
+	case *htlcSuccessResolver:
+		return r.expiry
+
+	case *htlcIncomingContestResolver:
+		return r.htlcExpiry
+
+	default:
+		return 0
+	}
+}
+
+// sortResolversByDeadline sorts the passed resolvers such that those with
+// the most pressing deadline come first.
+func sortResolversByDeadline(resolvers []ContractResolver) {
+	sort.SliceStable(resolvers, func(i, j int) bool {
+		return deadlineBefore(
+			resolverDeadline(resolvers[i]),
+			resolverDeadline(resolvers[j]),
+		)
+	})
+}
@@ -0,0 +1,198 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// waitForWaiters blocks until the passed number of resolvers are waiting on
+// the scheduler.
+func waitForWaiters(t *testing.T, s *resolverScheduler, n int) {
+	t.Helper()
+
+	for i := 0; i < 500; i++ {
+		s.mu.Lock()
+		numWaiters := s.waiters.Len()
+		s.mu.Unlock()
+
+		if numWaiters == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("expected %v waiters", n)
+}
+
+// TestResolverSchedulerDeadlineOrder tests that once all slots of the
+// scheduler are taken, claims are granted in order of their deadline, with
+// those without a deadline going last.
+func TestResolverSchedulerDeadlineOrder(t *testing.T) {
+	t.Parallel()
+
+	scheduler := newResolverScheduler(1)
+	quit := make(chan struct{})
+	defer close(quit)
+
+	// Take the only slot, such that all following claims need to wait.
+	release, err := scheduler.acquire(0, quit)
+	if err != nil {
+		t.Fatalf("unable to acquire slot: %v", err)
+	}
+
+	deadlines := []uint32{0, 300, 100, 200}
+	granted := make(chan uint32, len(deadlines))
+	for i, deadline := range deadlines {
+		go func(deadline uint32) {
+			release, err := scheduler.acquire(deadline, quit)
+			if err != nil {
+				return
+			}
+			granted <- deadline
+			release()
+		}(deadline)
+
+		waitForWaiters(t, scheduler, i+1)
+	}
+
+	release()
+
+	expectedOrder := []uint32{100, 200, 300, 0}
+	for _, expected := range expectedOrder {
+		select {
+		case deadline := <-granted:
+			if deadline != expected {
+				t.Fatalf("expected claim with deadline %v to "+
+					"be granted, got %v", expected, deadline)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("claim with deadline %v not granted",
+				expected)
+		}
+	}
+
+	// With all claims done, the slot should be free once again.
+	scheduler.mu.Lock()
+	freeSlots := scheduler.freeSlots
+	scheduler.mu.Unlock()
+	if freeSlots != 1 {
+		t.Fatalf("expected 1 free slot, got %v", freeSlots)
+	}
+}
+
+// TestResolverSchedulerQuit tests that a resolver quitting while waiting for
+// its turn is removed from the queue.
+func TestResolverSchedulerQuit(t *testing.T) {
+	t.Parallel()
+
+	scheduler := newResolverScheduler(1)
+	release, err := scheduler.acquire(0, nil)
+	if err != nil {
+		t.Fatalf("unable to acquire slot: %v", err)
+	}
+
+	quit := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := scheduler.acquire(100, quit)
+		errChan <- err
+	}()
+	waitForWaiters(t, scheduler, 1)
+
+	close(quit)
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("expected error after quitting")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("waiter didn't quit")
+	}
+	waitForWaiters(t, scheduler, 0)
+
+	// Releasing the slot should now free it, as no one is waiting.
+	release()
+	if _, err := scheduler.acquire(0, nil); err != nil {
+		t.Fatalf("unable to acquire slot: %v", err)
+	}
+}
+
+// TestSortResolversByDeadline tests that resolvers are ordered by the expiry
+// of the HTLC they resolve, with those not bound by one going last.
+func TestSortResolversByDeadline(t *testing.T) {
+	t.Parallel()
+
+	commitResolver := &commitSweepResolver{}
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry: 200,
+		},
+	}
+	successResolver := &htlcSuccessResolver{
+		expiry: 150,
+	}
+	contestResolver := &htlcIncomingContestResolver{
+		htlcExpiry: 100,
+	}
+
+	resolvers := []ContractResolver{
+		commitResolver, timeoutResolver, successResolver,
+		contestResolver,
+	}
+	sortResolversByDeadline(resolvers)
+
+	expected := []ContractResolver{
+		contestResolver, successResolver, timeoutResolver,
+		commitResolver,
+	}
+	for i := range expected {
+		if resolvers[i] != expected[i] {
+			t.Fatalf("#%v: expected %T with deadline %v, got %T "+
+				"with deadline %v", i, expected[i],
+				resolverDeadline(expected[i]), resolvers[i],
+				resolverDeadline(resolvers[i]))
+		}
+	}
+}
+
+// TestSuccessResolverExpiryStorage tests that the expiry of the HTLC claimed
+// by a success resolver is persisted along with it.
+func TestSuccessResolverExpiryStorage(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	resolver := &htlcSuccessResolver{
+		htlcResolution: lnwallet.IncomingHtlcResolution{
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+		expiry: 150,
+	}
+	if err := testLog.InsertUnresolvedContracts(resolver); err != nil {
+		t.Fatalf("unable to insert contract: %v", err)
+	}
+
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("expected 1 contract, instead got %v",
+			len(dbContracts))
+	}
+
+	dbExpiry := dbContracts[0].(*htlcSuccessResolver).expiry
+	if dbExpiry != resolver.expiry {
+		t.Fatalf("expected expiry %v, got %v", resolver.expiry,
+			dbExpiry)
+	}
+}
@@ -0,0 +1,642 @@
+package contractcourt
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// simConfClient is a client of the SimChain awaiting the confirmation of a
+// transaction.
+type simConfClient struct {
+	txid     chainhash.Hash
+	numConfs uint32
+
+	// confHeight is the height the transaction confirmed at, or zero if
+	// it's unconfirmed.
+	confHeight uint32
+
+	// dispatched is true once the client has been notified of the
+	// confirmation.
+	dispatched bool
+
+	event *chainntnfs.ConfirmationEvent
+}
+
+// simEpochClient is a client of the SimChain receiving a notification for
+// each new block.
+type simEpochClient struct {
+	epochQueue *chainntnfs.ConcurrentQueue
+	epochChan  chan *chainntnfs.BlockEpoch
+	cancelChan chan struct{}
+}
+
+// SimChain is an in-memory chain that implements the chainntnfs.ChainNotifier,
+// chainntnfs.MempoolNotifier, and lnwallet.BlockChainIO interfaces, along with
+// a PublishTx method that can be handed to the ChainArbitratorConfig.
+// Transactions are only confirmed once the caller mines a block, and blocks
+// can be disconnected to simulate reorgs. Together with the SimClock, this
+// allows the resolution of contracts to be driven end-to-end
+// deterministically.
+//
+// Scripts aren't validated, so any transaction can be broadcast, as long as
+// it doesn't double spend an output spent within the mempool or chain.
+type SimChain struct {
+	mu sync.Mutex
+
+	// blocks holds the blocks of the chain, indexed by height.
+	blocks []*wire.MsgBlock
+
+	// txHeights maps the txid of each confirmed transaction to the height
+	// it confirmed at.
+	txHeights map[chainhash.Hash]uint32
+
+	// spends holds the details of the spend of each output spent within
+	// the chain.
+	spends map[wire.OutPoint]*chainntnfs.SpendDetail
+
+	// mempool holds the transactions waiting to be mined, in order of
+	// their arrival.
+	mempool []*wire.MsgTx
+
+	// mempoolSpends maps each output spent within the mempool to the
+	// transaction spending it.
+	mempoolSpends map[wire.OutPoint]chainhash.Hash
+
+	// mempoolNtfns dispatches the notifications of transactions entering
+	// the mempool.
+	mempoolNtfns *chainntnfs.TxMempoolNotifier
+
+	// confClients, spendClients, and epochClients hold the registered
+	// notification clients, each identified by a unique ID.
+	confClients  map[uint64]*simConfClient
+	spendClients map[wire.OutPoint]map[uint64]chan *chainntnfs.SpendDetail
+	epochClients map[uint64]*simEpochClient
+	nextClientID uint64
+
+	// nonce is incremented with each mined block, such that blocks mined
+	// at the same height after a reorg have a distinct hash.
+	nonce uint32
+}
+
+// NewSimChain returns a new SimChain, consisting of just the regression test
+// network's genesis block.
+func NewSimChain() *SimChain {
+	return &SimChain{
+		blocks: []*wire.MsgBlock{
+			chaincfg.RegressionNetParams.GenesisBlock,
+		},
+		txHeights:     make(map[chainhash.Hash]uint32),
+		spends:        make(map[wire.OutPoint]*chainntnfs.SpendDetail),
+		mempoolSpends: make(map[wire.OutPoint]chainhash.Hash),
+		mempoolNtfns:  chainntnfs.NewTxMempoolNotifier(),
+		confClients:   make(map[uint64]*simConfClient),
+		spendClients: make(
+			map[wire.OutPoint]map[uint64]chan *chainntnfs.SpendDetail,
+		),
+		epochClients: make(map[uint64]*simEpochClient),
+	}
+}
+
+// Start is a no-op, as the SimChain is driven by its caller.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (s *SimChain) Start() error {
+	return nil
+}
+
+// Stop cancels all block epoch notifications.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (s *SimChain) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, client := range s.epochClients {
+		close(client.cancelChan)
+		client.epochQueue.Stop()
+		delete(s.epochClients, id)
+	}
+
+	return nil
+}
+
+// bestHeight returns the height of the tip of the chain.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *SimChain) bestHeight() uint32 {
+	return uint32(len(s.blocks) - 1)
+}
+
+// BestHeight returns the height of the tip of the chain.
+func (s *SimChain) BestHeight() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.bestHeight()
+}
+
+// Mempool returns the transactions waiting to be mined.
+func (s *SimChain) Mempool() []*wire.MsgTx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mempool := make([]*wire.MsgTx, len(s.mempool))
+	copy(mempool, s.mempool)
+
+	return mempool
+}
+
+// PublishTx adds the passed transaction to the mempool. If it spends an output
+// that's already spent by another transaction within the mempool or chain,
+// then lnwallet.ErrDoubleSpend is returned.
+func (s *SimChain) PublishTx(tx *wire.MsgTx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txid := tx.TxHash()
+	if _, ok := s.txHeights[txid]; ok {
+		return nil
+	}
+	for _, mempoolTx := range s.mempool {
+		if mempoolTx.TxHash() == txid {
+			return nil
+		}
+	}
+
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		if _, ok := s.spends[prevOut]; ok {
+			return lnwallet.ErrDoubleSpend
+		}
+		if _, ok := s.mempoolSpends[prevOut]; ok {
+			return lnwallet.ErrDoubleSpend
+		}
+	}
+
+	s.addToMempool(tx)
+
+	return nil
+}
+
+// addToMempool adds the passed transaction to the mempool.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *SimChain) addToMempool(tx *wire.MsgTx) {
+	txid := tx.TxHash()
+	for _, txIn := range tx.TxIn {
+		s.mempoolSpends[txIn.PreviousOutPoint] = txid
+	}
+	s.mempool = append(s.mempool, tx)
+	s.mempoolNtfns.NotifyMempoolTx(txid)
+}
+
+// MineBlock mines a new block on top of the chain, and dispatches all
+// resulting notifications. If no transactions are passed, then the block
+// includes the entire mempool. Otherwise, it includes exactly the passed
+// transactions, and any transactions within the mempool conflicting with them
+// are evicted. This allows a harness to simulate another party winning the
+// race to spend an output.
+func (s *SimChain) MineBlock(txns ...*wire.MsgTx) *wire.MsgBlock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(txns) == 0 {
+		txns = s.mempool
+	}
+
+	// Any transaction within the mempool that is either included in the
+	// block, or spends an output spent by it, is removed from the mempool.
+	included := make(map[chainhash.Hash]struct{})
+	spent := make(map[wire.OutPoint]struct{})
+	for _, tx := range txns {
+		included[tx.TxHash()] = struct{}{}
+		for _, txIn := range tx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+	mempool := s.mempool
+	s.mempool = nil
+	s.mempoolSpends = make(map[wire.OutPoint]chainhash.Hash)
+	for _, tx := range mempool {
+		if _, ok := included[tx.TxHash()]; ok {
+			continue
+		}
+
+		conflicts := false
+		for _, txIn := range tx.TxIn {
+			if _, ok := spent[txIn.PreviousOutPoint]; ok {
+				conflicts = true
+				break
+			}
+		}
+		if conflicts {
+			continue
+		}
+
+		s.addToMempool(tx)
+	}
+
+	s.nonce++
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   1,
+			PrevBlock: s.blocks[len(s.blocks)-1].BlockHash(),
+			Nonce:     s.nonce,
+		},
+		Transactions: txns,
+	}
+	s.blocks = append(s.blocks, block)
+	height := s.bestHeight()
+	blockHash := block.BlockHash()
+
+	for _, tx := range txns {
+		txid := tx.TxHash()
+		s.txHeights[txid] = height
+
+		for i, txIn := range tx.TxIn {
+			details := &chainntnfs.SpendDetail{
+				SpentOutPoint:     &tx.TxIn[i].PreviousOutPoint,
+				SpenderTxHash:     &txid,
+				SpendingTx:        tx,
+				SpenderInputIndex: uint32(i),
+				SpendingHeight:    int32(height),
+			}
+			s.spends[txIn.PreviousOutPoint] = details
+
+			clients := s.spendClients[txIn.PreviousOutPoint]
+			for _, spendChan := range clients {
+				spendChan <- details
+			}
+			delete(s.spendClients, txIn.PreviousOutPoint)
+		}
+	}
+
+	for _, client := range s.confClients {
+		if height, ok := s.txHeights[client.txid]; ok &&
+			client.confHeight == 0 {
+
+			client.confHeight = height
+		}
+		s.dispatchConf(client)
+	}
+
+	epoch := &chainntnfs.BlockEpoch{
+		Hash:   &blockHash,
+		Height: int32(height),
+	}
+	for _, client := range s.epochClients {
+		select {
+		case client.epochQueue.ChanIn() <- epoch:
+		case <-client.cancelChan:
+		}
+	}
+
+	return block
+}
+
+// DisconnectBlock disconnects the block at the tip of the chain, returning
+// its transactions to the mempool. Clients awaiting the confirmation of these
+// transactions are notified of the reorg.
+func (s *SimChain) DisconnectBlock() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	height := s.bestHeight()
+	if height == 0 {
+		return fmt.Errorf("unable to disconnect genesis block")
+	}
+
+	block := s.blocks[height]
+	s.blocks = s.blocks[:height]
+
+	for _, tx := range block.Transactions {
+		delete(s.txHeights, tx.TxHash())
+		for _, txIn := range tx.TxIn {
+			delete(s.spends, txIn.PreviousOutPoint)
+		}
+	}
+
+	for _, client := range s.confClients {
+		if client.confHeight != height {
+			continue
+		}
+
+		// Clients that haven't consumed a prior reorg notification
+		// won't be notified again.
+		if client.dispatched {
+			select {
+			case client.event.NegativeConf <- 1:
+			default:
+			}
+		}
+		client.confHeight = 0
+		client.dispatched = false
+	}
+
+	// The transactions of the block are placed in front of those that
+	// arrived in the mempool since.
+	mempool := s.mempool
+	s.mempool = nil
+	s.mempoolSpends = make(map[wire.OutPoint]chainhash.Hash)
+	for _, tx := range block.Transactions {
+		s.addToMempool(tx)
+	}
+	for _, tx := range mempool {
+		s.addToMempool(tx)
+	}
+
+	return nil
+}
+
+// dispatchConf notifies the passed client of the confirmation of its
+// transaction, if it has reached the requested number of confirmations.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *SimChain) dispatchConf(client *simConfClient) {
+	if client.dispatched || client.confHeight == 0 {
+		return
+	}
+
+	numConfs := s.bestHeight() - client.confHeight + 1
+	if numConfs < client.numConfs {
+		return
+	}
+
+	block := s.blocks[client.confHeight]
+	blockHash := block.BlockHash()
+	var txIndex uint32
+	for i, tx := range block.Transactions {
+		if tx.TxHash() == client.txid {
+			txIndex = uint32(i)
+			break
+		}
+	}
+
+	// Clients that haven't consumed a prior confirmation, as they've since
+	// stopped listening, won't be notified again.
+	select {
+	case client.event.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHash:   &blockHash,
+		BlockHeight: client.confHeight,
+		TxIndex:     txIndex,
+	}:
+	default:
+	}
+	client.dispatched = true
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once the
+// passed transaction reaches the passed number of confirmations. Should the
+// transaction be reorged out of the chain after the notification, the reorg
+// is signalled over the NegativeConf channel, and the transaction is notified
+// again once it re-confirms.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (s *SimChain) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte,
+	numConfs, _ uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextClientID
+	s.nextClientID++
+
+	client := &simConfClient{
+		txid:       *txid,
+		numConfs:   numConfs,
+		confHeight: s.txHeights[*txid],
+		event: &chainntnfs.ConfirmationEvent{
+			Confirmed:    make(chan *chainntnfs.TxConfirmation, 1),
+			Updates:      make(chan uint32, numConfs),
+			NegativeConf: make(chan int32, 1),
+		},
+	}
+	s.confClients[id] = client
+	s.dispatchConf(client)
+
+	return client.event, nil
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the passed output
+// is spent by a transaction within the chain. If it's already been spent,
+// then the notification is dispatched right away.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (s *SimChain) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
+	_ uint32) (*chainntnfs.SpendEvent, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	if details, ok := s.spends[*outpoint]; ok {
+		spendChan <- details
+
+		return &chainntnfs.SpendEvent{
+			Spend:  spendChan,
+			Cancel: func() {},
+		}, nil
+	}
+
+	id := s.nextClientID
+	s.nextClientID++
+
+	clients, ok := s.spendClients[*outpoint]
+	if !ok {
+		clients = make(map[uint64]chan *chainntnfs.SpendDetail)
+		s.spendClients[*outpoint] = clients
+	}
+	clients[id] = spendChan
+
+	return &chainntnfs.SpendEvent{
+		Spend: spendChan,
+		Cancel: func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			delete(s.spendClients[*outpoint], id)
+		},
+	}, nil
+}
+
+// RegisterMempoolNtfn registers an intent to be notified once the passed
+// transaction enters the mempool. If it's already within the mempool, then
+// the notification is dispatched right away.
+//
+// NOTE: Part of the chainntnfs.MempoolNotifier interface.
+func (s *SimChain) RegisterMempoolNtfn(
+	txid *chainhash.Hash) (*chainntnfs.MempoolEvent, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event := s.mempoolNtfns.Register(*txid)
+	for _, tx := range s.mempool {
+		if tx.TxHash() == *txid {
+			s.mempoolNtfns.NotifyMempoolTx(*txid)
+			break
+		}
+	}
+
+	return event, nil
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of each new block
+// connected to the tip of the chain. If a best block is passed, then any
+// blocks connected since are delivered first.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (s *SimChain) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextClientID
+	s.nextClientID++
+
+	client := &simEpochClient{
+		epochQueue: chainntnfs.NewConcurrentQueue(20),
+		epochChan:  make(chan *chainntnfs.BlockEpoch, 20),
+		cancelChan: make(chan struct{}),
+	}
+	client.epochQueue.Start()
+	s.epochClients[id] = client
+
+	// We'll proxy the epochs added to the queue to the client itself.
+	go func() {
+		for {
+			select {
+			case ntfn := <-client.epochQueue.ChanOut():
+				epoch := ntfn.(*chainntnfs.BlockEpoch)
+				select {
+				case client.epochChan <- epoch:
+				case <-client.cancelChan:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+			}
+		}
+	}()
+
+	if bestBlock != nil {
+		height := uint32(bestBlock.Height) + 1
+		for ; height <= s.bestHeight(); height++ {
+			blockHash := s.blocks[height].BlockHash()
+			client.epochQueue.ChanIn() <- &chainntnfs.BlockEpoch{
+				Hash:   &blockHash,
+				Height: int32(height),
+			}
+		}
+	}
+
+	var cancelOnce sync.Once
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: client.epochChan,
+		Cancel: func() {
+			cancelOnce.Do(func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+
+				if _, ok := s.epochClients[id]; !ok {
+					return
+				}
+
+				close(client.cancelChan)
+				client.epochQueue.Stop()
+				delete(s.epochClients, id)
+			})
+		},
+	}, nil
+}
+
+// GetBestBlock returns the hash and height of the tip of the chain.
+//
+// NOTE: Part of the lnwallet.BlockChainIO interface.
+func (s *SimChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	height := s.bestHeight()
+	blockHash := s.blocks[height].BlockHash()
+
+	return &blockHash, int32(height), nil
+}
+
+// GetUtxo returns the output referenced by the passed outpoint, as long as
+// it's been created and not yet spent within the chain.
+//
+// NOTE: Part of the lnwallet.BlockChainIO interface.
+func (s *SimChain) GetUtxo(op *wire.OutPoint, _ []byte,
+	_ uint32) (*wire.TxOut, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.spends[*op]; ok {
+		return nil, fmt.Errorf("output %v already spent", op)
+	}
+
+	height, ok := s.txHeights[op.Hash]
+	if !ok {
+		return nil, fmt.Errorf("output %v not found", op)
+	}
+	for _, tx := range s.blocks[height].Transactions {
+		if tx.TxHash() != op.Hash {
+			continue
+		}
+		if int(op.Index) >= len(tx.TxOut) {
+			break
+		}
+
+		return tx.TxOut[op.Index], nil
+	}
+
+	return nil, fmt.Errorf("output %v not found", op)
+}
+
+// GetBlockHash returns the hash of the block at the passed height.
+//
+// NOTE: Part of the lnwallet.BlockChainIO interface.
+func (s *SimChain) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if blockHeight < 0 || blockHeight >= int64(len(s.blocks)) {
+		return nil, fmt.Errorf("no block at height %v", blockHeight)
+	}
+
+	blockHash := s.blocks[blockHeight].BlockHash()
+	return &blockHash, nil
+}
+
+// GetBlock returns the block with the passed hash.
+//
+// NOTE: Part of the lnwallet.BlockChainIO interface.
+func (s *SimChain) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, block := range s.blocks {
+		if block.BlockHash() == *blockHash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block %v not found", blockHash)
+}
+
+// A compile time assertion to ensure SimChain meets the ChainNotifier and
+// BlockChainIO interfaces.
+var (
+	_ chainntnfs.ChainNotifier = (*SimChain)(nil)
+	_ lnwallet.BlockChainIO    = (*SimChain)(nil)
+)
@@ -0,0 +1,418 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// mockSigner is a lnwallet.Signer that produces dummy signatures, which is
+// sufficient for the SimChain as it doesn't validate scripts.
+type mockSigner struct{}
+
+func (mockSigner) SignOutputRaw(*wire.MsgTx,
+	*lnwallet.SignDescriptor) ([]byte, error) {
+
+	return make([]byte, 64), nil
+}
+
+func (mockSigner) ComputeInputScript(*wire.MsgTx,
+	*lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{}, nil
+}
+
+// TestSimChainReorg tests that the SimChain rejects double spends, and that
+// clients awaiting a confirmation are notified of reorgs.
+func TestSimChainReorg(t *testing.T) {
+	t.Parallel()
+
+	chain := NewSimChain()
+	defer chain.Stop()
+
+	prevOut := randOutPoint()
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: prevOut})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x00}})
+	txid := tx.TxHash()
+
+	conflictTx := tx.Copy()
+	conflictTx.LockTime = 1
+
+	if err := chain.PublishTx(tx); err != nil {
+		t.Fatalf("unable to publish tx: %v", err)
+	}
+	if err := chain.PublishTx(conflictTx); err != lnwallet.ErrDoubleSpend {
+		t.Fatalf("expected double spend, got %v", err)
+	}
+
+	confEvent, err := chain.RegisterConfirmationsNtfn(&txid, nil, 1, 0)
+	if err != nil {
+		t.Fatalf("unable to register for conf: %v", err)
+	}
+	spendEvent, err := chain.RegisterSpendNtfn(&prevOut, nil, 0)
+	if err != nil {
+		t.Fatalf("unable to register for spend: %v", err)
+	}
+
+	chain.MineBlock()
+
+	select {
+	case conf := <-confEvent.Confirmed:
+		if conf.BlockHeight != 1 {
+			t.Fatalf("expected conf at height 1, got %v",
+				conf.BlockHeight)
+		}
+	default:
+		t.Fatalf("tx not confirmed")
+	}
+	select {
+	case spend := <-spendEvent.Spend:
+		if *spend.SpenderTxHash != txid {
+			t.Fatalf("expected spend by %v, got %v", txid,
+				spend.SpenderTxHash)
+		}
+	default:
+		t.Fatalf("spend not notified")
+	}
+	_, err = chain.GetUtxo(&wire.OutPoint{Hash: txid}, nil, 0)
+	if err != nil {
+		t.Fatalf("unable to fetch utxo: %v", err)
+	}
+
+	// Disconnecting the block should notify the client of the reorg, and
+	// return the transaction to the mempool.
+	if err := chain.DisconnectBlock(); err != nil {
+		t.Fatalf("unable to disconnect block: %v", err)
+	}
+	select {
+	case <-confEvent.NegativeConf:
+	default:
+		t.Fatalf("reorg not notified")
+	}
+	if len(chain.Mempool()) != 1 {
+		t.Fatalf("expected tx to be back in the mempool")
+	}
+
+	// The conflicting transaction can now win the race, evicting the
+	// original one from the mempool.
+	chain.MineBlock(conflictTx)
+	if len(chain.Mempool()) != 0 {
+		t.Fatalf("expected conflicting tx to be evicted")
+	}
+	if err := chain.PublishTx(tx); err != lnwallet.ErrDoubleSpend {
+		t.Fatalf("expected double spend, got %v", err)
+	}
+	select {
+	case <-confEvent.Confirmed:
+		t.Fatalf("evicted tx confirmed")
+	default:
+	}
+}
+
+// TestSimChainCommitSweep tests that a resolver can be driven end-to-end by
+// the SimChain and SimClock, by resolving the commitment output on the remote
+// party's commitment through the sweep batcher.
+func TestSimChainCommitSweep(t *testing.T) {
+	t.Parallel()
+
+	chain := NewSimChain()
+	defer chain.Stop()
+	clock := NewSimClock(time.Unix(0, 0))
+
+	const batchWindow = time.Minute
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: 1000},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		},
+		batchWindow, clock,
+	)
+	if err := batcher.Start(); err != nil {
+		t.Fatalf("unable to start batcher: %v", err)
+	}
+	defer batcher.Stop()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// The remote party broadcasts their commitment, which confirms.
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{PreviousOutPoint: randOutPoint()})
+	commitTx.AddTxOut(&wire.TxOut{Value: 100000, PkScript: []byte{0x00}})
+	if err := chain.PublishTx(commitTx); err != nil {
+		t.Fatalf("unable to publish commit tx: %v", err)
+	}
+	chain.MineBlock()
+
+	signDesc := testSignDesc
+	signDesc.KeyDesc.PubKey = privKey.PubKey()
+	signDesc.Output = commitTx.TxOut[0]
+	commitOutpoint := wire.OutPoint{Hash: commitTx.TxHash()}
+
+	resolver := &commitSweepResolver{
+		commitResolution: lnwallet.CommitOutputResolution{
+			SelfOutPoint:       commitOutpoint,
+			SelfOutputSignDesc: signDesc,
+		},
+		broadcastHeight: 1,
+		chanPoint:       testChanPoint1,
+		ResolverKit: ResolverKit{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ChainArbitratorConfig: ChainArbitratorConfig{
+					ChainIO:        chain,
+					Notifier:       chain,
+					PublishTx:      chain.PublishTx,
+					Signer:         mockSigner{},
+					ClaimConfDepth: 1,
+					Clock:          clock,
+					sweeper:        batcher,
+				},
+			},
+			Checkpoint: func(ContractResolver) error {
+				return nil
+			},
+			Quit: make(chan struct{}),
+		},
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := resolver.Resolve()
+		errChan <- err
+	}()
+
+	// The sweep is only crafted once the batch window elapses, so we'll
+	// wait for the batcher to arm its timer before advancing the clock.
+	waitFor := func(cond func() bool, desc string) {
+		t.Helper()
+
+		for i := 0; i < 500; i++ {
+			if cond() {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for %v", desc)
+	}
+	waitFor(func() bool {
+		return clock.NumTimers() == 1
+	}, "batch window")
+	clock.Advance(batchWindow)
+
+	waitFor(func() bool {
+		return len(chain.Mempool()) == 1
+	}, "sweep tx")
+	sweepTx := chain.Mempool()[0]
+	if sweepTx.TxIn[0].PreviousOutPoint != commitOutpoint {
+		t.Fatalf("sweep spends %v instead of commit output",
+			sweepTx.TxIn[0].PreviousOutPoint)
+	}
+
+	// Once the sweep confirms, the output is resolved.
+	chain.MineBlock()
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to resolve commit output: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("commit output not resolved")
+	}
+
+	if !resolver.resolved ||
+		resolver.sweepTx.TxHash() != sweepTx.TxHash() {
+
+		t.Fatalf("expected output to be resolved by the sweep")
+	}
+}
+
+// TestSimChainBatchSweepConflict tests that once a batched sweep has been
+// accepted into the mempool, the remote party spending the output of one
+// resolver within the batch doesn't leave the others waiting for a sweep that
+// can never confirm: the resolver whose output was spent considers the spend
+// its sweep, while the other rebuilds its sweep to spend its output alone.
+func TestSimChainBatchSweepConflict(t *testing.T) {
+	t.Parallel()
+
+	chain := NewSimChain()
+	defer chain.Stop()
+	clock := NewSimClock(time.Unix(0, 0))
+
+	const batchWindow = time.Minute
+	sweepScript := []byte{0x00, 0x14}
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: 1000},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return sweepScript, nil
+		},
+		batchWindow, clock,
+	)
+	if err := batcher.Start(); err != nil {
+		t.Fatalf("unable to start batcher: %v", err)
+	}
+	defer batcher.Stop()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// The remote party broadcasts their commitment, which pays to two
+	// outputs of ours, each resolved by a resolver of its own.
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{PreviousOutPoint: randOutPoint()})
+	commitTx.AddTxOut(&wire.TxOut{Value: 100000, PkScript: []byte{0x00}})
+	commitTx.AddTxOut(&wire.TxOut{Value: 100000, PkScript: []byte{0x01}})
+	if err := chain.PublishTx(commitTx); err != nil {
+		t.Fatalf("unable to publish commit tx: %v", err)
+	}
+	chain.MineBlock()
+
+	newResolver := func(index uint32) *commitSweepResolver {
+		signDesc := testSignDesc
+		signDesc.KeyDesc.PubKey = privKey.PubKey()
+		signDesc.Output = commitTx.TxOut[index]
+
+		return &commitSweepResolver{
+			commitResolution: lnwallet.CommitOutputResolution{
+				SelfOutPoint: wire.OutPoint{
+					Hash:  commitTx.TxHash(),
+					Index: index,
+				},
+				SelfOutputSignDesc: signDesc,
+			},
+			broadcastHeight: 1,
+			chanPoint:       testChanPoint1,
+			ResolverKit: ResolverKit{
+				ChannelArbitratorConfig: ChannelArbitratorConfig{
+					ChainArbitratorConfig: ChainArbitratorConfig{
+						ChainIO:   chain,
+						Notifier:  chain,
+						PublishTx: chain.PublishTx,
+						Signer:    mockSigner{},
+						FeeEstimator: lnwallet.StaticFeeEstimator{
+							FeePerKW: 1000,
+						},
+						NewSweepAddr: func() ([]byte, error) {
+							return sweepScript, nil
+						},
+						ClaimConfDepth: 1,
+						Clock:          clock,
+						sweeper:        batcher,
+					},
+				},
+				Checkpoint: func(ContractResolver) error {
+					return nil
+				},
+				Quit: make(chan struct{}),
+			},
+		}
+	}
+	resolvers := []*commitSweepResolver{newResolver(0), newResolver(1)}
+
+	errChan := make(chan error, len(resolvers))
+	for _, resolver := range resolvers {
+		resolver := resolver
+		go func() {
+			_, err := resolver.Resolve()
+			errChan <- err
+		}()
+	}
+
+	waitFor := func(cond func() bool, desc string) {
+		t.Helper()
+
+		for i := 0; i < 500; i++ {
+			if cond() {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for %v", desc)
+	}
+	assertResolved := func() {
+		t.Helper()
+
+		select {
+		case err := <-errChan:
+			if err != nil {
+				t.Fatalf("unable to resolve commit output: %v",
+					err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("commit output not resolved")
+		}
+	}
+
+	// Both outputs should be swept by a single batched sweep.
+	waitFor(func() bool {
+		return clock.NumTimers() == 1
+	}, "batch window")
+	clock.Advance(batchWindow)
+
+	waitFor(func() bool {
+		return len(chain.Mempool()) == 1
+	}, "batched sweep tx")
+	batchTx := chain.Mempool()[0]
+	if len(batchTx.TxIn) != 2 {
+		t.Fatalf("expected batched sweep of 2 outputs, got %v inputs",
+			len(batchTx.TxIn))
+	}
+
+	// Once both resolvers are waiting for the batched sweep to confirm, a
+	// transaction spending the second output is mined instead, evicting
+	// the batched sweep from the mempool.
+	waitFor(func() bool {
+		chain.mu.Lock()
+		defer chain.mu.Unlock()
+
+		return len(chain.epochClients) == len(resolvers)
+	}, "resolvers to await sweep conf")
+
+	remoteTx := wire.NewMsgTx(2)
+	remoteTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: resolvers[1].commitResolution.SelfOutPoint,
+	})
+	remoteTx.AddTxOut(&wire.TxOut{Value: 90000, PkScript: []byte{0x02}})
+	chain.MineBlock(remoteTx)
+
+	// The resolver of the second output should consider the spend its
+	// sweep.
+	assertResolved()
+	if resolvers[1].sweepTx.TxHash() != remoteTx.TxHash() {
+		t.Fatalf("expected second output to be resolved by its spend")
+	}
+
+	// The batched sweep goes unseen within the mempool, so the resolver
+	// of the first output will rebroadcast it, and upon the conflict,
+	// rebuild it to sweep its output alone.
+	for i := 1; i < claimMempoolRebroadcastBlocks; i++ {
+		chain.MineBlock()
+	}
+	waitFor(func() bool {
+		return len(chain.Mempool()) == 1
+	}, "rebuilt sweep tx")
+	sweepTx := chain.Mempool()[0]
+	if len(sweepTx.TxIn) != 1 || sweepTx.TxIn[0].PreviousOutPoint !=
+		resolvers[0].commitResolution.SelfOutPoint {
+
+		t.Fatalf("expected rebuilt sweep of first output alone")
+	}
+
+	chain.MineBlock()
+	assertResolved()
+	if !resolvers[0].resolved ||
+		resolvers[0].sweepTx.TxHash() != sweepTx.TxHash() {
+
+		t.Fatalf("expected first output to be resolved by the " +
+			"rebuilt sweep")
+	}
+}
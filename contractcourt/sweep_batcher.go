@@ -0,0 +1,428 @@
+package contractcourt
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// defaultSweepBatchWindow is the default amount of time the sweep
+	// batcher will wait for other sweep requests after receiving the first
+	// request of a batch. Outputs of the same commitment transaction all
+	// mature at once, so their requests arrive in quick succession.
+	defaultSweepBatchWindow = 5 * time.Second
+)
+
+// defaultSweepFeePreference is the fee preference we'll use when sweeping
+// outputs back into the wallet, if none has been configured. These outputs
+// are in no immediate danger, so we use a lax confirmation target.
+var defaultSweepFeePreference = lnwallet.FeePreference{
+	ConfTarget: 6,
+}
+
+// witnessGenerator generates a valid witness for an input of the passed sweep
+// transaction. The sign descriptor will have its InputIndex and SigHashes
+// populated for the transaction.
+type witnessGenerator func(*wire.MsgTx, *lnwallet.SignDescriptor) (
+	wire.TxWitness, error)
+
+// sweepRequest is a request to sweep a single output back into the wallet.
+type sweepRequest struct {
+	// outpoint is the output to be swept.
+	outpoint wire.OutPoint
+
+	// signDesc is the sign descriptor of the output to be swept.
+	signDesc lnwallet.SignDescriptor
+
+	// witnessSize is the estimated size of the witness that will spend
+	// the output.
+	witnessSize int
+
+	// genWitness generates the witness that will spend the output.
+	genWitness witnessGenerator
+
+	// maxFee is the largest fee the output may contribute to the sweep,
+	// which is the fee attributable to the input spending it. A value of
+	// zero means no limit.
+	maxFee btcutil.Amount
+
+	// deadline, if non-zero, is the height by which the output must be
+	// swept. The confirmation target of the sweep is reduced to meet it.
+	deadline uint32
+
+	// height is the best block height known to the requester, as of
+	// which the deadline is evaluated.
+	height uint32
+
+	// alone is true if the output should be swept in a transaction of its
+	// own, rather than being batched with others.
+	alone bool
+
+	// fee is the portion of the sweep's fee attributed to the output. It's
+	// set once the sweep transaction has been crafted.
+	fee btcutil.Amount
+
+	// quit is closed if the requester is no longer interested in the
+	// sweep.
+	quit chan struct{}
+
+	// resp is the channel the crafted sweep transaction will be sent over.
+	resp chan *sweepResponse
+}
+
+// inputFee returns the portion of the sweep's fee that's attributable to the
+// input spending the requested output, at the passed fee rate.
+func (r *sweepRequest) inputFee(feePerKw lnwallet.SatPerKWeight) btcutil.Amount {
+	weight := lnwallet.InputSize*blockchain.WitnessScaleFactor +
+		r.witnessSize
+
+	return feePerKw.FeeForWeight(int64(weight))
+}
+
+// exceedsMaxFee returns true if the fee attributable to the input spending the
+// requested output would exceed its max fee at the passed fee rate.
+func (r *sweepRequest) exceedsMaxFee(feePerKw lnwallet.SatPerKWeight) bool {
+	return r.maxFee != 0 && r.inputFee(feePerKw) > r.maxFee
+}
+
+// batchFeePreference returns the fee preference a sweep of the passed requests
+// must satisfy, along with the height it's evaluated as of. The sweep must
+// confirm by the earliest deadline of the requests.
+func batchFeePreference(feePref lnwallet.FeePreference,
+	reqs []*sweepRequest) (lnwallet.FeePreference, uint32) {
+
+	var height uint32
+	for _, req := range reqs {
+		if req.height > height {
+			height = req.height
+		}
+		if req.deadline != 0 && (feePref.Deadline == 0 ||
+			req.deadline < feePref.Deadline) {
+
+			feePref.Deadline = req.deadline
+		}
+	}
+
+	return feePref, height
+}
+
+// sweepFeeRate returns the fee rate a sweep of the passed requests should pay
+// to satisfy the passed fee preference, and confirm by the earliest deadline
+// of the requests.
+func sweepFeeRate(feeEstimator lnwallet.FeeEstimator,
+	feePref lnwallet.FeePreference,
+	reqs []*sweepRequest) (lnwallet.SatPerKWeight, error) {
+
+	feePref, height := batchFeePreference(feePref, reqs)
+	return lnwallet.DetermineFeePerKw(feeEstimator, feePref, height)
+}
+
+// sweepResponse is the result of a sweepRequest.
+type sweepResponse struct {
+	sweepTx *wire.MsgTx
+	err     error
+}
+
+// sweepBatcher aggregates the sweeps of multiple contract resolvers into a
+// single transaction. Instead of each resolver crafting a transaction for its
+// own output, and paying for the overhead of a transaction, all requests that
+// arrive within the batch window are swept together. This includes preimage
+// claims of incoming HTLCs on the commitments of remote parties across all
+// channels, which become claimable at once when we learn the preimage.
+// Second-level success transactions on our commitment can't be aggregated, as
+// they carry a signature of the remote party committing to the entire
+// transaction. All outputs handed to the batcher are ours to sign, and each
+// input is only signed once the sweep has been fully assembled.
+//
+// The outputs of a batch share their fate: should the remote party spend any
+// one of them, the batched sweep can no longer confirm. Requesters MUST
+// therefore broadcast the sweep with publishSweep, and wait for it with
+// waitForSweepConf, which rebuild the sweep to spend their output alone upon
+// such a conflict.
+type sweepBatcher struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// feeEstimator is used to determine the fee rate of the sweep.
+	feeEstimator lnwallet.FeeEstimator
+
+	// feePref is the fee preference the sweep transactions must satisfy.
+	feePref lnwallet.FeePreference
+
+	// newSweepAddr returns a fresh address to sweep the outputs to.
+	newSweepAddr func() ([]byte, error)
+
+	// batchWindow is the amount of time we'll wait for further requests
+	// after receiving the first request of a batch.
+	batchWindow time.Duration
+
+	// clock is used to time the batch window.
+	clock Clock
+
+	requests chan *sweepRequest
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSweepBatcher returns a new sweep batcher that will sweep all outputs
+// requested within the passed batch window in a single transaction.
+func newSweepBatcher(feeEstimator lnwallet.FeeEstimator,
+	feePref lnwallet.FeePreference, newSweepAddr func() ([]byte, error),
+	batchWindow time.Duration, clock Clock) *sweepBatcher {
+
+	return &sweepBatcher{
+		feeEstimator: feeEstimator,
+		feePref:      feePref,
+		newSweepAddr: newSweepAddr,
+		batchWindow:  batchWindow,
+		clock:        clock,
+		requests:     make(chan *sweepRequest),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that batches incoming sweep requests.
+func (s *sweepBatcher) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	s.wg.Add(1)
+	go s.batchRequests()
+
+	return nil
+}
+
+// Stop signals the sweep batcher to exit, and waits for it to do so.
+func (s *sweepBatcher) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	close(s.quit)
+	s.wg.Wait()
+
+	return nil
+}
+
+// sweep hands off the request to the batcher, and blocks until the
+// transaction sweeping the output has been crafted. The transaction is NOT
+// broadcast, as the caller is expected to first checkpoint it. Broadcasting
+// the same transaction from each resolver in the batch is harmless. Should
+// the batch conflict with a spend of another requester's output, then the
+// caller is expected to rebuild the sweep with the alone flag set.
+func (s *sweepBatcher) sweep(req *sweepRequest) (*wire.MsgTx, error) {
+	req.resp = make(chan *sweepResponse, 1)
+
+	select {
+	case s.requests <- req:
+	case <-req.quit:
+		return nil, fmt.Errorf("quitting")
+	case <-s.quit:
+		return nil, fmt.Errorf("sweep batcher shutting down")
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp.sweepTx, resp.err
+	case <-req.quit:
+		return nil, fmt.Errorf("quitting")
+	case <-s.quit:
+		return nil, fmt.Errorf("sweep batcher shutting down")
+	}
+}
+
+// batchRequests collects incoming sweep requests, and once the batch window
+// of the first request of a batch has elapsed, crafts a single transaction
+// sweeping all of them.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *sweepBatcher) batchRequests() {
+	defer s.wg.Done()
+
+	var (
+		batch      []*sweepRequest
+		batchTimer <-chan time.Time
+	)
+	for {
+		select {
+		case req := <-s.requests:
+			batch = append(batch, req)
+			if batchTimer == nil {
+				batchTimer = s.clock.After(s.batchWindow)
+			}
+
+		case <-batchTimer:
+			s.sweepBatch(batch)
+
+			batch = nil
+			batchTimer = nil
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// sweepBatch crafts a transaction sweeping all requests within the batch
+// that are still active, and delivers it to each requester.
+func (s *sweepBatcher) sweepBatch(batch []*sweepRequest) {
+	// Requesters that have since quit won't checkpoint the transaction,
+	// so we leave their outputs out of the batch.
+	var active []*sweepRequest
+	for _, req := range batch {
+		select {
+		case <-req.quit:
+			continue
+		default:
+		}
+
+		active = append(active, req)
+	}
+	if len(active) == 0 {
+		return
+	}
+
+	// Outputs that would contribute more than their max fee to the sweep
+	// are left out, such that their requesters can retry later on, while
+	// the remaining outputs are swept at the same fee rate.
+	feePerKw, err := sweepFeeRate(s.feeEstimator, s.feePref, active)
+	if err != nil {
+		for _, req := range active {
+			req.resp <- &sweepResponse{err: err}
+		}
+		return
+	}
+	withinBudget := active[:0]
+	for _, req := range active {
+		if req.exceedsMaxFee(feePerKw) {
+			req.resp <- &sweepResponse{
+				err: lnwallet.ErrFeeExceedsMax,
+			}
+			continue
+		}
+
+		withinBudget = append(withinBudget, req)
+	}
+	active = withinBudget
+	if len(active) == 0 {
+		return
+	}
+
+	log.Infof("Sweeping %v outputs in a single batch", len(active))
+
+	sweepTx, err := assembleSweepTx(feePerKw, s.newSweepAddr, active)
+	for _, req := range active {
+		req.resp <- &sweepResponse{
+			sweepTx: sweepTx,
+			err:     err,
+		}
+	}
+}
+
+// craftSweepTx crafts and signs a transaction that sweeps the outputs of all
+// passed requests to a fresh wallet address, paying a fee that satisfies the
+// passed fee preference, and confirms by the earliest deadline of the
+// requests. If the fee attributable to any of the outputs would exceed its max
+// fee, then lnwallet.ErrFeeExceedsMax is returned. This also applies to
+// outputs swept alone, without going through the batcher.
+func craftSweepTx(feeEstimator lnwallet.FeeEstimator,
+	feePref lnwallet.FeePreference, newSweepAddr func() ([]byte, error),
+	reqs []*sweepRequest) (*wire.MsgTx, error) {
+
+	feePerKw, err := sweepFeeRate(feeEstimator, feePref, reqs)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range reqs {
+		if req.exceedsMaxFee(feePerKw) {
+			return nil, lnwallet.ErrFeeExceedsMax
+		}
+	}
+
+	return assembleSweepTx(feePerKw, newSweepAddr, reqs)
+}
+
+// assembleSweepTx assembles and signs a transaction that sweeps the outputs of
+// all passed requests to a fresh wallet address at the passed fee rate. The
+// max fees of the requests are expected to have been checked by the caller.
+func assembleSweepTx(feePerKw lnwallet.SatPerKWeight,
+	newSweepAddr func() ([]byte, error),
+	reqs []*sweepRequest) (*wire.MsgTx, error) {
+
+	sweepAddr, err := newSweepAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using a weight estimator, we'll compute the total fee required to
+	// sweep all outputs, and from that the value we'll end up with.
+	var (
+		weightEstimate lnwallet.TxWeightEstimator
+		totalAmt       int64
+	)
+	sweepTx := wire.NewMsgTx(2)
+	for _, req := range reqs {
+		weightEstimate.AddWitnessInput(req.witnessSize)
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: req.outpoint,
+		})
+
+		totalAmt += req.signDesc.Output.Value
+	}
+	weightEstimate.AddP2WKHOutput()
+
+	totalFees := feePerKw.FeeForWeight(int64(weightEstimate.Weight()))
+	sweepAmt := totalAmt - int64(totalFees)
+	if sweepAmt <= 0 {
+		return nil, fmt.Errorf("sweep of %v outputs worth %v sat would "+
+			"pay %v sat in fees", len(reqs), totalAmt, totalFees)
+	}
+
+	// Each output is attributed a portion of the fee proportional to the
+	// weight of the input spending it.
+	var totalInputFees btcutil.Amount
+	for _, req := range reqs {
+		totalInputFees += req.inputFee(feePerKw)
+	}
+	for _, req := range reqs {
+		if totalInputFees == 0 {
+			break
+		}
+		req.fee = totalFees * req.inputFee(feePerKw) / totalInputFees
+	}
+
+	log.Debugf("Using %v sat/kw to sweep %v outputs", int64(feePerKw),
+		len(reqs))
+
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: sweepAddr,
+		Value:    sweepAmt,
+	})
+
+	// With the transaction fully assembled, we can now generate a valid
+	// witness for each of the inputs.
+	hashCache := txscript.NewTxSigHashes(sweepTx)
+	for i, req := range reqs {
+		signDesc := req.signDesc
+		signDesc.SigHashes = hashCache
+		signDesc.InputIndex = i
+
+		witness, err := req.genWitness(sweepTx, &signDesc)
+		if err != nil {
+			return nil, err
+		}
+		sweepTx.TxIn[i].Witness = witness
+	}
+
+	return sweepTx, nil
+}
@@ -0,0 +1,328 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestSweepBatcherBatchesRequests tests that sweep requests arriving within
+// the same batch window are swept by a single transaction.
+func TestSweepBatcherBatchesRequests(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numRequests = 3
+		feePerKw    = lnwallet.SatPerKWeight(1000)
+	)
+	sweepScript := []byte{0x00, 0x14}
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: feePerKw},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return sweepScript, nil
+		},
+		50*time.Millisecond, systemClock{},
+	)
+	if err := batcher.Start(); err != nil {
+		t.Fatalf("unable to start batcher: %v", err)
+	}
+	defer batcher.Stop()
+
+	// Each request will generate a witness that marks the input index it
+	// was signed for, allowing us to verify each witness ended up with the
+	// proper input.
+	genWitness := func(tx *wire.MsgTx,
+		signDesc *lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{byte(signDesc.InputIndex)}}, nil
+	}
+
+	results := make(chan *wire.MsgTx, numRequests)
+	errs := make(chan error, numRequests)
+	for i := 0; i < numRequests; i++ {
+		req := &sweepRequest{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.P2WKHWitnessSize,
+			genWitness:  genWitness,
+			quit:        make(chan struct{}),
+		}
+		go func() {
+			sweepTx, err := batcher.sweep(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			results <- sweepTx
+		}()
+	}
+
+	var sweepTx *wire.MsgTx
+	for i := 0; i < numRequests; i++ {
+		select {
+		case tx := <-results:
+			if sweepTx != nil && tx != sweepTx {
+				t.Fatalf("requests swept by different txns")
+			}
+			sweepTx = tx
+
+		case err := <-errs:
+			t.Fatalf("unable to sweep output: %v", err)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("sweep request not answered")
+		}
+	}
+
+	if len(sweepTx.TxIn) != numRequests {
+		t.Fatalf("expected %v inputs, got %v", numRequests,
+			len(sweepTx.TxIn))
+	}
+	for i, txIn := range sweepTx.TxIn {
+		if txIn.Witness[0][0] != byte(i) {
+			t.Fatalf("input %v has witness for input %v", i,
+				txIn.Witness[0][0])
+		}
+	}
+
+	// The single output should sweep the total value of all inputs, minus
+	// the fee for the entire transaction.
+	var weightEstimate lnwallet.TxWeightEstimator
+	for i := 0; i < numRequests; i++ {
+		weightEstimate.AddWitnessInput(lnwallet.P2WKHWitnessSize)
+	}
+	weightEstimate.AddP2WKHOutput()
+	fee := feePerKw.FeeForWeight(int64(weightEstimate.Weight()))
+	expectedAmt := numRequests*testSignDesc.Output.Value - int64(fee)
+
+	if len(sweepTx.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %v", len(sweepTx.TxOut))
+	}
+	if sweepTx.TxOut[0].Value != expectedAmt {
+		t.Fatalf("expected sweep amount %v, got %v", expectedAmt,
+			sweepTx.TxOut[0].Value)
+	}
+}
+
+// TestSweepAloneMaxFee tests that outputs swept alone, rather than being
+// handed to the batcher, are held to their max fee just like batched ones.
+func TestSweepAloneMaxFee(t *testing.T) {
+	t.Parallel()
+
+	feePerKw := lnwallet.SatPerKWeight(1000)
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: feePerKw},
+		defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		},
+		defaultSweepBatchWindow, systemClock{},
+	)
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				FeeEstimator: batcher.feeEstimator,
+				NewSweepAddr: batcher.newSweepAddr,
+				sweeper:      batcher,
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+	newRequest := func() *sweepRequest {
+		return &sweepRequest{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.P2WKHWitnessSize,
+			genWitness:  genWitness,
+			alone:       true,
+			quit:        kit.Quit,
+		}
+	}
+
+	// As the batcher isn't running, the requests can only be answered if
+	// they're swept alone. The first can't afford its fee, while the
+	// second can.
+	overBudget := newRequest()
+	overBudget.maxFee = overBudget.inputFee(feePerKw) - 1
+	_, err := kit.sweepRequest(overBudget)
+	if err != lnwallet.ErrFeeExceedsMax {
+		t.Fatalf("expected ErrFeeExceedsMax, got %v", err)
+	}
+
+	withinBudget := newRequest()
+	withinBudget.maxFee = withinBudget.inputFee(feePerKw)
+	sweepTx, err := kit.sweepRequest(withinBudget)
+	if err != nil {
+		t.Fatalf("unable to sweep output: %v", err)
+	}
+	if len(sweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(sweepTx.TxIn))
+	}
+}
+
+// TestSweepOutputFeePreferenceMaxFee tests that the max fee of the sweep fee
+// preference applies to the fee attributable to each output of a batch,
+// rather than to the fee of the batch as a whole. An output over it is left
+// out of the batch, while the others are still swept.
+func TestSweepOutputFeePreferenceMaxFee(t *testing.T) {
+	t.Parallel()
+
+	const largeWitnessSize = lnwallet.P2WKHWitnessSize + 100
+
+	// The output spent by the smaller witness can afford the fee of its
+	// input, while the one spent by the larger witness can't. Neither can
+	// afford the fee of the entire sweep.
+	feePerKw := lnwallet.SatPerKWeight(1000)
+	feePref := lnwallet.FeePreference{
+		ConfTarget: 6,
+		MaxFee: (&sweepRequest{
+			witnessSize: lnwallet.P2WKHWitnessSize,
+		}).inputFee(feePerKw),
+	}
+	batcher := newSweepBatcher(
+		lnwallet.StaticFeeEstimator{FeePerKW: feePerKw}, feePref,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		},
+		50*time.Millisecond, systemClock{},
+	)
+	if err := batcher.Start(); err != nil {
+		t.Fatalf("unable to start batcher: %v", err)
+	}
+	defer batcher.Stop()
+
+	kit := &ResolverKit{
+		ChannelArbitratorConfig: ChannelArbitratorConfig{
+			ChainArbitratorConfig: ChainArbitratorConfig{
+				Notifier:           &mockNotifier{},
+				FeeEstimator:       batcher.feeEstimator,
+				NewSweepAddr:       batcher.newSweepAddr,
+				SweepFeePreference: feePref,
+				sweeper:            batcher,
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+	defer close(kit.Quit)
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+
+	type sweepResult struct {
+		sweepTx *wire.MsgTx
+		err     error
+	}
+	results := make(chan sweepResult, 2)
+	sweep := func(outpoint wire.OutPoint, witnessSize int) {
+		sweepTx, _, err := kit.sweepOutput(
+			outpoint, &testSignDesc, witnessSize, genWitness, 0, 0,
+			false,
+		)
+		results <- sweepResult{sweepTx, err}
+	}
+	withinBudget := randOutPoint()
+	go sweep(withinBudget, lnwallet.P2WKHWitnessSize)
+	go sweep(randOutPoint(), largeWitnessSize)
+
+	// Only the output within budget should be swept, while the other one
+	// is retried on the next block, which never comes.
+	select {
+	case result := <-results:
+		if result.err != nil {
+			t.Fatalf("unable to sweep output: %v", result.err)
+		}
+		if len(result.sweepTx.TxIn) != 1 {
+			t.Fatalf("expected 1 input, got %v",
+				len(result.sweepTx.TxIn))
+		}
+		outpoint := result.sweepTx.TxIn[0].PreviousOutPoint
+		if outpoint != withinBudget {
+			t.Fatalf("expected input %v, got %v", withinBudget,
+				outpoint)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("sweep request not answered")
+	}
+
+	select {
+	case result := <-results:
+		t.Fatalf("expected sweep to be retried, got %v", result.err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// targetFeeEstimator is a fee estimator whose estimates are inversely
+// proportional to the confirmation target.
+type targetFeeEstimator struct {
+	lnwallet.StaticFeeEstimator
+}
+
+func (e targetFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return lnwallet.SatPerKWeight(60000 / numBlocks), nil
+}
+
+// TestCraftSweepTxDeadline tests that the fee rate of a sweep is estimated for
+// the blocks left until the earliest deadline of its outputs, if it's closer
+// than the confirmation target.
+func TestCraftSweepTxDeadline(t *testing.T) {
+	t.Parallel()
+
+	genWitness := func(*wire.MsgTx,
+		*lnwallet.SignDescriptor) (wire.TxWitness, error) {
+
+		return wire.TxWitness{{}}, nil
+	}
+	newRequest := func(deadline uint32) *sweepRequest {
+		return &sweepRequest{
+			outpoint:    randOutPoint(),
+			signDesc:    testSignDesc,
+			witnessSize: lnwallet.P2WKHWitnessSize,
+			genWitness:  genWitness,
+			deadline:    deadline,
+			height:      100,
+		}
+	}
+
+	// With a confirmation target of 6 blocks, the second output's
+	// deadline 2 blocks out should set the fee rate of the sweep.
+	reqs := []*sweepRequest{newRequest(0), newRequest(102), newRequest(110)}
+	sweepTx, err := craftSweepTx(
+		targetFeeEstimator{}, defaultSweepFeePreference,
+		func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		}, reqs,
+	)
+	if err != nil {
+		t.Fatalf("unable to craft sweep: %v", err)
+	}
+
+	var weightEstimate lnwallet.TxWeightEstimator
+	for range reqs {
+		weightEstimate.AddWitnessInput(lnwallet.P2WKHWitnessSize)
+	}
+	weightEstimate.AddP2WKHOutput()
+	feePerKw := lnwallet.SatPerKWeight(60000 / 2)
+	fee := feePerKw.FeeForWeight(int64(weightEstimate.Weight()))
+	expectedAmt := int64(len(reqs))*testSignDesc.Output.Value - int64(fee)
+
+	if sweepTx.TxOut[0].Value != expectedAmt {
+		t.Fatalf("expected sweep amount %v, got %v", expectedAmt,
+			sweepTx.TxOut[0].Value)
+	}
+}
@@ -0,0 +1,151 @@
+package heighthint
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	bolt "github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+var (
+	// confirmHintBucket is the name of the bucket which houses the height
+	// hints committed for transactions. It's kept apart from the buckets
+	// of the chain notifiers' height hint cache, as the latter records the
+	// height a notifier has scanned up to, which isn't safe to resume from
+	// should the notifier have been interrupted during a historical
+	// rescan.
+	confirmHintBucket = []byte("safe-confirm-hints")
+
+	// ErrCorruptedCache indicates that the on-disk bucketing structure has
+	// altered since the cache instance was initialized.
+	ErrCorruptedCache = errors.New("height hint cache has been corrupted")
+)
+
+// Cache persists the best-known height hint for each transaction watched by
+// the subsystems resolving closed channels: the utxo nursery, the resolvers
+// of the contract court, and the sweeps they batch together. A hint is the
+// height from which a subsystem should watch for the confirmation of the
+// transaction, such that it can resume watching after a restart without
+// rescanning the chain from the height the channel closed at.
+//
+// Subsystems MUST only commit a hint once they know the transaction can't
+// have been confirmed below it, such as the height a confirmation of the
+// transaction was seen at. If the confirmation is reorged out of the chain,
+// the hint should be purged.
+type Cache struct {
+	db *channeldb.DB
+}
+
+// Compile-time check to ensure Cache satisfies the ConfirmHintCache
+// interface.
+var _ chainntnfs.ConfirmHintCache = (*Cache)(nil)
+
+// New returns a new height hint cache backed by the passed database.
+func New(db *channeldb.DB) (*Cache, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(confirmHintBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{db: db}, nil
+}
+
+// CommitConfirmHint commits a confirm hint for the transactions to the cache.
+// As each committed hint is known to be safe, a transaction's hint is only
+// ever raised, never lowered.
+//
+// NOTE: Part of the chainntnfs.ConfirmHintCache interface.
+func (c *Cache) CommitConfirmHint(height uint32,
+	txids ...chainhash.Hash) error {
+
+	return c.db.Batch(func(tx *bolt.Tx) error {
+		confirmHints := tx.Bucket(confirmHintBucket)
+		if confirmHints == nil {
+			return ErrCorruptedCache
+		}
+
+		var hint bytes.Buffer
+		if err := channeldb.WriteElement(&hint, height); err != nil {
+			return err
+		}
+
+		for _, txid := range txids {
+			existingHint := confirmHints.Get(txid[:])
+			if existingHint != nil {
+				var existingHeight uint32
+				err := channeldb.ReadElement(
+					bytes.NewReader(existingHint),
+					&existingHeight,
+				)
+				if err != nil {
+					return err
+				}
+
+				if existingHeight >= height {
+					continue
+				}
+			}
+
+			err := confirmHints.Put(txid[:], hint.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// QueryConfirmHint returns the best-known confirm hint for a transaction.
+// chainntnfs.ErrConfirmHintNotFound is returned if no hint has been committed
+// for the transaction.
+//
+// NOTE: Part of the chainntnfs.ConfirmHintCache interface.
+func (c *Cache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
+	var hint uint32
+	err := c.db.View(func(tx *bolt.Tx) error {
+		confirmHints := tx.Bucket(confirmHintBucket)
+		if confirmHints == nil {
+			return ErrCorruptedCache
+		}
+
+		confirmHint := confirmHints.Get(txid[:])
+		if confirmHint == nil {
+			return chainntnfs.ErrConfirmHintNotFound
+		}
+
+		return channeldb.ReadElement(bytes.NewReader(confirmHint), &hint)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return hint, nil
+}
+
+// PurgeConfirmHint removes the confirm hint for the transactions from the
+// cache.
+//
+// NOTE: Part of the chainntnfs.ConfirmHintCache interface.
+func (c *Cache) PurgeConfirmHint(txids ...chainhash.Hash) error {
+	return c.db.Batch(func(tx *bolt.Tx) error {
+		confirmHints := tx.Bucket(confirmHintBucket)
+		if confirmHints == nil {
+			return ErrCorruptedCache
+		}
+
+		for _, txid := range txids {
+			if err := confirmHints.Delete(txid[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
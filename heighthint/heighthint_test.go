@@ -0,0 +1,86 @@
+package heighthint
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// TestCacheConfirmHints tests that confirm hints committed to the cache are
+// only ever raised, and can be purged.
+func TestCacheConfirmHints(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "heighthint")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to create db: %v", err)
+	}
+	defer db.Close()
+
+	cache, err := New(db)
+	if err != nil {
+		t.Fatalf("unable to create hint cache: %v", err)
+	}
+
+	txid1 := chainhash.Hash{0x01}
+	txid2 := chainhash.Hash{0x02}
+
+	assertHint := func(txid chainhash.Hash, expected uint32) {
+		t.Helper()
+
+		hint, err := cache.QueryConfirmHint(txid)
+		if err != nil {
+			t.Fatalf("unable to query hint: %v", err)
+		}
+		if hint != expected {
+			t.Fatalf("expected hint %v, got %v", expected, hint)
+		}
+	}
+
+	// No hint should be known for a transaction yet to be committed.
+	_, err = cache.QueryConfirmHint(txid1)
+	if err != chainntnfs.ErrConfirmHintNotFound {
+		t.Fatalf("expected ErrConfirmHintNotFound, got: %v", err)
+	}
+
+	if err := cache.CommitConfirmHint(100, txid1, txid2); err != nil {
+		t.Fatalf("unable to commit hints: %v", err)
+	}
+	assertHint(txid1, 100)
+	assertHint(txid2, 100)
+
+	// A higher hint replaces the one known, while a lower one is ignored.
+	if err := cache.CommitConfirmHint(110, txid1); err != nil {
+		t.Fatalf("unable to commit hint: %v", err)
+	}
+	if err := cache.CommitConfirmHint(90, txid1, txid2); err != nil {
+		t.Fatalf("unable to commit hints: %v", err)
+	}
+	assertHint(txid1, 110)
+	assertHint(txid2, 100)
+
+	// Once purged, the hint is no longer known, and a lower one can be
+	// committed.
+	if err := cache.PurgeConfirmHint(txid1); err != nil {
+		t.Fatalf("unable to purge hint: %v", err)
+	}
+	_, err = cache.QueryConfirmHint(txid1)
+	if err != chainntnfs.ErrConfirmHintNotFound {
+		t.Fatalf("expected ErrConfirmHintNotFound, got: %v", err)
+	}
+	if err := cache.CommitConfirmHint(90, txid1); err != nil {
+		t.Fatalf("unable to commit hint: %v", err)
+	}
+	assertHint(txid1, 90)
+	assertHint(txid2, 100)
+}
@@ -75,6 +75,16 @@ func (m *mockFeeEstimator) EstimateFeePerKW(
 	}
 }
 
+func (m *mockFeeEstimator) RelayFeePerKW() lnwallet.SatPerKWeight {
+	return lnwallet.FeePerKwFloor
+}
+
+func (m *mockFeeEstimator) SubscribeFeeUpdates(
+	confTarget uint32) (*lnwallet.FeeSubscription, error) {
+
+	return &lnwallet.FeeSubscription{Cancel: func() {}}, nil
+}
+
 func (m *mockFeeEstimator) Start() error {
 	return nil
 }
@@ -63,6 +63,9 @@ It has these top-level messages:
 	PendingHTLC
 	PendingChannelsRequest
 	PendingChannelsResponse
+	RecoveryPackRequest
+	RecoverySweep
+	RecoveryPackResponse
 	WalletBalanceRequest
 	WalletBalanceResponse
 	ChannelBalanceRequest
@@ -2827,13 +2830,217 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*Pendin
 	return nil
 }
 
+type RecoveryPackRequest struct {
+	// / The address each output should be swept to
+	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	// / The target number of blocks that each sweep should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the sweeps.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *RecoveryPackRequest) Reset()                    { *m = RecoveryPackRequest{} }
+func (m *RecoveryPackRequest) String() string            { return proto.CompactTextString(m) }
+func (*RecoveryPackRequest) ProtoMessage()               {}
+func (*RecoveryPackRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *RecoveryPackRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *RecoveryPackRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *RecoveryPackRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type RecoverySweep struct {
+	// / The outpoint of the output to be swept
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The channel point of the channel the output originates from
+	ChanPoint string `protobuf:"bytes,2,opt,name=chan_point" json:"chan_point,omitempty"`
+	// / The script path spending the output
+	WitnessType string `protobuf:"bytes,3,opt,name=witness_type" json:"witness_type,omitempty"`
+	// / The value of the output in satoshis
+	Value int64 `protobuf:"varint,4,opt,name=value" json:"value,omitempty"`
+	// / The family of the key signing for the output
+	KeyFamily uint32 `protobuf:"varint,5,opt,name=key_family" json:"key_family,omitempty"`
+	// / The index of the key signing for the output
+	KeyIndex uint32 `protobuf:"varint,6,opt,name=key_index" json:"key_index,omitempty"`
+	// / The hex encoded public key signing for the output, before any tweak
+	PubKey string `protobuf:"bytes,7,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / The hex encoded tweak to be applied to the key signing for the output
+	SingleTweak string `protobuf:"bytes,8,opt,name=single_tweak" json:"single_tweak,omitempty"`
+	// / The hex encoded witness script of the output
+	WitnessScript string `protobuf:"bytes,9,opt,name=witness_script" json:"witness_script,omitempty"`
+	// / The number of blocks that need to pass after the output confirmed before it can be swept
+	CsvDelay uint32 `protobuf:"varint,10,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The absolute lock time of the sweep, if any
+	LockTime uint32 `protobuf:"varint,11,opt,name=lock_time" json:"lock_time,omitempty"`
+	// / The hex encoded preimage of an incoming HTLC, if known
+	Preimage string `protobuf:"bytes,12,opt,name=preimage" json:"preimage,omitempty"`
+	// / The hex encoded second-level transaction creating the output, which needs to confirm first
+	ParentTx string `protobuf:"bytes,13,opt,name=parent_tx" json:"parent_tx,omitempty"`
+	// / The base64 encoded unsigned sweep as a PSBT, empty if the output isn't worth sweeping
+	Psbt string `protobuf:"bytes,14,opt,name=psbt" json:"psbt,omitempty"`
+	// / The fee in satoshis paid by the sweep
+	Fee int64 `protobuf:"varint,15,opt,name=fee" json:"fee,omitempty"`
+}
+
+func (m *RecoverySweep) Reset()                    { *m = RecoverySweep{} }
+func (m *RecoverySweep) String() string            { return proto.CompactTextString(m) }
+func (*RecoverySweep) ProtoMessage()               {}
+func (*RecoverySweep) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *RecoverySweep) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetWitnessType() string {
+	if m != nil {
+		return m.WitnessType
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *RecoverySweep) GetKeyFamily() uint32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+func (m *RecoverySweep) GetKeyIndex() uint32 {
+	if m != nil {
+		return m.KeyIndex
+	}
+	return 0
+}
+
+func (m *RecoverySweep) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetSingleTweak() string {
+	if m != nil {
+		return m.SingleTweak
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetWitnessScript() string {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
+
+func (m *RecoverySweep) GetLockTime() uint32 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *RecoverySweep) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetParentTx() string {
+	if m != nil {
+		return m.ParentTx
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *RecoverySweep) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type RecoveryPackResponse struct {
+	// / The fee rate in sat/kw paid by each sweep
+	SweepFeePerKw int64 `protobuf:"varint,1,opt,name=sweep_fee_per_kw" json:"sweep_fee_per_kw,omitempty"`
+	// / The sweep of each output the node has yet to sweep
+	Sweeps []*RecoverySweep `protobuf:"bytes,2,rep,name=sweeps" json:"sweeps,omitempty"`
+}
+
+func (m *RecoveryPackResponse) Reset()                    { *m = RecoveryPackResponse{} }
+func (m *RecoveryPackResponse) String() string            { return proto.CompactTextString(m) }
+func (*RecoveryPackResponse) ProtoMessage()               {}
+func (*RecoveryPackResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *RecoveryPackResponse) GetSweepFeePerKw() int64 {
+	if m != nil {
+		return m.SweepFeePerKw
+	}
+	return 0
+}
+
+func (m *RecoveryPackResponse) GetSweeps() []*RecoverySweep {
+	if m != nil {
+		return m.Sweeps
+	}
+	return nil
+}
+
 type WalletBalanceRequest struct {
 }
 
 func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 type WalletBalanceResponse struct {
 	// / The balance of the wallet
@@ -2847,7 +3054,7 @@ type WalletBalanceResponse struct {
 func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
@@ -2876,7 +3083,7 @@ type ChannelBalanceRequest struct {
 func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 type ChannelBalanceResponse struct {
 	// / Sum of channels balances denominated in satoshis
@@ -2888,7 +3095,7 @@ type ChannelBalanceResponse struct {
 func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
 func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
@@ -2924,7 +3131,7 @@ type QueryRoutesRequest struct {
 func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
@@ -2968,7 +3175,7 @@ type QueryRoutesResponse struct {
 func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
@@ -2994,7 +3201,7 @@ type Hop struct {
 func (m *Hop) Reset()                    { *m = Hop{} }
 func (m *Hop) String() string            { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *Hop) GetChanId() uint64 {
 	if m != nil {
@@ -3084,7 +3291,7 @@ type Route struct {
 func (m *Route) Reset()                    { *m = Route{} }
 func (m *Route) String() string            { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
@@ -3136,7 +3343,7 @@ type NodeInfoRequest struct {
 func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
@@ -3159,7 +3366,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
 func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
@@ -3198,7 +3405,7 @@ type LightningNode struct {
 func (m *LightningNode) Reset()                    { *m = LightningNode{} }
 func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
 func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
@@ -3243,7 +3450,7 @@ type NodeAddress struct {
 func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
 func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
@@ -3270,7 +3477,7 @@ type RoutingPolicy struct {
 func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
@@ -3331,7 +3538,7 @@ type ChannelEdge struct {
 func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
@@ -3395,7 +3602,7 @@ type ChannelGraphRequest struct {
 func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 // / Returns a new instance of the directed channel graph.
 type ChannelGraph struct {
@@ -3408,7 +3615,7 @@ type ChannelGraph struct {
 func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
@@ -3435,7 +3642,7 @@ type ChanInfoRequest struct {
 func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
@@ -3450,7 +3657,7 @@ type NetworkInfoRequest struct {
 func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 type NetworkInfo struct {
 	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
@@ -3467,7 +3674,7 @@ type NetworkInfo struct {
 func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
@@ -3538,7 +3745,7 @@ type StopRequest struct {
 func (m *StopRequest) Reset()                    { *m = StopRequest{} }
 func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 type StopResponse struct {
 }
@@ -3546,7 +3753,7 @@ type StopResponse struct {
 func (m *StopResponse) Reset()                    { *m = StopResponse{} }
 func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 type GraphTopologySubscription struct {
 }
@@ -3554,7 +3761,7 @@ type GraphTopologySubscription struct {
 func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
 func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 type GraphTopologyUpdate struct {
 	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
@@ -3565,7 +3772,7 @@ type GraphTopologyUpdate struct {
 func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
@@ -3598,7 +3805,7 @@ type NodeUpdate struct {
 func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
@@ -3644,7 +3851,7 @@ type ChannelEdgeUpdate struct {
 func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *ChannelEdgeUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3702,7 +3909,7 @@ type ClosedChannelUpdate struct {
 func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *ClosedChannelUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3750,7 +3957,7 @@ type HopHint struct {
 func (m *HopHint) Reset()                    { *m = HopHint{} }
 func (m *HopHint) String() string            { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *HopHint) GetNodeId() string {
 	if m != nil {
@@ -3797,7 +4004,7 @@ type RouteHint struct {
 func (m *RouteHint) Reset()                    { *m = RouteHint{} }
 func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *RouteHint) GetHopHints() []*HopHint {
 	if m != nil {
@@ -3886,7 +4093,7 @@ type Invoice struct {
 func (m *Invoice) Reset()                    { *m = Invoice{} }
 func (m *Invoice) String() string            { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *Invoice) GetMemo() string {
 	if m != nil {
@@ -4046,7 +4253,7 @@ type AddInvoiceResponse struct {
 func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
@@ -4081,7 +4288,7 @@ type PaymentHash struct {
 func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
 func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
@@ -4115,7 +4322,7 @@ type ListInvoiceRequest struct {
 func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
@@ -4163,7 +4370,7 @@ type ListInvoiceResponse struct {
 func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
@@ -4204,7 +4411,7 @@ type InvoiceSubscription struct {
 func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
@@ -4238,7 +4445,7 @@ type Payment struct {
 func (m *Payment) Reset()                    { *m = Payment{} }
 func (m *Payment) String() string            { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *Payment) GetPaymentHash() string {
 	if m != nil {
@@ -4288,7 +4495,7 @@ type ListPaymentsRequest struct {
 func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 type ListPaymentsResponse struct {
 	// / The list of payments
@@ -4298,7 +4505,7 @@ type ListPaymentsResponse struct {
 func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
@@ -4313,7 +4520,7 @@ type DeleteAllPaymentsRequest struct {
 func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
 func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
 type DeleteAllPaymentsResponse struct {
 }
@@ -4321,7 +4528,7 @@ type DeleteAllPaymentsResponse struct {
 func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
 func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
 type DebugLevelRequest struct {
 	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
@@ -4331,7 +4538,7 @@ type DebugLevelRequest struct {
 func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
@@ -4354,7 +4561,7 @@ type DebugLevelResponse struct {
 func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
@@ -4371,7 +4578,7 @@ type PayReqString struct {
 func (m *PayReqString) Reset()                    { *m = PayReqString{} }
 func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *PayReqString) GetPayReq() string {
 	if m != nil {
@@ -4396,7 +4603,7 @@ type PayReq struct {
 func (m *PayReq) Reset()                    { *m = PayReq{} }
 func (m *PayReq) String() string            { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *PayReq) GetDestination() string {
 	if m != nil {
@@ -4474,7 +4681,7 @@ type FeeReportRequest struct {
 func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 type ChannelFeeReport struct {
 	// / The channel that this fee report belongs to.
@@ -4490,7 +4697,7 @@ type ChannelFeeReport struct {
 func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
@@ -4534,7 +4741,7 @@ type FeeReportResponse struct {
 func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
@@ -4580,7 +4787,7 @@ type PolicyUpdateRequest struct {
 func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
 
@@ -4715,7 +4922,7 @@ type PolicyUpdateResponse struct {
 func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -4731,7 +4938,7 @@ type ForwardingHistoryRequest struct {
 func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
 func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
@@ -4779,7 +4986,7 @@ type ForwardingEvent struct {
 func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
 func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
@@ -4833,7 +5040,7 @@ type ForwardingHistoryResponse struct {
 func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
 func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
 func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
@@ -4910,6 +5117,9 @@ func init() {
 	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
 	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
 	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*RecoveryPackRequest)(nil), "lnrpc.RecoveryPackRequest")
+	proto.RegisterType((*RecoverySweep)(nil), "lnrpc.RecoverySweep")
+	proto.RegisterType((*RecoveryPackResponse)(nil), "lnrpc.RecoveryPackResponse")
 	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
 	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
@@ -5275,6 +5485,13 @@ type LightningClient interface {
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// * lncli: `exportrecoverypack`
+	// ExportRecoveryPack returns an unsigned sweep of every output of a closed
+	// channel that the node has yet to sweep, along with everything needed to
+	// sign it. This allows a user abandoning a broken node to recover their
+	// funds manually using external tools. Each sweep pays to the passed address,
+	// at a fee rate determined by either target_conf or sat_per_byte.
+	ExportRecoveryPack(ctx context.Context, in *RecoveryPackRequest, opts ...grpc.CallOption) (*RecoveryPackResponse, error)
 	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
@@ -5615,6 +5832,15 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) ExportRecoveryPack(ctx context.Context, in *RecoveryPackRequest, opts ...grpc.CallOption) (*RecoveryPackResponse, error) {
+	out := new(RecoveryPackResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ExportRecoveryPack", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
@@ -6059,6 +6285,13 @@ type LightningServer interface {
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// * lncli: `exportrecoverypack`
+	// ExportRecoveryPack returns an unsigned sweep of every output of a closed
+	// channel that the node has yet to sweep, along with everything needed to
+	// sign it. This allows a user abandoning a broken node to recover their
+	// funds manually using external tools. Each sweep pays to the passed address,
+	// at a fee rate determined by either target_conf or sat_per_byte.
+	ExportRecoveryPack(context.Context, *RecoveryPackRequest) (*RecoveryPackResponse, error)
 	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
@@ -6528,6 +6761,24 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ExportRecoveryPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ExportRecoveryPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ExportRecoveryPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ExportRecoveryPack(ctx, req.(*RecoveryPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -7074,6 +7325,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "ExportRecoveryPack",
+			Handler:    _Lightning_ExportRecoveryPack_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
@@ -7196,400 +7451,412 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xdd, 0x8f, 0x1c, 0xd9,
-	0x55, 0x77, 0xf5, 0xc7, 0xcc, 0xf4, 0xe9, 0x9e, 0x99, 0x9e, 0x3b, 0xe3, 0x99, 0x76, 0xef, 0xae,
-	0xd7, 0x5b, 0xb1, 0xd6, 0xce, 0xb0, 0xd8, 0xde, 0x49, 0xb2, 0xda, 0xec, 0x42, 0x82, 0x3d, 0x1e,
-	0x7b, 0x9c, 0xcc, 0xda, 0x93, 0x1a, 0x3b, 0x86, 0x04, 0xd4, 0xa9, 0xe9, 0xbe, 0xd3, 0x53, 0x71,
-	0x77, 0x55, 0xa7, 0xaa, 0x7a, 0xc6, 0x9d, 0xc5, 0x52, 0x20, 0x88, 0x27, 0x22, 0x84, 0x40, 0x42,
-	0x41, 0x42, 0x48, 0x01, 0xa1, 0xf0, 0x07, 0xc0, 0x4b, 0x40, 0xe2, 0x81, 0x17, 0x90, 0x10, 0x0f,
-	0x79, 0x8a, 0x78, 0x84, 0x17, 0x40, 0xbc, 0x20, 0xf1, 0x8a, 0xd0, 0x39, 0xf7, 0xa3, 0xee, 0xad,
-	0xaa, 0xf1, 0x38, 0x1f, 0xf0, 0xd6, 0xf7, 0x77, 0x4f, 0xdd, 0xcf, 0xf3, 0x75, 0xcf, 0x3d, 0xb7,
-	0xa1, 0x11, 0x4f, 0xfa, 0x37, 0x26, 0x71, 0x94, 0x46, 0xac, 0x3e, 0x0a, 0xe3, 0x49, 0xbf, 0xfb,
-	0xfa, 0x30, 0x8a, 0x86, 0x23, 0x7e, 0xd3, 0x9f, 0x04, 0x37, 0xfd, 0x30, 0x8c, 0x52, 0x3f, 0x0d,
-	0xa2, 0x30, 0x11, 0x44, 0xee, 0xd7, 0x60, 0xe9, 0x3e, 0x0f, 0x0f, 0x38, 0x1f, 0x78, 0xfc, 0x1b,
-	0x53, 0x9e, 0xa4, 0xec, 0xe7, 0x60, 0xc5, 0xe7, 0xdf, 0xe4, 0x7c, 0xd0, 0x9b, 0xf8, 0x49, 0x32,
-	0x39, 0x8e, 0xfd, 0x84, 0x77, 0x9c, 0x2b, 0xce, 0xf5, 0x96, 0xd7, 0x16, 0x15, 0xfb, 0x1a, 0x67,
-	0x6f, 0x41, 0x2b, 0x41, 0x52, 0x1e, 0xa6, 0x71, 0x34, 0x99, 0x75, 0x2a, 0x44, 0xd7, 0x44, 0x6c,
-	0x47, 0x40, 0xee, 0x08, 0x96, 0x75, 0x0f, 0xc9, 0x24, 0x0a, 0x13, 0xce, 0x6e, 0xc1, 0x5a, 0x3f,
-	0x98, 0x1c, 0xf3, 0xb8, 0x47, 0x1f, 0x8f, 0x43, 0x3e, 0x8e, 0xc2, 0xa0, 0xdf, 0x71, 0xae, 0x54,
-	0xaf, 0x37, 0x3c, 0x26, 0xea, 0xf0, 0x8b, 0x8f, 0x64, 0x0d, 0xbb, 0x06, 0xcb, 0x3c, 0x14, 0x38,
-	0x1f, 0xd0, 0x57, 0xb2, 0xab, 0xa5, 0x0c, 0xc6, 0x0f, 0xdc, 0xbf, 0x73, 0x60, 0xe5, 0x41, 0x18,
-	0xa4, 0x4f, 0xfd, 0xd1, 0x88, 0xa7, 0x6a, 0x4e, 0xd7, 0x60, 0xf9, 0x94, 0x00, 0x9a, 0xd3, 0x69,
-	0x14, 0x0f, 0xe4, 0x8c, 0x96, 0x04, 0xbc, 0x2f, 0xd1, 0x33, 0x47, 0x56, 0x39, 0x73, 0x64, 0xa5,
-	0xcb, 0x55, 0x3d, 0x63, 0xb9, 0xae, 0xc1, 0x72, 0xcc, 0xfb, 0xd1, 0x09, 0x8f, 0x67, 0xbd, 0xd3,
-	0x20, 0x1c, 0x44, 0xa7, 0x9d, 0xda, 0x15, 0xe7, 0x7a, 0xdd, 0x5b, 0x52, 0xf0, 0x53, 0x42, 0xdd,
-	0x35, 0x60, 0xe6, 0x2c, 0xc4, 0xba, 0xb9, 0x43, 0x58, 0x7d, 0x12, 0x8e, 0xa2, 0xfe, 0xb3, 0x9f,
-	0x70, 0x76, 0x25, 0xdd, 0x57, 0x4a, 0xbb, 0x5f, 0x87, 0x35, 0xbb, 0x23, 0x39, 0x00, 0x0e, 0x17,
-	0xb7, 0x8f, 0xfd, 0x70, 0xc8, 0x55, 0x93, 0x6a, 0x08, 0x9f, 0x84, 0x76, 0x7f, 0x1a, 0xc7, 0x3c,
-	0x2c, 0x8c, 0x61, 0x59, 0xe2, 0x7a, 0x10, 0x6f, 0x41, 0x2b, 0xe4, 0xa7, 0x19, 0x99, 0x64, 0x99,
-	0x90, 0x9f, 0x2a, 0x12, 0xb7, 0x03, 0xeb, 0xf9, 0x6e, 0xe4, 0x00, 0xbe, 0x5b, 0x81, 0xe6, 0xe3,
-	0xd8, 0x0f, 0x13, 0xbf, 0x8f, 0x5c, 0xcc, 0x3a, 0x30, 0x9f, 0x3e, 0xef, 0x1d, 0xfb, 0xc9, 0x31,
-	0x75, 0xd7, 0xf0, 0x54, 0x91, 0xad, 0xc3, 0x9c, 0x3f, 0x8e, 0xa6, 0x61, 0x4a, 0x1d, 0x54, 0x3d,
-	0x59, 0x62, 0xef, 0xc0, 0x4a, 0x38, 0x1d, 0xf7, 0xfa, 0x51, 0x78, 0x14, 0xc4, 0x63, 0x21, 0x0b,
-	0xb4, 0x5f, 0x75, 0xaf, 0x58, 0xc1, 0x2e, 0x03, 0x1c, 0xe2, 0x3a, 0x88, 0x2e, 0x6a, 0xd4, 0x85,
-	0x81, 0x30, 0x17, 0x5a, 0xb2, 0xc4, 0x83, 0xe1, 0x71, 0xda, 0xa9, 0x53, 0x43, 0x16, 0x86, 0x6d,
-	0xa4, 0xc1, 0x98, 0xf7, 0x92, 0xd4, 0x1f, 0x4f, 0x3a, 0x73, 0x34, 0x1a, 0x03, 0xa1, 0xfa, 0x28,
-	0xf5, 0x47, 0xbd, 0x23, 0xce, 0x93, 0xce, 0xbc, 0xac, 0xd7, 0x08, 0x7b, 0x1b, 0x96, 0x06, 0x3c,
-	0x49, 0x7b, 0xfe, 0x60, 0x10, 0xf3, 0x24, 0xe1, 0x49, 0x67, 0x81, 0xb8, 0x31, 0x87, 0xe2, 0xaa,
-	0xdd, 0xe7, 0xa9, 0xb1, 0x3a, 0x89, 0xdc, 0x1d, 0x77, 0x0f, 0x98, 0x01, 0xdf, 0xe5, 0xa9, 0x1f,
-	0x8c, 0x12, 0xf6, 0x1e, 0xb4, 0x52, 0x83, 0x98, 0xa4, 0xaf, 0xb9, 0xc5, 0x6e, 0x90, 0xda, 0xb8,
-	0x61, 0x7c, 0xe0, 0x59, 0x74, 0xee, 0x7d, 0x58, 0xb8, 0xc7, 0xf9, 0x5e, 0x30, 0x0e, 0x52, 0xb6,
-	0x0e, 0xf5, 0xa3, 0xe0, 0x39, 0x17, 0x9b, 0x5d, 0xdd, 0xbd, 0xe0, 0x89, 0x22, 0xeb, 0xc2, 0xfc,
-	0x84, 0xc7, 0x7d, 0xae, 0x96, 0x7f, 0xf7, 0x82, 0xa7, 0x80, 0x3b, 0xf3, 0x50, 0x1f, 0xe1, 0xc7,
-	0xee, 0xf7, 0x2b, 0xd0, 0x3c, 0xe0, 0xa1, 0x66, 0x22, 0x06, 0x35, 0x9c, 0x92, 0x64, 0x1c, 0xfa,
-	0xcd, 0xde, 0x84, 0x26, 0x4d, 0x33, 0x49, 0xe3, 0x20, 0x1c, 0x52, 0x63, 0x0d, 0x0f, 0x10, 0x3a,
-	0x20, 0x84, 0xb5, 0xa1, 0xea, 0x8f, 0x53, 0xda, 0xc1, 0xaa, 0x87, 0x3f, 0x91, 0xc1, 0x26, 0xfe,
-	0x6c, 0x8c, 0xbc, 0xa8, 0x77, 0xad, 0xe5, 0x35, 0x25, 0xb6, 0x8b, 0xdb, 0x76, 0x03, 0x56, 0x4d,
-	0x12, 0xd5, 0x7a, 0x9d, 0x5a, 0x5f, 0x31, 0x28, 0x65, 0x27, 0xd7, 0x60, 0x59, 0xd1, 0xc7, 0x62,
-	0xb0, 0xb4, 0x8f, 0x0d, 0x6f, 0x49, 0xc2, 0x6a, 0x0a, 0xd7, 0xa1, 0x7d, 0x14, 0x84, 0xfe, 0xa8,
-	0xd7, 0x1f, 0xa5, 0x27, 0xbd, 0x01, 0x1f, 0xa5, 0x3e, 0xed, 0x68, 0xdd, 0x5b, 0x22, 0x7c, 0x7b,
-	0x94, 0x9e, 0xdc, 0x45, 0x94, 0xbd, 0x03, 0x8d, 0x23, 0xce, 0x7b, 0xb4, 0x12, 0x9d, 0x85, 0x2b,
-	0xce, 0xf5, 0xe6, 0xd6, 0xb2, 0x5c, 0x7a, 0xb5, 0xba, 0xde, 0xc2, 0x91, 0xfc, 0xe5, 0xfe, 0x81,
-	0x03, 0x2d, 0xb1, 0x54, 0x52, 0x85, 0x5e, 0x85, 0x45, 0x35, 0x22, 0x1e, 0xc7, 0x51, 0x2c, 0xd9,
-	0xdf, 0x06, 0xd9, 0x26, 0xb4, 0x15, 0x30, 0x89, 0x79, 0x30, 0xf6, 0x87, 0x5c, 0xca, 0x5b, 0x01,
-	0x67, 0x5b, 0x59, 0x8b, 0x71, 0x34, 0x4d, 0x85, 0x12, 0x6b, 0x6e, 0xb5, 0xe4, 0xa0, 0x3c, 0xc4,
-	0x3c, 0x9b, 0xc4, 0xfd, 0x8e, 0x03, 0x0c, 0x87, 0xf5, 0x38, 0x12, 0xd5, 0x72, 0x15, 0xf2, 0x3b,
-	0xe0, 0xbc, 0xf2, 0x0e, 0x54, 0xce, 0xda, 0x81, 0xab, 0x30, 0x47, 0x5d, 0xa2, 0xac, 0x56, 0x0b,
-	0xc3, 0x92, 0x75, 0xee, 0xf7, 0x1c, 0x68, 0xa1, 0xe6, 0x08, 0xf9, 0x68, 0x3f, 0x0a, 0xc2, 0x94,
-	0xdd, 0x02, 0x76, 0x34, 0x0d, 0x07, 0x41, 0x38, 0xec, 0xa5, 0xcf, 0x83, 0x41, 0xef, 0x70, 0x86,
-	0x4d, 0xd0, 0x78, 0x76, 0x2f, 0x78, 0x25, 0x75, 0xec, 0x1d, 0x68, 0x5b, 0x68, 0x92, 0xc6, 0x62,
-	0x54, 0xbb, 0x17, 0xbc, 0x42, 0x0d, 0xca, 0x7f, 0x34, 0x4d, 0x27, 0xd3, 0xb4, 0x17, 0x84, 0x03,
-	0xfe, 0x9c, 0xd6, 0x6c, 0xd1, 0xb3, 0xb0, 0x3b, 0x4b, 0xd0, 0x32, 0xbf, 0x73, 0x3f, 0x07, 0xed,
-	0x3d, 0x54, 0x0c, 0x61, 0x10, 0x0e, 0x6f, 0x0b, 0xe9, 0x45, 0x6d, 0x35, 0x99, 0x1e, 0x3e, 0xe3,
-	0x33, 0xb9, 0x8f, 0xb2, 0x84, 0x22, 0x71, 0x1c, 0x25, 0xa9, 0x5c, 0x17, 0xfa, 0xed, 0xfe, 0x8b,
-	0x03, 0xcb, 0xb8, 0xe8, 0x1f, 0xf9, 0xe1, 0x4c, 0xad, 0xf8, 0x1e, 0xb4, 0xb0, 0xa9, 0xc7, 0xd1,
-	0x6d, 0xa1, 0xf3, 0x84, 0x2c, 0x5f, 0x97, 0x8b, 0x94, 0xa3, 0xbe, 0x61, 0x92, 0xa2, 0x99, 0x9e,
-	0x79, 0xd6, 0xd7, 0x28, 0x74, 0xa9, 0x1f, 0x0f, 0x79, 0x4a, 0xda, 0x50, 0x6a, 0x47, 0x10, 0xd0,
-	0x76, 0x14, 0x1e, 0xb1, 0x2b, 0xd0, 0x4a, 0xfc, 0xb4, 0x37, 0xe1, 0x31, 0xad, 0x1a, 0x09, 0x4e,
-	0xd5, 0x83, 0xc4, 0x4f, 0xf7, 0x79, 0x7c, 0x67, 0x96, 0xf2, 0xee, 0xe7, 0x61, 0xa5, 0xd0, 0x0b,
-	0xca, 0x6a, 0x36, 0x45, 0xfc, 0xc9, 0xd6, 0xa0, 0x7e, 0xe2, 0x8f, 0xa6, 0x5c, 0x2a, 0x69, 0x51,
-	0xf8, 0xa0, 0xf2, 0xbe, 0xe3, 0xbe, 0x0d, 0xed, 0x6c, 0xd8, 0x92, 0xe9, 0x19, 0xd4, 0x70, 0x05,
-	0x65, 0x03, 0xf4, 0xdb, 0xfd, 0x0d, 0x47, 0x10, 0x6e, 0x47, 0x81, 0x56, 0x78, 0x48, 0x88, 0x7a,
-	0x51, 0x11, 0xe2, 0xef, 0x33, 0x0d, 0xc2, 0x4f, 0x3f, 0x59, 0xf7, 0x1a, 0xac, 0x18, 0x43, 0x78,
-	0xc9, 0x60, 0xbf, 0xe3, 0xc0, 0xca, 0x43, 0x7e, 0x2a, 0x77, 0x5d, 0x8d, 0xf6, 0x7d, 0xa8, 0xa5,
-	0xb3, 0x89, 0x70, 0xb2, 0x96, 0xb6, 0xae, 0xca, 0x4d, 0x2b, 0xd0, 0xdd, 0x90, 0xc5, 0xc7, 0xb3,
-	0x09, 0xf7, 0xe8, 0x0b, 0xf7, 0x73, 0xd0, 0x34, 0x40, 0xb6, 0x01, 0xab, 0x4f, 0x1f, 0x3c, 0x7e,
-	0xb8, 0x73, 0x70, 0xd0, 0xdb, 0x7f, 0x72, 0xe7, 0x8b, 0x3b, 0xbf, 0xd2, 0xdb, 0xbd, 0x7d, 0xb0,
-	0xdb, 0xbe, 0xc0, 0xd6, 0x81, 0x3d, 0xdc, 0x39, 0x78, 0xbc, 0x73, 0xd7, 0xc2, 0x1d, 0xb7, 0x0b,
-	0x9d, 0x87, 0xfc, 0xf4, 0x69, 0x90, 0x86, 0x3c, 0x49, 0xec, 0xde, 0xdc, 0x1b, 0xc0, 0xcc, 0x21,
-	0xc8, 0x59, 0x75, 0x60, 0x5e, 0x5a, 0x1c, 0x65, 0x70, 0x65, 0xd1, 0x7d, 0x1b, 0xd8, 0x41, 0x30,
-	0x0c, 0x3f, 0xe2, 0x49, 0xe2, 0x0f, 0xb5, 0x2a, 0x68, 0x43, 0x75, 0x9c, 0x0c, 0xa5, 0x06, 0xc0,
-	0x9f, 0xee, 0xa7, 0x60, 0xd5, 0xa2, 0x93, 0x0d, 0xbf, 0x0e, 0x8d, 0x24, 0x18, 0x86, 0x7e, 0x3a,
-	0x8d, 0xb9, 0x6c, 0x3a, 0x03, 0xdc, 0x7b, 0xb0, 0xf6, 0x65, 0x1e, 0x07, 0x47, 0xb3, 0xf3, 0x9a,
-	0xb7, 0xdb, 0xa9, 0xe4, 0xdb, 0xd9, 0x81, 0x8b, 0xb9, 0x76, 0x64, 0xf7, 0x82, 0x11, 0xe5, 0x76,
-	0x2d, 0x78, 0xa2, 0x60, 0x88, 0x65, 0xc5, 0x14, 0x4b, 0xf7, 0x09, 0xb0, 0xed, 0x28, 0x0c, 0x79,
-	0x3f, 0xdd, 0xe7, 0x3c, 0xce, 0x3c, 0xe7, 0x8c, 0xeb, 0x9a, 0x5b, 0x1b, 0x72, 0x1f, 0xf3, 0xb2,
-	0x2e, 0xd9, 0x91, 0x41, 0x6d, 0xc2, 0xe3, 0x31, 0x35, 0xbc, 0xe0, 0xd1, 0x6f, 0xf7, 0x22, 0xac,
-	0x5a, 0xcd, 0x4a, 0xa7, 0xe7, 0x5d, 0xb8, 0x78, 0x37, 0x48, 0xfa, 0xc5, 0x0e, 0x3b, 0x30, 0x3f,
-	0x99, 0x1e, 0xf6, 0x32, 0x99, 0x52, 0x45, 0xf4, 0x05, 0xf2, 0x9f, 0xc8, 0xc6, 0x7e, 0xdb, 0x81,
-	0xda, 0xee, 0xe3, 0xbd, 0x6d, 0xd6, 0x85, 0x85, 0x20, 0xec, 0x47, 0x63, 0x54, 0xbb, 0x62, 0xd2,
-	0xba, 0x7c, 0xa6, 0xac, 0xbc, 0x0e, 0x0d, 0xd2, 0xd6, 0xe8, 0xde, 0x48, 0x27, 0x37, 0x03, 0xd0,
-	0xb5, 0xe2, 0xcf, 0x27, 0x41, 0x4c, 0xbe, 0x93, 0xf2, 0x88, 0x6a, 0xa4, 0x11, 0x8b, 0x15, 0xee,
-	0xff, 0xd4, 0x60, 0x5e, 0xea, 0x6a, 0xea, 0xaf, 0x9f, 0x06, 0x27, 0x5c, 0x8e, 0x44, 0x96, 0xd0,
-	0xca, 0xc5, 0x7c, 0x1c, 0xa5, 0xbc, 0x67, 0x6d, 0x83, 0x0d, 0x22, 0x55, 0x5f, 0x34, 0xd4, 0x9b,
-	0xa0, 0xd6, 0xa7, 0x91, 0x35, 0x3c, 0x1b, 0xc4, 0xc5, 0x42, 0xa0, 0x17, 0x0c, 0x68, 0x4c, 0x35,
-	0x4f, 0x15, 0x71, 0x25, 0xfa, 0xfe, 0xc4, 0xef, 0x07, 0xe9, 0x4c, 0x0a, 0xb7, 0x2e, 0x63, 0xdb,
-	0xa3, 0xa8, 0xef, 0x8f, 0x7a, 0x87, 0xfe, 0xc8, 0x0f, 0xfb, 0x5c, 0xfa, 0x6f, 0x36, 0x88, 0x2e,
-	0x9a, 0x1c, 0x92, 0x22, 0x13, 0x6e, 0x5c, 0x0e, 0x45, 0x57, 0xaf, 0x1f, 0x8d, 0xc7, 0x41, 0x8a,
-	0x9e, 0x1d, 0x59, 0xfd, 0xaa, 0x67, 0x20, 0x34, 0x13, 0x51, 0x3a, 0x15, 0xab, 0xd7, 0x10, 0xbd,
-	0x59, 0x20, 0xb6, 0x82, 0xae, 0x03, 0x2a, 0xa4, 0x67, 0xa7, 0x1d, 0x10, 0xad, 0x64, 0x08, 0xee,
-	0xc3, 0x34, 0x4c, 0x78, 0x9a, 0x8e, 0xf8, 0x40, 0x0f, 0xa8, 0x49, 0x64, 0xc5, 0x0a, 0x76, 0x0b,
-	0x56, 0x85, 0xb3, 0x99, 0xf8, 0x69, 0x94, 0x1c, 0x07, 0x49, 0x2f, 0x41, 0xb7, 0xad, 0x45, 0xf4,
-	0x65, 0x55, 0xec, 0x7d, 0xd8, 0xc8, 0xc1, 0x31, 0xef, 0xf3, 0xe0, 0x84, 0x0f, 0x3a, 0x8b, 0xf4,
-	0xd5, 0x59, 0xd5, 0xec, 0x0a, 0x34, 0xd1, 0xc7, 0x9e, 0x4e, 0x06, 0x3e, 0xda, 0xe1, 0x25, 0xda,
-	0x07, 0x13, 0x62, 0xef, 0xc2, 0xe2, 0x84, 0x0b, 0x63, 0x79, 0x9c, 0x8e, 0xfa, 0x49, 0x67, 0x99,
-	0x2c, 0x59, 0x53, 0x0a, 0x13, 0x72, 0xae, 0x67, 0x53, 0x20, 0x53, 0xf6, 0x13, 0x72, 0xb6, 0xfc,
-	0x59, 0xa7, 0x4d, 0xec, 0x96, 0x01, 0x24, 0x23, 0x71, 0x70, 0xe2, 0xa7, 0xbc, 0xb3, 0x42, 0xbc,
-	0xa5, 0x8a, 0xee, 0x9f, 0x38, 0xb0, 0xba, 0x17, 0x24, 0xa9, 0x64, 0x42, 0xad, 0x8e, 0xdf, 0x84,
-	0xa6, 0x60, 0xbf, 0x5e, 0x14, 0x8e, 0x66, 0x92, 0x23, 0x41, 0x40, 0x8f, 0xc2, 0xd1, 0x8c, 0x7d,
-	0x02, 0x16, 0x83, 0xd0, 0x24, 0x11, 0x32, 0xdc, 0x52, 0x20, 0x11, 0xbd, 0x09, 0xcd, 0xc9, 0xf4,
-	0x70, 0x14, 0xf4, 0x05, 0x49, 0x55, 0xb4, 0x22, 0x20, 0x22, 0x40, 0x27, 0x49, 0x8c, 0x44, 0x50,
-	0xd4, 0x88, 0xa2, 0x29, 0x31, 0x24, 0x71, 0xef, 0xc0, 0x9a, 0x3d, 0x40, 0xa9, 0xac, 0x36, 0x61,
-	0x41, 0xf2, 0x76, 0xd2, 0x69, 0xd2, 0xfa, 0x2c, 0xc9, 0xf5, 0x91, 0xa4, 0x9e, 0xae, 0x77, 0xff,
-	0xbc, 0x06, 0xab, 0x12, 0xdd, 0x1e, 0x45, 0x09, 0x3f, 0x98, 0x8e, 0xc7, 0x7e, 0x5c, 0x22, 0x34,
-	0xce, 0x39, 0x42, 0x53, 0xb1, 0x85, 0x06, 0x59, 0xf9, 0xd8, 0x0f, 0x42, 0xe1, 0xe1, 0x09, 0x89,
-	0x33, 0x10, 0x76, 0x1d, 0x96, 0xfb, 0xa3, 0x28, 0x11, 0x5e, 0x8f, 0x79, 0x7c, 0xca, 0xc3, 0x45,
-	0x21, 0xaf, 0x97, 0x09, 0xb9, 0x29, 0xa4, 0x73, 0x39, 0x21, 0x75, 0xa1, 0x85, 0x8d, 0x72, 0xa5,
-	0x73, 0xe6, 0x85, 0x17, 0x66, 0x62, 0x38, 0x9e, 0xbc, 0x48, 0x08, 0xf9, 0x5b, 0x2e, 0x13, 0x08,
-	0x3c, 0x9d, 0xa1, 0x4e, 0x33, 0xa8, 0x1b, 0x52, 0x20, 0x8a, 0x55, 0xec, 0x1e, 0x80, 0xe8, 0x8b,
-	0xcc, 0x38, 0x90, 0x19, 0x7f, 0xdb, 0xde, 0x11, 0x73, 0xed, 0x6f, 0x60, 0x61, 0x1a, 0x73, 0x32,
-	0xe4, 0xc6, 0x97, 0xee, 0xc7, 0xd0, 0x34, 0xaa, 0xd8, 0x45, 0x58, 0xd9, 0x7e, 0xf4, 0x68, 0x7f,
-	0xc7, 0xbb, 0xfd, 0xf8, 0xc1, 0x97, 0x77, 0x7a, 0xdb, 0x7b, 0x8f, 0x0e, 0x76, 0xda, 0x17, 0x10,
-	0xde, 0x7b, 0xb4, 0x7d, 0x7b, 0xaf, 0x77, 0xef, 0x91, 0xb7, 0xad, 0x60, 0x07, 0x6d, 0xbc, 0xb7,
-	0xf3, 0xd1, 0xa3, 0xc7, 0x3b, 0x16, 0x5e, 0x61, 0x6d, 0x68, 0xdd, 0xf1, 0x76, 0x6e, 0x6f, 0xef,
-	0x4a, 0xa4, 0xca, 0xd6, 0xa0, 0x7d, 0xef, 0xc9, 0xc3, 0xbb, 0x0f, 0x1e, 0xde, 0xef, 0x6d, 0xdf,
-	0x7e, 0xb8, 0xbd, 0xb3, 0xb7, 0x73, 0xb7, 0x5d, 0x73, 0xff, 0xd6, 0x81, 0x8b, 0x34, 0xca, 0x41,
-	0x5e, 0x20, 0xae, 0x40, 0xb3, 0x1f, 0x45, 0x13, 0x8e, 0xfa, 0x5b, 0xab, 0x68, 0x13, 0x42, 0x66,
-	0x17, 0x0a, 0xf1, 0x28, 0x8a, 0xfb, 0x5c, 0xca, 0x03, 0x10, 0x74, 0x0f, 0x11, 0x64, 0x76, 0xb9,
-	0x9d, 0x82, 0x42, 0x88, 0x43, 0x53, 0x60, 0x82, 0x64, 0x1d, 0xe6, 0x0e, 0x63, 0xee, 0xf7, 0x8f,
-	0xa5, 0x24, 0xc8, 0x12, 0xfb, 0x64, 0xe6, 0x90, 0xf7, 0x71, 0xb5, 0x47, 0x7c, 0x40, 0x1c, 0xb2,
-	0xe0, 0x2d, 0x4b, 0x7c, 0x5b, 0xc2, 0xee, 0x3e, 0xac, 0xe7, 0x67, 0x20, 0x25, 0xe6, 0x3d, 0x43,
-	0x62, 0x84, 0x6f, 0xdc, 0x3d, 0x7b, 0x7f, 0x0c, 0xe9, 0xf9, 0x77, 0x07, 0x6a, 0x68, 0x3e, 0xcf,
-	0x36, 0xb5, 0xa6, 0x47, 0x54, 0xb5, 0x3c, 0x22, 0x0a, 0x1e, 0xe0, 0x99, 0x42, 0x28, 0x54, 0x61,
-	0x74, 0x0c, 0x24, 0xab, 0x8f, 0x79, 0xff, 0x84, 0xe6, 0xa4, 0xeb, 0x11, 0x41, 0x96, 0x47, 0xc7,
-	0x93, 0xbe, 0x96, 0x2c, 0xaf, 0xca, 0xaa, 0x8e, 0xbe, 0x9c, 0xcf, 0xea, 0xe8, 0xbb, 0x0e, 0xcc,
-	0x07, 0xe1, 0x61, 0x34, 0x0d, 0x07, 0xc4, 0xe2, 0x0b, 0x9e, 0x2a, 0xa2, 0xaa, 0x9c, 0x90, 0xe8,
-	0x05, 0x63, 0xc5, 0xd0, 0x19, 0xe0, 0x32, 0x3c, 0x98, 0x24, 0xe4, 0x2e, 0x68, 0x2f, 0xf0, 0x3d,
-	0x58, 0x31, 0x30, 0xb9, 0x9a, 0x6f, 0x41, 0x7d, 0x82, 0x80, 0x5c, 0x4a, 0xa5, 0x9c, 0xc9, 0xcf,
-	0x10, 0x35, 0x6e, 0x1b, 0x96, 0xee, 0xf3, 0xf4, 0x41, 0x78, 0x14, 0xa9, 0x96, 0x7e, 0x54, 0x85,
-	0x65, 0x0d, 0xc9, 0x86, 0xae, 0xc3, 0x72, 0x30, 0xe0, 0x61, 0x1a, 0xa4, 0xb3, 0x9e, 0x75, 0xfe,
-	0xc9, 0xc3, 0xe8, 0x9f, 0xf9, 0xa3, 0xc0, 0x4f, 0xa4, 0x07, 0x20, 0x0a, 0x6c, 0x0b, 0xd6, 0xd0,
-	0x78, 0x28, 0x7b, 0xa0, 0xb7, 0x58, 0x1c, 0xc3, 0x4a, 0xeb, 0x50, 0xbc, 0x11, 0x97, 0xfa, 0x5b,
-	0x7f, 0x22, 0xfc, 0x94, 0xb2, 0x2a, 0x5c, 0x35, 0xd1, 0x12, 0x4e, 0xb9, 0x2e, 0x0c, 0x8c, 0x06,
-	0x0a, 0x21, 0xa0, 0x39, 0xa1, 0x7c, 0xf2, 0x21, 0x20, 0x23, 0x8c, 0xb4, 0x50, 0x08, 0x23, 0xa1,
-	0x72, 0x9a, 0x85, 0x7d, 0x3e, 0xe8, 0xa5, 0x51, 0x8f, 0x94, 0x28, 0xed, 0xce, 0x82, 0x97, 0x87,
-	0x29, 0xe0, 0xc5, 0x93, 0x34, 0xe4, 0x29, 0xe9, 0x99, 0x05, 0x4f, 0x15, 0x51, 0x7e, 0x88, 0x44,
-	0x98, 0x84, 0x86, 0x27, 0x4b, 0xe8, 0x68, 0x4e, 0xe3, 0x20, 0xe9, 0xb4, 0x08, 0xa5, 0xdf, 0xec,
-	0xd3, 0x70, 0xf1, 0x90, 0x27, 0x69, 0xef, 0x98, 0xfb, 0x03, 0x1e, 0xd3, 0xee, 0x8b, 0xe8, 0x94,
-	0xb0, 0xdf, 0xe5, 0x95, 0xd8, 0xf7, 0x09, 0x8f, 0x93, 0x20, 0x0a, 0xc9, 0x72, 0x37, 0x3c, 0x55,
-	0x74, 0xbf, 0x49, 0xfe, 0xb0, 0x8e, 0x9b, 0x3d, 0x21, 0x63, 0xce, 0x5e, 0x83, 0x86, 0x98, 0x63,
-	0x72, 0xec, 0x4b, 0x17, 0x7d, 0x81, 0x80, 0x83, 0x63, 0x1f, 0x35, 0x82, 0xb5, 0x6c, 0x22, 0x10,
-	0xd9, 0x24, 0x6c, 0x57, 0xac, 0xda, 0x55, 0x58, 0x52, 0x11, 0xb9, 0xa4, 0x37, 0xe2, 0x47, 0xa9,
-	0x3a, 0x5e, 0x87, 0xd3, 0x31, 0x76, 0x97, 0xec, 0xf1, 0xa3, 0xd4, 0x7d, 0x08, 0x2b, 0x52, 0x86,
-	0x1f, 0x4d, 0xb8, 0xea, 0xfa, 0xb3, 0x65, 0xd6, 0xad, 0xb9, 0xb5, 0x6a, 0x0b, 0x3d, 0xc5, 0x08,
-	0x72, 0x26, 0xcf, 0xf5, 0x80, 0x99, 0x3a, 0x41, 0x36, 0x28, 0x4d, 0x8c, 0x3a, 0xc4, 0xcb, 0xe9,
-	0x58, 0x18, 0xae, 0x4f, 0x32, 0xed, 0xf7, 0x51, 0x13, 0x08, 0x0d, 0xa8, 0x8a, 0xee, 0xf7, 0x1d,
-	0x58, 0xa5, 0xd6, 0x94, 0x7d, 0xd6, 0x27, 0xbf, 0x57, 0x1f, 0x66, 0xab, 0x6f, 0x06, 0x36, 0xd6,
-	0xa0, 0x6e, 0xea, 0x5a, 0x51, 0xf8, 0xf1, 0xcf, 0xb2, 0xb5, 0xc2, 0x59, 0xf6, 0x47, 0x0e, 0xac,
-	0x08, 0x65, 0x98, 0xfa, 0xe9, 0x34, 0x91, 0xd3, 0xff, 0x05, 0x58, 0x14, 0x76, 0x4a, 0x8a, 0x93,
-	0x1c, 0xe8, 0x9a, 0x96, 0x7c, 0x42, 0x05, 0xf1, 0xee, 0x05, 0xcf, 0x26, 0x66, 0x9f, 0x87, 0x96,
-	0x19, 0x56, 0xa5, 0x31, 0x37, 0xb7, 0x2e, 0xa9, 0x59, 0x16, 0x38, 0x67, 0xf7, 0x82, 0x67, 0x7d,
-	0xc0, 0x3e, 0x24, 0x67, 0x23, 0xec, 0x51, 0xb3, 0x32, 0x30, 0x75, 0xa9, 0x44, 0x81, 0xeb, 0xcf,
-	0x0d, 0xf2, 0x3b, 0x0b, 0x30, 0x27, 0xbc, 0x4b, 0xf7, 0x3e, 0x2c, 0x5a, 0x23, 0xb5, 0xce, 0xe8,
-	0x2d, 0x71, 0x46, 0x2f, 0x84, 0x74, 0x2a, 0xc5, 0x90, 0x8e, 0xfb, 0xed, 0x2a, 0x30, 0xe4, 0xb6,
-	0xdc, 0x76, 0xa2, 0x7b, 0x1b, 0x0d, 0xac, 0xc3, 0x4a, 0xcb, 0x33, 0x21, 0x76, 0x03, 0x98, 0x51,
-	0x54, 0x51, 0x2f, 0x61, 0x37, 0x4a, 0x6a, 0x50, 0xc1, 0x49, 0xc3, 0x2a, 0x4d, 0xa0, 0x3c, 0x96,
-	0x89, 0x7d, 0x2b, 0xad, 0x43, 0xd3, 0x30, 0x99, 0x26, 0xc7, 0xe8, 0x7e, 0xab, 0xe3, 0x8c, 0x2a,
-	0xe7, 0x19, 0x64, 0xee, 0x5c, 0x06, 0x99, 0xcf, 0x33, 0x88, 0xe9, 0x50, 0x2f, 0x58, 0x0e, 0x35,
-	0x3a, 0x72, 0x63, 0x74, 0xff, 0xd2, 0x51, 0xbf, 0x37, 0xc6, 0xde, 0xe5, 0xe9, 0xc5, 0x02, 0xd9,
-	0x26, 0xb4, 0xa5, 0x2b, 0x90, 0x79, 0xed, 0x40, 0x6b, 0x5c, 0xc0, 0x51, 0xf3, 0xe2, 0xc7, 0xa4,
-	0x01, 0xe8, 0x04, 0x53, 0xf7, 0x32, 0xc0, 0xfd, 0xa1, 0x03, 0x6d, 0xdc, 0x05, 0x8b, 0x53, 0x3f,
-	0x00, 0x12, 0x94, 0x57, 0x64, 0x54, 0x8b, 0xf6, 0xa7, 0xe7, 0xd3, 0xf7, 0xa1, 0x41, 0x0d, 0x46,
-	0x13, 0x1e, 0x4a, 0x36, 0xed, 0xd8, 0x6c, 0x9a, 0xe9, 0xa8, 0xdd, 0x0b, 0x5e, 0x46, 0x6c, 0x30,
-	0xe9, 0x3f, 0x39, 0xd0, 0x94, 0xc3, 0xfc, 0x89, 0xcf, 0xe9, 0x5d, 0x58, 0x40, 0x7e, 0x35, 0x0e,
-	0xc3, 0xba, 0x8c, 0xb6, 0x66, 0xec, 0xa7, 0xd3, 0x18, 0x8d, 0xab, 0x75, 0x46, 0xcf, 0xc3, 0x68,
-	0x29, 0x49, 0x1d, 0x27, 0xbd, 0x34, 0x18, 0xf5, 0x54, 0xad, 0xbc, 0xe3, 0x28, 0xab, 0x42, 0xad,
-	0x94, 0xa4, 0xfe, 0x90, 0x4b, 0x23, 0x28, 0x0a, 0x6e, 0x07, 0xd6, 0xe5, 0x84, 0x72, 0x9e, 0xa5,
-	0xfb, 0x37, 0x2d, 0xd8, 0x28, 0x54, 0xe9, 0x4b, 0x42, 0x79, 0xf8, 0x1c, 0x05, 0xe3, 0xc3, 0x48,
-	0xbb, 0xe1, 0x8e, 0x79, 0x2e, 0xb5, 0xaa, 0xd8, 0x10, 0x2e, 0x2a, 0x6b, 0x8f, 0x6b, 0x9a, 0xd9,
-	0xf6, 0x0a, 0xb9, 0x29, 0xef, 0xda, 0x3c, 0x90, 0xef, 0x50, 0xe1, 0xa6, 0x5c, 0x97, 0xb7, 0xc7,
-	0x8e, 0xa1, 0xa3, 0xdd, 0x0a, 0x69, 0x00, 0x0c, 0xd7, 0x03, 0xfb, 0x7a, 0xe7, 0x9c, 0xbe, 0x2c,
-	0x37, 0xd5, 0x3b, 0xb3, 0x35, 0x36, 0x83, 0xcb, 0xaa, 0x8e, 0x34, 0x7c, 0xb1, 0xbf, 0xda, 0x2b,
-	0xcd, 0x8d, 0x5c, 0x6c, 0xbb, 0xd3, 0x73, 0x1a, 0x66, 0x5f, 0x87, 0xf5, 0x53, 0x3f, 0x48, 0xd5,
-	0xb0, 0x0c, 0x57, 0xa9, 0x4e, 0x5d, 0x6e, 0x9d, 0xd3, 0xe5, 0x53, 0xf1, 0xb1, 0x65, 0xf6, 0xce,
-	0x68, 0xb1, 0xfb, 0x0f, 0x0e, 0x2c, 0xd9, 0xed, 0x20, 0x9b, 0x4a, 0x75, 0xa0, 0xd4, 0xa2, 0x72,
-	0x0d, 0x73, 0x70, 0xf1, 0x24, 0x5b, 0x29, 0x3b, 0xc9, 0x9a, 0xe7, 0xc7, 0xea, 0x79, 0x41, 0x9e,
-	0xda, 0xab, 0x05, 0x79, 0xea, 0x65, 0x41, 0x9e, 0xee, 0x7f, 0x3b, 0xc0, 0x8a, 0xbc, 0xc4, 0xee,
-	0x8b, 0xa3, 0x74, 0xc8, 0x47, 0x52, 0x27, 0xfd, 0xfc, 0xab, 0xf1, 0xa3, 0x5a, 0x3b, 0xf5, 0x35,
-	0x0a, 0x86, 0xa9, 0x74, 0x4c, 0x07, 0x6a, 0xd1, 0x2b, 0xab, 0xca, 0x85, 0x9d, 0x6a, 0xe7, 0x87,
-	0x9d, 0xea, 0xe7, 0x87, 0x9d, 0xe6, 0xf2, 0x61, 0xa7, 0xee, 0x6f, 0x39, 0xb0, 0x5a, 0xb2, 0xe9,
-	0x3f, 0xbb, 0x89, 0xe3, 0x36, 0x59, 0xba, 0xa0, 0x22, 0xb7, 0xc9, 0x04, 0xbb, 0xbf, 0x0e, 0x8b,
-	0x16, 0xa3, 0xff, 0xec, 0xfa, 0xcf, 0xfb, 0x80, 0x82, 0xcf, 0x2c, 0xac, 0xfb, 0x1f, 0x15, 0x60,
-	0x45, 0x61, 0xfb, 0x7f, 0x1d, 0x43, 0x71, 0x9d, 0xaa, 0x25, 0xeb, 0xf4, 0x7f, 0x6a, 0x07, 0xde,
-	0x81, 0x15, 0x99, 0x51, 0x60, 0x04, 0x50, 0x04, 0xc7, 0x14, 0x2b, 0xd0, 0x0b, 0xb6, 0x63, 0x7e,
-	0x0b, 0xd6, 0x4d, 0xb4, 0x61, 0x0c, 0x73, 0xa1, 0x3f, 0x77, 0x1d, 0xd6, 0x44, 0x86, 0xc2, 0x1d,
-	0xd1, 0x94, 0xb2, 0x2b, 0x7f, 0xec, 0xc0, 0xc5, 0x5c, 0x45, 0x76, 0x6f, 0x2a, 0x4c, 0x87, 0x6d,
-	0x4f, 0x6c, 0x10, 0xc7, 0x2f, 0xe5, 0xc8, 0x18, 0xbf, 0xe0, 0xb6, 0x62, 0x05, 0xae, 0xcf, 0x34,
-	0x2c, 0xd2, 0x8b, 0x55, 0x2f, 0xab, 0x72, 0x37, 0x44, 0x1e, 0x45, 0xc8, 0x47, 0xb9, 0x81, 0x1f,
-	0x89, 0xcc, 0x07, 0xb3, 0x22, 0xbb, 0x78, 0xb1, 0x87, 0xac, 0x8a, 0xe8, 0x23, 0x5a, 0x66, 0xca,
-	0x1e, 0x6f, 0x69, 0x9d, 0xfb, 0x57, 0x0e, 0xb0, 0x2f, 0x4d, 0x79, 0x3c, 0xa3, 0xfb, 0x53, 0x1d,
-	0xe9, 0xd9, 0xc8, 0x47, 0x39, 0xe6, 0x26, 0xd3, 0xc3, 0x2f, 0xf2, 0x99, 0xba, 0x65, 0xaf, 0x64,
-	0xb7, 0xec, 0x6f, 0x00, 0xe0, 0xe1, 0x4c, 0x5f, 0xca, 0x92, 0x6f, 0x16, 0x4e, 0xc7, 0xa2, 0xc1,
-	0xd2, 0x8b, 0xf0, 0xda, 0xf9, 0x17, 0xe1, 0xf5, 0xf3, 0x2e, 0xc2, 0x3f, 0x84, 0x55, 0x6b, 0xdc,
-	0x7a, 0x5b, 0xd5, 0xf5, 0xb0, 0xf3, 0x92, 0xeb, 0xe1, 0xff, 0x74, 0xa0, 0xba, 0x1b, 0x4d, 0xcc,
-	0xa8, 0xa6, 0x63, 0x47, 0x35, 0xa5, 0x2d, 0xe9, 0x69, 0x53, 0x21, 0x55, 0x8c, 0x05, 0xb2, 0x4d,
-	0x58, 0xf2, 0xc7, 0x29, 0x1e, 0xca, 0x8f, 0xa2, 0xf8, 0xd4, 0x8f, 0x07, 0x62, 0xaf, 0xef, 0x54,
-	0x3a, 0x8e, 0x97, 0xab, 0x61, 0x6b, 0x50, 0xd5, 0x4a, 0x97, 0x08, 0xb0, 0x88, 0x8e, 0x1b, 0xdd,
-	0x88, 0xcc, 0x64, 0x3c, 0x41, 0x96, 0x90, 0x95, 0xec, 0xef, 0x85, 0x23, 0x2d, 0x44, 0xa7, 0xac,
-	0x0a, 0xed, 0x1a, 0x2e, 0x1f, 0x91, 0xc9, 0x40, 0x90, 0x2a, 0xbb, 0xff, 0xe6, 0x40, 0x9d, 0x56,
-	0x00, 0x85, 0x5d, 0x70, 0xb8, 0x0e, 0x5f, 0xd2, 0xcc, 0x17, 0xbd, 0x3c, 0xcc, 0x5c, 0x2b, 0x1b,
-	0xa5, 0xa2, 0x87, 0x6d, 0x66, 0xa4, 0x5c, 0x81, 0x86, 0x28, 0xe9, 0xcc, 0x0b, 0x22, 0xc9, 0x40,
-	0x76, 0x19, 0x6a, 0xc7, 0xd1, 0x44, 0x79, 0x27, 0xa0, 0xa2, 0xf7, 0xd1, 0xc4, 0x23, 0x3c, 0x1b,
-	0x0f, 0xb6, 0x27, 0x06, 0x2f, 0x6c, 0x4e, 0x1e, 0x46, 0xab, 0xab, 0x9b, 0x35, 0x17, 0x23, 0x87,
-	0xba, 0x9b, 0xb0, 0xfc, 0x30, 0x1a, 0x70, 0x23, 0xe2, 0x74, 0x26, 0x37, 0xbb, 0xdf, 0x72, 0x60,
-	0x41, 0x11, 0xb3, 0xeb, 0x50, 0x43, 0x57, 0x22, 0x77, 0x50, 0xd0, 0xb7, 0x76, 0x48, 0xe7, 0x11,
-	0x05, 0xea, 0x5e, 0x8a, 0x47, 0x64, 0x6e, 0xa5, 0x8a, 0x46, 0x64, 0x5e, 0x93, 0x1e, 0x6e, 0xce,
-	0xd9, 0xc8, 0xa1, 0xee, 0x5f, 0x38, 0xb0, 0x68, 0xf5, 0x81, 0x87, 0xc7, 0x91, 0x9f, 0xa4, 0xf2,
-	0x26, 0x44, 0x6e, 0x8f, 0x09, 0x99, 0x31, 0xc8, 0x8a, 0x1d, 0x83, 0xd4, 0xd1, 0xb1, 0xaa, 0x19,
-	0x1d, 0xbb, 0x05, 0x8d, 0x2c, 0x67, 0xa8, 0x66, 0xe9, 0x54, 0xec, 0x51, 0xdd, 0x47, 0x66, 0x44,
-	0xd8, 0x4e, 0x3f, 0x1a, 0x45, 0xb1, 0x0c, 0xc1, 0x8b, 0x82, 0xfb, 0x21, 0x34, 0x0d, 0x7a, 0x1c,
-	0x46, 0xc8, 0xd3, 0xd3, 0x28, 0x7e, 0xa6, 0x42, 0xa1, 0xb2, 0xa8, 0xaf, 0xdd, 0x2b, 0xd9, 0xb5,
-	0xbb, 0xfb, 0xf7, 0x0e, 0x2c, 0x22, 0x0f, 0x06, 0xe1, 0x70, 0x3f, 0x1a, 0x05, 0xfd, 0x19, 0xed,
-	0xbd, 0x62, 0x37, 0xa9, 0x19, 0x14, 0x2f, 0xda, 0x30, 0xf2, 0xb6, 0x3a, 0x3b, 0x4a, 0x41, 0xd4,
-	0x65, 0x94, 0x54, 0xe4, 0xf3, 0x43, 0x3f, 0x91, 0xcc, 0x2f, 0x8d, 0x9c, 0x05, 0xa2, 0x3c, 0x21,
-	0x10, 0xfb, 0x29, 0xef, 0x8d, 0x83, 0xd1, 0x28, 0x10, 0xb4, 0xc2, 0x05, 0x2a, 0xab, 0xc2, 0x3e,
-	0x07, 0x41, 0xe2, 0x1f, 0x66, 0x61, 0x66, 0x5d, 0x76, 0x7f, 0x50, 0x81, 0xa6, 0x54, 0xcf, 0x3b,
-	0x83, 0x21, 0x97, 0x77, 0x20, 0xe4, 0x64, 0x6a, 0x55, 0x62, 0x20, 0xaa, 0xde, 0x72, 0x4b, 0x0d,
-	0x24, 0xbf, 0xe5, 0xd5, 0xe2, 0x96, 0xbf, 0x0e, 0x0d, 0x64, 0xbd, 0x77, 0xc9, 0xff, 0x15, 0xf7,
-	0x27, 0x19, 0xa0, 0x6a, 0xb7, 0xa8, 0xb6, 0x9e, 0xd5, 0x12, 0xf0, 0xd2, 0x1b, 0x93, 0xf7, 0xa1,
-	0x25, 0x9b, 0xa1, 0x3d, 0x21, 0xcd, 0x91, 0x31, 0xbf, 0xb5, 0x5f, 0x9e, 0x45, 0xa9, 0xbe, 0xdc,
-	0x52, 0x5f, 0x2e, 0x9c, 0xf7, 0xa5, 0xa2, 0xa4, 0xdb, 0x6d, 0xb1, 0x36, 0xf7, 0x63, 0x7f, 0x72,
-	0xac, 0x4c, 0xde, 0x40, 0xa7, 0xec, 0x10, 0xcc, 0x36, 0xa1, 0x8e, 0x9f, 0x29, 0x4d, 0x5e, 0x2e,
-	0x90, 0x82, 0x84, 0x5d, 0x87, 0x3a, 0x1f, 0x0c, 0xb9, 0x3a, 0xe1, 0x31, 0xfb, 0xac, 0x8d, 0x7b,
-	0xe4, 0x09, 0x02, 0x54, 0x0f, 0x88, 0xe6, 0xd4, 0x83, 0x6d, 0x05, 0xe6, 0xb0, 0xf8, 0x60, 0xe0,
-	0xae, 0x01, 0x7b, 0x28, 0x38, 0xda, 0x8c, 0x5f, 0x7f, 0xbb, 0x0a, 0x4d, 0x03, 0x46, 0x49, 0x1f,
-	0xe2, 0x80, 0x7b, 0x83, 0xc0, 0x1f, 0xf3, 0x94, 0xc7, 0x92, 0x8b, 0x73, 0x28, 0xd2, 0xf9, 0x27,
-	0xc3, 0x5e, 0x34, 0x4d, 0x7b, 0x03, 0x3e, 0x8c, 0xb9, 0x30, 0xcc, 0x68, 0x28, 0x2c, 0x14, 0xe9,
-	0xc6, 0xfe, 0x73, 0x93, 0x4e, 0xf0, 0x43, 0x0e, 0x55, 0xd1, 0x68, 0xb1, 0x46, 0xb5, 0x2c, 0x1a,
-	0x2d, 0x56, 0x24, 0xaf, 0xa3, 0xea, 0x25, 0x3a, 0xea, 0x3d, 0x58, 0x17, 0xda, 0x48, 0xca, 0x6d,
-	0x2f, 0xc7, 0x26, 0x67, 0xd4, 0xb2, 0x4d, 0x68, 0xe3, 0x98, 0x15, 0x83, 0x27, 0xc1, 0x37, 0x45,
-	0x7c, 0xc8, 0xf1, 0x0a, 0x38, 0xd2, 0x52, 0xa0, 0xc6, 0xa4, 0x15, 0xf7, 0x6d, 0x05, 0x9c, 0x68,
-	0xfd, 0xe7, 0x36, 0x6d, 0x43, 0xd2, 0xe6, 0x70, 0x77, 0x11, 0x9a, 0x07, 0x69, 0x34, 0x51, 0x9b,
-	0xb2, 0x04, 0x2d, 0x51, 0x94, 0xd9, 0x0d, 0xaf, 0xc1, 0x25, 0xe2, 0xa2, 0xc7, 0xd1, 0x24, 0x1a,
-	0x45, 0xc3, 0xd9, 0xc1, 0xf4, 0x30, 0xe9, 0xc7, 0xc1, 0x04, 0x4f, 0x43, 0xee, 0x3f, 0x3a, 0xb0,
-	0x6a, 0xd5, 0xca, 0x90, 0xd1, 0xa7, 0x05, 0x4b, 0xeb, 0x6b, 0x69, 0xc1, 0x78, 0x2b, 0x86, 0xaa,
-	0x14, 0x84, 0x22, 0x94, 0xf7, 0x44, 0xde, 0x54, 0xdf, 0x86, 0x65, 0x35, 0x32, 0xf5, 0xa1, 0xe0,
-	0xc2, 0x4e, 0x91, 0x0b, 0xe5, 0xf7, 0x4b, 0xf2, 0x03, 0xd5, 0xc4, 0x2f, 0xca, 0x7b, 0xcb, 0x01,
-	0xcd, 0x51, 0xc5, 0x0e, 0xf4, 0xcd, 0x94, 0x79, 0x82, 0x50, 0x23, 0xe8, 0x6b, 0x30, 0x71, 0x7f,
-	0xc7, 0x01, 0xc8, 0x46, 0x87, 0x8c, 0x91, 0xa9, 0x7b, 0x91, 0x4a, 0x6d, 0xa8, 0xf6, 0xb7, 0xa0,
-	0xa5, 0xef, 0x54, 0x32, 0x0b, 0xd2, 0x54, 0x18, 0x3a, 0x79, 0xd7, 0x60, 0x79, 0x38, 0x8a, 0x0e,
-	0xc9, 0xfc, 0x52, 0xba, 0x4c, 0x22, 0x73, 0x3c, 0x96, 0x04, 0x7c, 0x4f, 0xa2, 0x99, 0xb9, 0xa9,
-	0x19, 0xe6, 0xc6, 0xfd, 0x4e, 0x45, 0x47, 0xe2, 0xb3, 0x39, 0x9f, 0x29, 0x65, 0x6c, 0xab, 0xa0,
-	0x1c, 0xcf, 0x08, 0x7c, 0x53, 0x94, 0x6c, 0xff, 0xdc, 0x43, 0xfc, 0x87, 0xb0, 0x14, 0x0b, 0xed,
-	0xa3, 0x54, 0x53, 0xed, 0x25, 0xaa, 0x69, 0x31, 0xb6, 0x6c, 0xd2, 0x27, 0xa1, 0xed, 0x0f, 0x4e,
-	0x78, 0x9c, 0x06, 0x74, 0x8c, 0x22, 0x87, 0x40, 0x28, 0xd4, 0x65, 0x03, 0x27, 0x3b, 0x7d, 0x0d,
-	0x96, 0x65, 0x5e, 0x8d, 0xa6, 0x94, 0xb9, 0xa0, 0x19, 0x8c, 0x84, 0xee, 0x9f, 0xaa, 0xa0, 0xbf,
-	0xbd, 0x87, 0x67, 0xaf, 0x88, 0x39, 0xbb, 0x4a, 0x6e, 0x76, 0x9f, 0x90, 0x01, 0xf8, 0x81, 0x3a,
-	0xab, 0x55, 0x8d, 0x3b, 0xee, 0x81, 0xbc, 0x30, 0xb1, 0x97, 0xb4, 0xf6, 0x2a, 0x4b, 0xea, 0xfe,
-	0xd0, 0x81, 0xf9, 0xdd, 0x68, 0xb2, 0x2b, 0x6f, 0xfb, 0x49, 0x10, 0x74, 0xd6, 0x9a, 0x2a, 0xbe,
-	0x24, 0x0f, 0xa0, 0xd4, 0x0e, 0x2f, 0xe6, 0xed, 0xf0, 0x2f, 0xc1, 0x6b, 0x14, 0x29, 0x88, 0xa3,
-	0x49, 0x14, 0xa3, 0x30, 0xfa, 0x23, 0x61, 0x74, 0xa3, 0x30, 0x3d, 0x56, 0x6a, 0xec, 0x65, 0x24,
-	0x74, 0x24, 0xc3, 0xa3, 0x84, 0x70, 0x94, 0xa5, 0xdf, 0x20, 0xb4, 0x5b, 0xb1, 0xc2, 0xfd, 0x2c,
-	0x34, 0xc8, 0xf1, 0xa5, 0x69, 0xbd, 0x03, 0x8d, 0xe3, 0x68, 0xd2, 0x3b, 0x0e, 0xc2, 0x54, 0x09,
-	0xf7, 0x52, 0xe6, 0x91, 0xee, 0xd2, 0x82, 0x68, 0x02, 0xf7, 0x0f, 0xeb, 0x30, 0xff, 0x20, 0x3c,
-	0x89, 0x82, 0x3e, 0xdd, 0x0f, 0x8c, 0xf9, 0x38, 0x52, 0x39, 0x7c, 0xf8, 0x1b, 0x97, 0x82, 0xf2,
-	0x59, 0x26, 0xa9, 0x0c, 0xf0, 0xab, 0x22, 0x9a, 0xfb, 0x38, 0xcb, 0xb3, 0x15, 0xa2, 0x63, 0x20,
-	0xe8, 0xf4, 0xc7, 0x66, 0x4a, 0xb2, 0x2c, 0x65, 0x49, 0x90, 0x75, 0x23, 0x09, 0x92, 0x6e, 0x93,
-	0x44, 0x66, 0x02, 0xf1, 0xd7, 0x82, 0xa7, 0x8a, 0x74, 0x48, 0x89, 0xb9, 0x88, 0xf0, 0x90, 0xe3,
-	0x30, 0x2f, 0x0f, 0x29, 0x26, 0x88, 0xce, 0x85, 0xf8, 0x40, 0xd0, 0x08, 0xe5, 0x6b, 0x42, 0xe8,
-	0x88, 0xe5, 0xb3, 0x9a, 0x1b, 0x82, 0xe7, 0x73, 0x30, 0x6a, 0xe8, 0x01, 0xd7, 0x8a, 0x54, 0xcc,
-	0x01, 0x44, 0x1e, 0x71, 0x1e, 0x37, 0x8e, 0x36, 0x22, 0xe5, 0x48, 0x1d, 0x6d, 0x90, 0x51, 0xfc,
-	0xd1, 0xe8, 0xd0, 0xef, 0x3f, 0xa3, 0xa4, 0x75, 0xca, 0x30, 0x6a, 0x78, 0x36, 0x48, 0xb9, 0x06,
-	0xd9, 0x6e, 0xd2, 0x7d, 0x64, 0xcd, 0x33, 0x21, 0xb6, 0x05, 0x4d, 0x3a, 0xce, 0xc9, 0xfd, 0x5c,
-	0xa2, 0xfd, 0x6c, 0x9b, 0xe7, 0x3d, 0xda, 0x51, 0x93, 0xc8, 0xbc, 0xb3, 0x58, 0xb6, 0xef, 0x2c,
-	0x84, 0xd2, 0x94, 0x57, 0x3d, 0x6d, 0xea, 0x2d, 0x03, 0xd0, 0x9a, 0xca, 0x05, 0x13, 0x04, 0x2b,
-	0x44, 0x60, 0x61, 0xec, 0x32, 0x2c, 0xe0, 0x21, 0x64, 0xe2, 0x07, 0x83, 0x0e, 0xd3, 0x67, 0x21,
-	0x8d, 0x61, 0x1b, 0xea, 0x37, 0x5d, 0xc9, 0xac, 0xd2, 0xaa, 0x58, 0x18, 0xae, 0x8d, 0x2e, 0x93,
-	0x10, 0xad, 0x89, 0x1d, 0xb5, 0x40, 0x37, 0x05, 0x76, 0x7b, 0x30, 0x90, 0xbc, 0xa9, 0x8f, 0xbe,
-	0x19, 0x57, 0x39, 0x16, 0x57, 0x95, 0xec, 0x6e, 0xa5, 0x7c, 0x77, 0x5f, 0xba, 0x06, 0xee, 0x0e,
-	0x34, 0xf7, 0x8d, 0xc4, 0x6d, 0x62, 0x72, 0x95, 0xb2, 0x2d, 0x05, 0xc3, 0x40, 0x8c, 0xe1, 0x54,
-	0xcc, 0xe1, 0xb8, 0x7f, 0xe6, 0x00, 0xdb, 0x0b, 0x92, 0x54, 0x0f, 0x5f, 0xf4, 0xed, 0x42, 0x4b,
-	0x07, 0x28, 0xb2, 0x6c, 0x2b, 0x0b, 0x43, 0x1a, 0x1a, 0x4a, 0x2f, 0x3a, 0x3a, 0x4a, 0xb8, 0xca,
-	0xa4, 0xb0, 0x30, 0xe4, 0x50, 0xf4, 0x71, 0xd0, 0x5f, 0x08, 0x44, 0x0f, 0x89, 0xcc, 0xa8, 0x28,
-	0xe0, 0xa8, 0x67, 0x63, 0x7e, 0xc2, 0xe3, 0x44, 0x8b, 0x96, 0x2e, 0xeb, 0xa4, 0xb0, 0xfc, 0x2a,
-	0x6f, 0xc2, 0x82, 0x6e, 0xd7, 0x56, 0x21, 0x8a, 0x52, 0xd7, 0xa3, 0xaa, 0x22, 0x1f, 0xde, 0x1a,
-	0xb4, 0x50, 0x9b, 0xc5, 0x0a, 0x76, 0x03, 0xd8, 0x51, 0x10, 0xe7, 0xc9, 0xab, 0x44, 0x5e, 0x52,
-	0xe3, 0x3e, 0x85, 0x55, 0xd9, 0xa5, 0xe9, 0xdc, 0xd8, 0x9b, 0xe8, 0x9c, 0xc7, 0xc8, 0x95, 0x22,
-	0x23, 0xbb, 0x3f, 0x70, 0x60, 0x5e, 0xee, 0x34, 0x6d, 0x4b, 0x3e, 0x83, 0xbf, 0xe1, 0x59, 0x58,
-	0x79, 0xee, 0x76, 0x51, 0x39, 0x55, 0xcb, 0x94, 0x13, 0x83, 0xda, 0xc4, 0x4f, 0x8f, 0xe9, 0x54,
-	0xda, 0xf0, 0xe8, 0x37, 0x6b, 0x8b, 0x48, 0x89, 0x50, 0x82, 0x14, 0x25, 0x29, 0x7b, 0xbe, 0x20,
-	0x6c, 0x6d, 0x01, 0xc7, 0xd3, 0x05, 0x25, 0xaa, 0x08, 0x5c, 0xdf, 0x30, 0xc9, 0x14, 0xba, 0x0c,
-	0xce, 0xf6, 0x53, 0x36, 0x91, 0xdf, 0x4f, 0x49, 0xea, 0xe9, 0x7a, 0xb7, 0x0b, 0x9d, 0xbb, 0x7c,
-	0xc4, 0x53, 0x7e, 0x7b, 0x34, 0xca, 0xb7, 0xff, 0x1a, 0x5c, 0x2a, 0xa9, 0x93, 0xde, 0xe8, 0x3d,
-	0x58, 0xb9, 0xcb, 0x0f, 0xa7, 0xc3, 0x3d, 0x7e, 0x92, 0x5d, 0x12, 0x33, 0xa8, 0x25, 0xc7, 0xd1,
-	0xa9, 0xe4, 0x74, 0xfa, 0xcd, 0xde, 0x00, 0x18, 0x21, 0x4d, 0x2f, 0x99, 0xf0, 0xbe, 0xca, 0x5a,
-	0x26, 0xe4, 0x60, 0xc2, 0xfb, 0xee, 0x7b, 0xc0, 0xcc, 0x76, 0xe4, 0x14, 0x50, 0xc1, 0x4f, 0x0f,
-	0x7b, 0xc9, 0x2c, 0x49, 0xf9, 0x58, 0xa5, 0x63, 0x9b, 0x90, 0x7b, 0x0d, 0x5a, 0xfb, 0xfe, 0xcc,
-	0xe3, 0xdf, 0x90, 0x8f, 0x28, 0x36, 0x60, 0x7e, 0xe2, 0xcf, 0x50, 0xee, 0x75, 0x40, 0x84, 0xaa,
-	0xdd, 0xff, 0xaa, 0xc0, 0x9c, 0xa0, 0xc4, 0x56, 0x07, 0x3c, 0x49, 0x83, 0x50, 0x5c, 0x81, 0xca,
-	0x56, 0x0d, 0xa8, 0xc0, 0x1b, 0x95, 0x12, 0xde, 0x90, 0xc7, 0x10, 0x95, 0x01, 0x2a, 0x99, 0xc0,
-	0xc2, 0x90, 0x63, 0xb3, 0xc4, 0x13, 0x71, 0x22, 0xcf, 0x80, 0x5c, 0x84, 0x2c, 0x33, 0x23, 0x62,
-	0x7c, 0x8a, 0xed, 0x25, 0x3b, 0x98, 0x50, 0xa9, 0xb1, 0x9a, 0x17, 0x5c, 0x53, 0x30, 0x56, 0x05,
-	0xa3, 0xb4, 0xf0, 0x0a, 0x46, 0x49, 0x9c, 0x4d, 0x5e, 0x66, 0x94, 0xe0, 0x15, 0x8c, 0x92, 0xcb,
-	0xa0, 0x7d, 0x8f, 0x73, 0x8f, 0xa3, 0xbb, 0xa3, 0xd8, 0xe9, 0xbb, 0x0e, 0xb4, 0xa5, 0xa7, 0xa6,
-	0xeb, 0xd8, 0x5b, 0x96, 0x5b, 0x57, 0x9a, 0xa7, 0x79, 0x15, 0x16, 0xc9, 0xd9, 0xd2, 0xa1, 0x40,
-	0x19, 0xb7, 0xb4, 0x40, 0x9c, 0x87, 0xba, 0xaf, 0x19, 0x07, 0x23, 0xb9, 0x29, 0x26, 0xa4, 0xa2,
-	0x89, 0xb1, 0x2f, 0x73, 0x43, 0x1c, 0x4f, 0x97, 0xdd, 0xbf, 0x76, 0x60, 0xc5, 0x18, 0xb0, 0xe4,
-	0xc2, 0x0f, 0x41, 0x25, 0xa6, 0x88, 0x88, 0xa1, 0x10, 0xa6, 0x0d, 0xdb, 0xeb, 0xcc, 0x3e, 0xb3,
-	0x88, 0x69, 0x33, 0xfd, 0x19, 0x0d, 0x30, 0x99, 0x8e, 0xa5, 0x56, 0x32, 0x21, 0x64, 0xa4, 0x53,
-	0xce, 0x9f, 0x69, 0x12, 0xa1, 0x17, 0x2d, 0x8c, 0xf2, 0x0e, 0xd0, 0x49, 0xd4, 0x44, 0xc2, 0x40,
-	0xd8, 0xa0, 0xfb, 0xcf, 0x0e, 0xac, 0x0a, 0x6f, 0x5f, 0x9e, 0xa5, 0x74, 0x12, 0xfd, 0x9c, 0x38,
-	0xde, 0x08, 0x89, 0xdc, 0xbd, 0xe0, 0xc9, 0x32, 0xfb, 0xcc, 0x2b, 0x9e, 0x50, 0x74, 0xbe, 0xc9,
-	0x19, 0x7b, 0x51, 0x2d, 0xdb, 0x8b, 0x97, 0xac, 0x74, 0x59, 0x84, 0xac, 0x5e, 0x1a, 0x21, 0xbb,
-	0x33, 0x0f, 0xf5, 0xa4, 0x1f, 0x4d, 0xb8, 0xbb, 0x0e, 0x6b, 0xf6, 0xe4, 0xa4, 0x0a, 0xfa, 0x9e,
-	0x03, 0x9d, 0x7b, 0x22, 0x5e, 0x1c, 0x84, 0xc3, 0xdd, 0x20, 0x49, 0xa3, 0x58, 0xbf, 0x1a, 0xba,
-	0x0c, 0x90, 0xa4, 0x7e, 0x9c, 0x8a, 0x7c, 0x40, 0x19, 0xbf, 0xca, 0x10, 0x1c, 0x23, 0x0f, 0x07,
-	0xa2, 0x56, 0xec, 0x8d, 0x2e, 0x17, 0x8c, 0xb2, 0x3c, 0x8f, 0x58, 0xa6, 0xed, 0x6d, 0x91, 0xc0,
-	0x85, 0xc6, 0x97, 0x9f, 0x90, 0xaa, 0x15, 0x8e, 0x7e, 0x0e, 0x75, 0xff, 0xd2, 0x81, 0xe5, 0x6c,
-	0x90, 0x3b, 0x08, 0xda, 0xda, 0x41, 0xda, 0xb3, 0x4c, 0x3b, 0xa8, 0xc8, 0x5a, 0x80, 0x06, 0x4e,
-	0x8e, 0xcd, 0x40, 0x48, 0x62, 0x65, 0x29, 0x9a, 0x2a, 0x8f, 0xc1, 0x84, 0x44, 0xea, 0x04, 0x9a,
-	0x56, 0xe9, 0x26, 0xc8, 0x12, 0xa5, 0x73, 0x8e, 0x53, 0xfa, 0x6a, 0x4e, 0x9c, 0x74, 0x64, 0x51,
-	0xd9, 0xa7, 0x79, 0x42, 0xf1, 0xa7, 0xfb, 0xbb, 0x0e, 0x5c, 0x2a, 0x59, 0x5c, 0x29, 0x19, 0x77,
-	0x61, 0xe5, 0x48, 0x57, 0xaa, 0x05, 0x10, 0xe2, 0xb1, 0xae, 0x2e, 0x38, 0xec, 0x49, 0x7b, 0xc5,
-	0x0f, 0xb4, 0x33, 0x21, 0x96, 0xd4, 0xca, 0x49, 0x2a, 0x56, 0x6c, 0xfd, 0x5e, 0x15, 0x96, 0xc4,
-	0xc5, 0x97, 0x78, 0xbf, 0xcb, 0x63, 0xf6, 0x11, 0xcc, 0xcb, 0xf7, 0xd7, 0xec, 0xa2, 0xec, 0xd6,
-	0x7e, 0xf1, 0xdd, 0x5d, 0xcf, 0xc3, 0x92, 0x77, 0x56, 0x7f, 0xf3, 0x87, 0xff, 0xfa, 0xfb, 0x95,
-	0x45, 0xd6, 0xbc, 0x79, 0xf2, 0xee, 0xcd, 0x21, 0x0f, 0x13, 0x6c, 0xe3, 0x57, 0x01, 0xb2, 0x97,
-	0xc9, 0xac, 0xa3, 0x9d, 0xa0, 0xdc, 0x93, 0xeb, 0xee, 0xa5, 0x92, 0x1a, 0xd9, 0xee, 0x25, 0x6a,
-	0x77, 0xd5, 0x5d, 0xc2, 0x76, 0x83, 0x30, 0x48, 0xc5, 0x33, 0xe5, 0x0f, 0x9c, 0x4d, 0x36, 0x80,
-	0x96, 0xf9, 0xf0, 0x98, 0xa9, 0x58, 0x48, 0xc9, 0xb3, 0xe7, 0xee, 0x6b, 0xa5, 0x75, 0x2a, 0x10,
-	0x44, 0x7d, 0x5c, 0x74, 0xdb, 0xd8, 0xc7, 0x94, 0x28, 0xb2, 0x5e, 0x46, 0xb0, 0x64, 0xbf, 0x2f,
-	0x66, 0xaf, 0x1b, 0x62, 0x5d, 0x78, 0xdd, 0xdc, 0x7d, 0xe3, 0x8c, 0x5a, 0xd9, 0xd7, 0x1b, 0xd4,
-	0xd7, 0x86, 0xcb, 0xb0, 0xaf, 0x3e, 0xd1, 0xa8, 0xd7, 0xcd, 0x1f, 0x38, 0x9b, 0x5b, 0xdf, 0xba,
-	0x0c, 0x0d, 0x1d, 0xbd, 0x64, 0x5f, 0x87, 0x45, 0xeb, 0x66, 0x92, 0xa9, 0x69, 0x94, 0x5d, 0x64,
-	0x76, 0x5f, 0x2f, 0xaf, 0x94, 0x1d, 0x5f, 0xa6, 0x8e, 0x3b, 0x6c, 0x1d, 0x3b, 0x96, 0x57, 0x7b,
-	0x37, 0xe9, 0x3e, 0x56, 0x24, 0x8b, 0x3e, 0x13, 0xf3, 0xcc, 0x6e, 0x13, 0xad, 0x79, 0x16, 0x6e,
-	0x1f, 0xad, 0x79, 0x16, 0xaf, 0x20, 0xdd, 0xd7, 0xa9, 0xbb, 0x75, 0xb6, 0x66, 0x76, 0xa7, 0xa3,
-	0x8a, 0x9c, 0xd2, 0x7b, 0xcd, 0xe7, 0xc7, 0xec, 0x0d, 0xcd, 0x58, 0x65, 0xcf, 0x92, 0x35, 0x8b,
-	0x14, 0xdf, 0x26, 0xbb, 0x1d, 0xea, 0x8a, 0x31, 0xda, 0x3e, 0xf3, 0xf5, 0x31, 0xfb, 0x2a, 0x34,
-	0xf4, 0x5b, 0x3b, 0xb6, 0x61, 0x3c, 0x70, 0x34, 0x1f, 0x00, 0x76, 0x3b, 0xc5, 0x8a, 0x32, 0xc6,
-	0x30, 0x5b, 0x46, 0xc6, 0xd8, 0x83, 0x8b, 0xd2, 0xa9, 0x3e, 0xe4, 0x3f, 0xce, 0x4c, 0x4a, 0x1e,
-	0x4d, 0xdf, 0x72, 0xd8, 0x87, 0xb0, 0xa0, 0x9e, 0x30, 0xb2, 0xf5, 0xf2, 0xa7, 0x98, 0xdd, 0x8d,
-	0x02, 0x2e, 0xb5, 0xc7, 0x6d, 0x80, 0xec, 0xf9, 0x9d, 0x96, 0xb3, 0xc2, 0xa3, 0x40, 0xbd, 0x88,
-	0x25, 0x6f, 0xf5, 0x86, 0xf4, 0xd8, 0xd0, 0x7e, 0xdd, 0xc7, 0xde, 0xcc, 0xe8, 0x4b, 0xdf, 0xfd,
-	0xbd, 0xa4, 0x41, 0x77, 0x9d, 0xd6, 0xae, 0xcd, 0x48, 0x70, 0x43, 0x7e, 0xaa, 0x12, 0xdd, 0xef,
-	0x42, 0xd3, 0x78, 0xd2, 0xc7, 0x54, 0x0b, 0xc5, 0xe7, 0x80, 0xdd, 0x6e, 0x59, 0x95, 0x1c, 0xee,
-	0x17, 0x60, 0xd1, 0x7a, 0x9b, 0xa7, 0x25, 0xa3, 0xec, 0xe5, 0x9f, 0x96, 0x8c, 0xf2, 0xe7, 0x7c,
-	0x5f, 0x81, 0xa6, 0xf1, 0x92, 0x8e, 0x19, 0x29, 0x7c, 0xb9, 0x37, 0x74, 0x7a, 0x44, 0x65, 0x0f,
-	0xef, 0xd6, 0x68, 0xbe, 0x4b, 0x6e, 0x03, 0xe7, 0x4b, 0xd9, 0xde, 0xc8, 0x24, 0x5f, 0x87, 0x25,
-	0xfb, 0x6d, 0x9d, 0x96, 0xaa, 0xd2, 0x57, 0x7a, 0x5a, 0xaa, 0xce, 0x78, 0x90, 0x27, 0x19, 0x72,
-	0x73, 0x55, 0x77, 0x72, 0xf3, 0x63, 0x79, 0xaf, 0xf7, 0x82, 0x7d, 0x09, 0x55, 0x87, 0x4c, 0xbf,
-	0x67, 0xd9, 0x8b, 0x42, 0x3b, 0x49, 0x5f, 0x73, 0x7b, 0x21, 0x53, 0xdf, 0x5d, 0xa1, 0xc6, 0x9b,
-	0x2c, 0x9b, 0x81, 0xb0, 0x07, 0x94, 0x86, 0x6f, 0xd8, 0x03, 0x33, 0x53, 0xdf, 0xb0, 0x07, 0x56,
-	0xb6, 0x7e, 0xde, 0x1e, 0xa4, 0x01, 0xb6, 0x11, 0xc2, 0x72, 0x2e, 0x87, 0x45, 0x0b, 0x4b, 0x79,
-	0xd2, 0x5f, 0xf7, 0xf2, 0xcb, 0x53, 0x5f, 0x6c, 0x35, 0xa3, 0xd4, 0xcb, 0x4d, 0x95, 0xa3, 0xf9,
-	0x6b, 0xd0, 0x32, 0xdf, 0x44, 0x69, 0x0b, 0x51, 0xf2, 0x92, 0x4b, 0x5b, 0x88, 0xb2, 0x47, 0x54,
-	0x6a, 0x73, 0x59, 0xcb, 0xec, 0x06, 0x37, 0xd7, 0x7e, 0x42, 0x92, 0xa9, 0xcc, 0xb2, 0xb7, 0x31,
-	0x99, 0xca, 0x2c, 0x7d, 0x77, 0xa2, 0x36, 0x97, 0xad, 0x5a, 0x73, 0x11, 0x41, 0x5b, 0xf6, 0x15,
-	0x58, 0x36, 0x12, 0xc4, 0x0e, 0x66, 0x61, 0x5f, 0x33, 0x6a, 0x31, 0xb9, 0xb8, 0x5b, 0xe6, 0x79,
-	0xba, 0x1b, 0xd4, 0xfe, 0x8a, 0x6b, 0x4d, 0x02, 0x99, 0x74, 0x1b, 0x9a, 0x66, 0xf2, 0xd9, 0x4b,
-	0xda, 0xdd, 0x30, 0xaa, 0xcc, 0x4c, 0xda, 0x5b, 0x0e, 0xfb, 0x23, 0x07, 0x5a, 0x56, 0x2a, 0x97,
-	0x75, 0x35, 0x91, 0x6b, 0xa7, 0x63, 0xd6, 0x99, 0x0d, 0xb9, 0x1e, 0x0d, 0x72, 0x6f, 0xf3, 0x0b,
-	0xd6, 0x22, 0x7c, 0x6c, 0x9d, 0x60, 0x6e, 0xe4, 0x9f, 0xd6, 0xbf, 0xc8, 0x13, 0x98, 0x09, 0xd8,
-	0x2f, 0x6e, 0x39, 0xec, 0x03, 0xf1, 0xe7, 0x11, 0x2a, 0x62, 0xc1, 0x0c, 0x45, 0x9a, 0x5f, 0x32,
-	0xf3, 0x9f, 0x13, 0xae, 0x3b, 0xb7, 0x1c, 0xf6, 0x35, 0xf1, 0x82, 0x5e, 0x7e, 0x4b, 0x2b, 0xff,
-	0xaa, 0xdf, 0xbb, 0x57, 0x69, 0x36, 0x97, 0xdd, 0x4b, 0xd6, 0x6c, 0xf2, 0x96, 0xe4, 0xb6, 0x18,
-	0x9d, 0xfc, 0x63, 0x84, 0x4c, 0x25, 0x16, 0xfe, 0x2c, 0xe1, 0xec, 0x41, 0x8e, 0xc5, 0x20, 0x25,
-	0xb9, 0xc5, 0x1e, 0xaf, 0xd8, 0x8c, 0xbb, 0x49, 0x63, 0xbd, 0xea, 0xbe, 0x79, 0xe6, 0x58, 0x6f,
-	0xd2, 0x89, 0x14, 0x47, 0xbc, 0x0f, 0x90, 0x45, 0x17, 0x59, 0x2e, 0xba, 0xa5, 0xad, 0x42, 0x31,
-	0x00, 0x69, 0xf3, 0xa0, 0x0a, 0x82, 0x61, 0x8b, 0x5f, 0x15, 0xa2, 0xfa, 0x40, 0xc5, 0xc5, 0x2e,
-	0x19, 0xe2, 0x68, 0x87, 0x01, 0xbb, 0xdd, 0xb2, 0xaa, 0x32, 0x41, 0xd5, 0x41, 0xb6, 0x27, 0xb0,
-	0xb8, 0x17, 0x45, 0xcf, 0xa6, 0x13, 0x1d, 0xab, 0xb7, 0xe3, 0x37, 0xbb, 0x7e, 0x72, 0xdc, 0xcd,
-	0xcd, 0xc2, 0xbd, 0x42, 0x4d, 0x75, 0x59, 0xc7, 0x68, 0xea, 0xe6, 0xc7, 0x59, 0xf4, 0xf2, 0x05,
-	0xf3, 0x61, 0x45, 0x7b, 0x00, 0x7a, 0xe0, 0x5d, 0xbb, 0x19, 0x33, 0xee, 0x56, 0xe8, 0xc2, 0xf2,
-	0xc9, 0xd4, 0x68, 0x6f, 0x26, 0xaa, 0xcd, 0x5b, 0x0e, 0xdb, 0x87, 0xd6, 0x5d, 0xde, 0x8f, 0x06,
-	0x5c, 0x46, 0x5c, 0x56, 0xb3, 0x81, 0xeb, 0x50, 0x4d, 0x77, 0xd1, 0x02, 0x6d, 0x9d, 0x38, 0xf1,
-	0x67, 0x31, 0xff, 0xc6, 0xcd, 0x8f, 0x65, 0x2c, 0xe7, 0x85, 0xd2, 0x89, 0x2a, 0xfe, 0x64, 0xe9,
-	0xc4, 0x5c, 0xc0, 0xca, 0xd2, 0x89, 0x85, 0x80, 0x95, 0xb5, 0xd4, 0x2a, 0xfe, 0xc5, 0x46, 0xb0,
-	0x52, 0x88, 0x71, 0x69, 0x3f, 0xe2, 0xac, 0xc8, 0x58, 0xf7, 0xca, 0xd9, 0x04, 0x76, 0x6f, 0x9b,
-	0x76, 0x6f, 0x07, 0xb0, 0x78, 0x97, 0x8b, 0xc5, 0x12, 0x09, 0x01, 0xb9, 0x97, 0x7a, 0x66, 0xf2,
-	0x40, 0x5e, 0x29, 0x52, 0x9d, 0x6d, 0xf4, 0xe8, 0x36, 0x9e, 0x7d, 0x15, 0x9a, 0xf7, 0x79, 0xaa,
-	0x32, 0x00, 0xb4, 0x37, 0x96, 0x4b, 0x09, 0xe8, 0x96, 0x24, 0x10, 0xd8, 0x3c, 0x43, 0xad, 0xdd,
-	0xe4, 0x83, 0x21, 0x17, 0xea, 0xa9, 0x17, 0x0c, 0x5e, 0xb0, 0x5f, 0xa6, 0xc6, 0x75, 0x42, 0xd1,
-	0xba, 0x71, 0x71, 0x6c, 0x36, 0xbe, 0x9c, 0xc3, 0xcb, 0x5a, 0x0e, 0xa3, 0x01, 0x37, 0xcc, 0x7f,
-	0x08, 0x4d, 0x23, 0xdb, 0x4d, 0x0b, 0x50, 0x31, 0x73, 0x4f, 0x0b, 0x50, 0x49, 0x72, 0x9c, 0x7b,
-	0x9d, 0xfa, 0x71, 0xd9, 0x95, 0xac, 0x1f, 0x91, 0x10, 0x97, 0xf5, 0x74, 0xf3, 0x63, 0x7f, 0x9c,
-	0xbe, 0x60, 0x4f, 0xe9, 0xd5, 0x9e, 0x99, 0xe5, 0x90, 0x79, 0x83, 0xf9, 0x84, 0x08, 0xbd, 0x58,
-	0x46, 0x95, 0xed, 0x21, 0x8a, 0xae, 0xc8, 0x4b, 0xf8, 0x0c, 0xc0, 0x41, 0x1a, 0x4d, 0xee, 0xfa,
-	0x7c, 0x1c, 0x85, 0x99, 0xae, 0xcd, 0x6e, 0xf2, 0x33, 0xfd, 0x65, 0x5c, 0xe7, 0xb3, 0xa7, 0x86,
-	0x3f, 0x6e, 0x25, 0x89, 0x28, 0xe6, 0x3a, 0xf3, 0xb2, 0x5f, 0x2f, 0x48, 0xc9, 0x85, 0xff, 0x2d,
-	0x07, 0xbd, 0xeb, 0x2c, 0xa2, 0xaa, 0xbd, 0xeb, 0x42, 0xb0, 0x56, 0xab, 0xbd, 0x92, 0xf0, 0xeb,
-	0x3e, 0x34, 0xb2, 0x10, 0xdd, 0x46, 0x96, 0xb1, 0x68, 0x05, 0xf4, 0xb4, 0x55, 0x2c, 0x04, 0xce,
-	0xdc, 0x36, 0x2d, 0x15, 0xb0, 0x05, 0x5c, 0x2a, 0x8a, 0x86, 0x05, 0xb0, 0x2a, 0x06, 0xa8, 0x4d,
-	0x3c, 0xdd, 0x4d, 0xab, 0x99, 0x94, 0x04, 0xaf, 0xb4, 0x34, 0x97, 0xc6, 0x7e, 0xac, 0x73, 0x36,
-	0x72, 0xab, 0xb8, 0x17, 0x47, 0xd5, 0x3c, 0x86, 0x95, 0x42, 0xe0, 0x42, 0x8b, 0xf4, 0x59, 0xf1,
-	0x22, 0x2d, 0xd2, 0x67, 0xc6, 0x3c, 0xdc, 0x8b, 0xd4, 0xe5, 0xb2, 0x0b, 0xd8, 0x65, 0x72, 0x1a,
-	0xa4, 0xfd, 0xe3, 0x0f, 0x9c, 0xcd, 0xc3, 0x39, 0xfa, 0xb3, 0xb9, 0x4f, 0xfd, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xf1, 0xeb, 0xab, 0x92, 0x9e, 0x4e, 0x00, 0x00,
+	// 6508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xdb, 0x8f, 0x1c, 0xdb,
+	0x59, 0xaf, 0xab, 0x2f, 0x33, 0xd3, 0x5f, 0xf7, 0x74, 0xcf, 0xac, 0xb9, 0xb8, 0x5d, 0xbe, 0x6c,
+	0xef, 0x8a, 0xb5, 0xed, 0xe3, 0xe3, 0x63, 0x7b, 0x4f, 0x92, 0xad, 0x9d, 0xbd, 0xcf, 0x49, 0x8e,
+	0x3d, 0x1e, 0x7b, 0x9c, 0xcc, 0xb6, 0x27, 0x35, 0xde, 0x31, 0x24, 0xa0, 0x4e, 0x4d, 0xf7, 0x9a,
+	0x9e, 0x8a, 0xab, 0xab, 0x2a, 0x55, 0xd5, 0x33, 0xee, 0x6c, 0x2c, 0x71, 0x13, 0x4f, 0x44, 0x08,
+	0x81, 0x84, 0x82, 0x84, 0x90, 0x02, 0x42, 0xe1, 0x0f, 0x80, 0x97, 0x80, 0xc4, 0x03, 0x3c, 0x80,
+	0x84, 0x78, 0xc8, 0x53, 0xc4, 0x23, 0xbc, 0x00, 0xe2, 0x05, 0x29, 0xaf, 0x08, 0x7d, 0xeb, 0x56,
+	0x6b, 0x55, 0x55, 0x7b, 0x9c, 0x0b, 0xbc, 0xf5, 0xfa, 0xad, 0xaf, 0xd6, 0xf5, 0xbb, 0xad, 0x6f,
+	0x7d, 0xab, 0xa1, 0x95, 0xc4, 0xc3, 0xdb, 0x71, 0x12, 0x65, 0x11, 0x69, 0x06, 0x61, 0x12, 0x0f,
+	0xed, 0x4b, 0xe3, 0x28, 0x1a, 0x07, 0xf4, 0x8e, 0x17, 0xfb, 0x77, 0xbc, 0x30, 0x8c, 0x32, 0x2f,
+	0xf3, 0xa3, 0x30, 0xe5, 0x44, 0xce, 0xd7, 0xa1, 0xfb, 0x88, 0x86, 0x07, 0x94, 0x8e, 0x5c, 0xfa,
+	0xcd, 0x29, 0x4d, 0x33, 0xf2, 0xbf, 0x61, 0xd5, 0xa3, 0xdf, 0xa2, 0x74, 0x34, 0x88, 0xbd, 0x34,
+	0x8d, 0x8f, 0x13, 0x2f, 0xa5, 0x7d, 0xeb, 0xaa, 0x75, 0xa3, 0xe3, 0xae, 0xf0, 0x8a, 0x7d, 0x85,
+	0x93, 0xb7, 0xa1, 0x93, 0x22, 0x29, 0x0d, 0xb3, 0x24, 0x8a, 0x67, 0xfd, 0x1a, 0xa3, 0x6b, 0x23,
+	0xb6, 0xc3, 0x21, 0x27, 0x80, 0x9e, 0xea, 0x21, 0x8d, 0xa3, 0x30, 0xa5, 0xe4, 0x2e, 0xac, 0x0f,
+	0xfd, 0xf8, 0x98, 0x26, 0x03, 0xf6, 0xf1, 0x24, 0xa4, 0x93, 0x28, 0xf4, 0x87, 0x7d, 0xeb, 0x6a,
+	0xfd, 0x46, 0xcb, 0x25, 0xbc, 0x0e, 0xbf, 0xf8, 0x48, 0xd4, 0x90, 0xeb, 0xd0, 0xa3, 0x21, 0xc7,
+	0xe9, 0x88, 0x7d, 0x25, 0xba, 0xea, 0xe6, 0x30, 0x7e, 0xe0, 0xfc, 0xb5, 0x05, 0xab, 0x8f, 0x43,
+	0x3f, 0x7b, 0xee, 0x05, 0x01, 0xcd, 0xe4, 0x9c, 0xae, 0x43, 0xef, 0x94, 0x01, 0x6c, 0x4e, 0xa7,
+	0x51, 0x32, 0x12, 0x33, 0xea, 0x72, 0x78, 0x5f, 0xa0, 0x73, 0x47, 0x56, 0x9b, 0x3b, 0xb2, 0xca,
+	0xe5, 0xaa, 0xcf, 0x59, 0xae, 0xeb, 0xd0, 0x4b, 0xe8, 0x30, 0x3a, 0xa1, 0xc9, 0x6c, 0x70, 0xea,
+	0x87, 0xa3, 0xe8, 0xb4, 0xdf, 0xb8, 0x6a, 0xdd, 0x68, 0xba, 0x5d, 0x09, 0x3f, 0x67, 0xa8, 0xb3,
+	0x0e, 0x44, 0x9f, 0x05, 0x5f, 0x37, 0x67, 0x0c, 0x6b, 0x1f, 0x87, 0x41, 0x34, 0x7c, 0xf1, 0x13,
+	0xce, 0xae, 0xa2, 0xfb, 0x5a, 0x65, 0xf7, 0x9b, 0xb0, 0x6e, 0x76, 0x24, 0x06, 0x40, 0x61, 0x63,
+	0xfb, 0xd8, 0x0b, 0xc7, 0x54, 0x36, 0x29, 0x87, 0xf0, 0xbf, 0x60, 0x65, 0x38, 0x4d, 0x12, 0x1a,
+	0x96, 0xc6, 0xd0, 0x13, 0xb8, 0x1a, 0xc4, 0xdb, 0xd0, 0x09, 0xe9, 0x69, 0x4e, 0x26, 0x58, 0x26,
+	0xa4, 0xa7, 0x92, 0xc4, 0xe9, 0xc3, 0x66, 0xb1, 0x1b, 0x31, 0x80, 0xef, 0xd4, 0xa0, 0xfd, 0x2c,
+	0xf1, 0xc2, 0xd4, 0x1b, 0x22, 0x17, 0x93, 0x3e, 0x2c, 0x66, 0x2f, 0x07, 0xc7, 0x5e, 0x7a, 0xcc,
+	0xba, 0x6b, 0xb9, 0xb2, 0x48, 0x36, 0x61, 0xc1, 0x9b, 0x44, 0xd3, 0x30, 0x63, 0x1d, 0xd4, 0x5d,
+	0x51, 0x22, 0xb7, 0x60, 0x35, 0x9c, 0x4e, 0x06, 0xc3, 0x28, 0x3c, 0xf2, 0x93, 0x09, 0x97, 0x05,
+	0xb6, 0x5f, 0x4d, 0xb7, 0x5c, 0x41, 0xae, 0x00, 0x1c, 0xe2, 0x3a, 0xf0, 0x2e, 0x1a, 0xac, 0x0b,
+	0x0d, 0x21, 0x0e, 0x74, 0x44, 0x89, 0xfa, 0xe3, 0xe3, 0xac, 0xdf, 0x64, 0x0d, 0x19, 0x18, 0xb6,
+	0x91, 0xf9, 0x13, 0x3a, 0x48, 0x33, 0x6f, 0x12, 0xf7, 0x17, 0xd8, 0x68, 0x34, 0x84, 0xd5, 0x47,
+	0x99, 0x17, 0x0c, 0x8e, 0x28, 0x4d, 0xfb, 0x8b, 0xa2, 0x5e, 0x21, 0xe4, 0x1d, 0xe8, 0x8e, 0x68,
+	0x9a, 0x0d, 0xbc, 0xd1, 0x28, 0xa1, 0x69, 0x4a, 0xd3, 0xfe, 0x12, 0xe3, 0xc6, 0x02, 0x8a, 0xab,
+	0xf6, 0x88, 0x66, 0xda, 0xea, 0xa4, 0x62, 0x77, 0x9c, 0x3d, 0x20, 0x1a, 0xfc, 0x80, 0x66, 0x9e,
+	0x1f, 0xa4, 0xe4, 0x3d, 0xe8, 0x64, 0x1a, 0x31, 0x93, 0xbe, 0xf6, 0x16, 0xb9, 0xcd, 0xd4, 0xc6,
+	0x6d, 0xed, 0x03, 0xd7, 0xa0, 0x73, 0x1e, 0xc1, 0xd2, 0x43, 0x4a, 0xf7, 0xfc, 0x89, 0x9f, 0x91,
+	0x4d, 0x68, 0x1e, 0xf9, 0x2f, 0x29, 0xdf, 0xec, 0xfa, 0xee, 0x39, 0x97, 0x17, 0x89, 0x0d, 0x8b,
+	0x31, 0x4d, 0x86, 0x54, 0x2e, 0xff, 0xee, 0x39, 0x57, 0x02, 0xf7, 0x17, 0xa1, 0x19, 0xe0, 0xc7,
+	0xce, 0xf7, 0x6a, 0xd0, 0x3e, 0xa0, 0xa1, 0x62, 0x22, 0x02, 0x0d, 0x9c, 0x92, 0x60, 0x1c, 0xf6,
+	0x9b, 0xbc, 0x05, 0x6d, 0x36, 0xcd, 0x34, 0x4b, 0xfc, 0x70, 0xcc, 0x1a, 0x6b, 0xb9, 0x80, 0xd0,
+	0x01, 0x43, 0xc8, 0x0a, 0xd4, 0xbd, 0x49, 0xc6, 0x76, 0xb0, 0xee, 0xe2, 0x4f, 0x64, 0xb0, 0xd8,
+	0x9b, 0x4d, 0x90, 0x17, 0xd5, 0xae, 0x75, 0xdc, 0xb6, 0xc0, 0x76, 0x71, 0xdb, 0x6e, 0xc3, 0x9a,
+	0x4e, 0x22, 0x5b, 0x6f, 0xb2, 0xd6, 0x57, 0x35, 0x4a, 0xd1, 0xc9, 0x75, 0xe8, 0x49, 0xfa, 0x84,
+	0x0f, 0x96, 0xed, 0x63, 0xcb, 0xed, 0x0a, 0x58, 0x4e, 0xe1, 0x06, 0xac, 0x1c, 0xf9, 0xa1, 0x17,
+	0x0c, 0x86, 0x41, 0x76, 0x32, 0x18, 0xd1, 0x20, 0xf3, 0xd8, 0x8e, 0x36, 0xdd, 0x2e, 0xc3, 0xb7,
+	0x83, 0xec, 0xe4, 0x01, 0xa2, 0xe4, 0x16, 0xb4, 0x8e, 0x28, 0x1d, 0xb0, 0x95, 0xe8, 0x2f, 0x5d,
+	0xb5, 0x6e, 0xb4, 0xb7, 0x7a, 0x62, 0xe9, 0xe5, 0xea, 0xba, 0x4b, 0x47, 0xe2, 0x97, 0xf3, 0xbb,
+	0x16, 0x74, 0xf8, 0x52, 0x09, 0x15, 0x7a, 0x0d, 0x96, 0xe5, 0x88, 0x68, 0x92, 0x44, 0x89, 0x60,
+	0x7f, 0x13, 0x24, 0x37, 0x61, 0x45, 0x02, 0x71, 0x42, 0xfd, 0x89, 0x37, 0xa6, 0x42, 0xde, 0x4a,
+	0x38, 0xd9, 0xca, 0x5b, 0x4c, 0xa2, 0x69, 0xc6, 0x95, 0x58, 0x7b, 0xab, 0x23, 0x06, 0xe5, 0x22,
+	0xe6, 0x9a, 0x24, 0xce, 0xb7, 0x2d, 0x20, 0x38, 0xac, 0x67, 0x11, 0xaf, 0x16, 0xab, 0x50, 0xdc,
+	0x01, 0xeb, 0x8d, 0x77, 0xa0, 0x36, 0x6f, 0x07, 0xae, 0xc1, 0x02, 0xeb, 0x12, 0x65, 0xb5, 0x5e,
+	0x1a, 0x96, 0xa8, 0x73, 0xbe, 0x6b, 0x41, 0x07, 0x35, 0x47, 0x48, 0x83, 0xfd, 0xc8, 0x0f, 0x33,
+	0x72, 0x17, 0xc8, 0xd1, 0x34, 0x1c, 0xf9, 0xe1, 0x78, 0x90, 0xbd, 0xf4, 0x47, 0x83, 0xc3, 0x19,
+	0x36, 0xc1, 0xc6, 0xb3, 0x7b, 0xce, 0xad, 0xa8, 0x23, 0xb7, 0x60, 0xc5, 0x40, 0xd3, 0x2c, 0xe1,
+	0xa3, 0xda, 0x3d, 0xe7, 0x96, 0x6a, 0x50, 0xfe, 0xa3, 0x69, 0x16, 0x4f, 0xb3, 0x81, 0x1f, 0x8e,
+	0xe8, 0x4b, 0xb6, 0x66, 0xcb, 0xae, 0x81, 0xdd, 0xef, 0x42, 0x47, 0xff, 0xce, 0xf9, 0x3c, 0xac,
+	0xec, 0xa1, 0x62, 0x08, 0xfd, 0x70, 0x7c, 0x8f, 0x4b, 0x2f, 0x6a, 0xab, 0x78, 0x7a, 0xf8, 0x82,
+	0xce, 0xc4, 0x3e, 0x8a, 0x12, 0x8a, 0xc4, 0x71, 0x94, 0x66, 0x62, 0x5d, 0xd8, 0x6f, 0xe7, 0x9f,
+	0x2c, 0xe8, 0xe1, 0xa2, 0x7f, 0xe4, 0x85, 0x33, 0xb9, 0xe2, 0x7b, 0xd0, 0xc1, 0xa6, 0x9e, 0x45,
+	0xf7, 0xb8, 0xce, 0xe3, 0xb2, 0x7c, 0x43, 0x2c, 0x52, 0x81, 0xfa, 0xb6, 0x4e, 0x8a, 0x66, 0x7a,
+	0xe6, 0x1a, 0x5f, 0xa3, 0xd0, 0x65, 0x5e, 0x32, 0xa6, 0x19, 0xd3, 0x86, 0x42, 0x3b, 0x02, 0x87,
+	0xb6, 0xa3, 0xf0, 0x88, 0x5c, 0x85, 0x4e, 0xea, 0x65, 0x83, 0x98, 0x26, 0x6c, 0xd5, 0x98, 0xe0,
+	0xd4, 0x5d, 0x48, 0xbd, 0x6c, 0x9f, 0x26, 0xf7, 0x67, 0x19, 0xb5, 0xbf, 0x00, 0xab, 0xa5, 0x5e,
+	0x50, 0x56, 0xf3, 0x29, 0xe2, 0x4f, 0xb2, 0x0e, 0xcd, 0x13, 0x2f, 0x98, 0x52, 0xa1, 0xa4, 0x79,
+	0xe1, 0x83, 0xda, 0xfb, 0x96, 0xf3, 0x0e, 0xac, 0xe4, 0xc3, 0x16, 0x4c, 0x4f, 0xa0, 0x81, 0x2b,
+	0x28, 0x1a, 0x60, 0xbf, 0x9d, 0x5f, 0xb1, 0x38, 0xe1, 0x76, 0xe4, 0x2b, 0x85, 0x87, 0x84, 0xa8,
+	0x17, 0x25, 0x21, 0xfe, 0x9e, 0x6b, 0x10, 0x7e, 0xfa, 0xc9, 0x3a, 0xd7, 0x61, 0x55, 0x1b, 0xc2,
+	0x6b, 0x06, 0xfb, 0x6d, 0x0b, 0x56, 0x9f, 0xd0, 0x53, 0xb1, 0xeb, 0x72, 0xb4, 0xef, 0x43, 0x23,
+	0x9b, 0xc5, 0xdc, 0xc9, 0xea, 0x6e, 0x5d, 0x13, 0x9b, 0x56, 0xa2, 0xbb, 0x2d, 0x8a, 0xcf, 0x66,
+	0x31, 0x75, 0xd9, 0x17, 0xce, 0xe7, 0xa1, 0xad, 0x81, 0xe4, 0x3c, 0xac, 0x3d, 0x7f, 0xfc, 0xec,
+	0xc9, 0xce, 0xc1, 0xc1, 0x60, 0xff, 0xe3, 0xfb, 0x5f, 0xda, 0xf9, 0xf9, 0xc1, 0xee, 0xbd, 0x83,
+	0xdd, 0x95, 0x73, 0x64, 0x13, 0xc8, 0x93, 0x9d, 0x83, 0x67, 0x3b, 0x0f, 0x0c, 0xdc, 0x72, 0x6c,
+	0xe8, 0x3f, 0xa1, 0xa7, 0xcf, 0xfd, 0x2c, 0xa4, 0x69, 0x6a, 0xf6, 0xe6, 0xdc, 0x06, 0xa2, 0x0f,
+	0x41, 0xcc, 0xaa, 0x0f, 0x8b, 0xc2, 0xe2, 0x48, 0x83, 0x2b, 0x8a, 0xce, 0x3b, 0x40, 0x0e, 0xfc,
+	0x71, 0xf8, 0x11, 0x4d, 0x53, 0x6f, 0xac, 0x54, 0xc1, 0x0a, 0xd4, 0x27, 0xe9, 0x58, 0x68, 0x00,
+	0xfc, 0xe9, 0x7c, 0x1a, 0xd6, 0x0c, 0x3a, 0xd1, 0xf0, 0x25, 0x68, 0xa5, 0xfe, 0x38, 0xf4, 0xb2,
+	0x69, 0x42, 0x45, 0xd3, 0x39, 0xe0, 0x3c, 0x84, 0xf5, 0xaf, 0xd0, 0xc4, 0x3f, 0x9a, 0x9d, 0xd5,
+	0xbc, 0xd9, 0x4e, 0xad, 0xd8, 0xce, 0x0e, 0x6c, 0x14, 0xda, 0x11, 0xdd, 0x73, 0x46, 0x14, 0xdb,
+	0xb5, 0xe4, 0xf2, 0x82, 0x26, 0x96, 0x35, 0x5d, 0x2c, 0x9d, 0x8f, 0x81, 0x6c, 0x47, 0x61, 0x48,
+	0x87, 0xd9, 0x3e, 0xa5, 0x49, 0xee, 0x39, 0xe7, 0x5c, 0xd7, 0xde, 0x3a, 0x2f, 0xf6, 0xb1, 0x28,
+	0xeb, 0x82, 0x1d, 0x09, 0x34, 0x62, 0x9a, 0x4c, 0x58, 0xc3, 0x4b, 0x2e, 0xfb, 0xed, 0x6c, 0xc0,
+	0x9a, 0xd1, 0xac, 0x70, 0x7a, 0xde, 0x85, 0x8d, 0x07, 0x7e, 0x3a, 0x2c, 0x77, 0xd8, 0x87, 0xc5,
+	0x78, 0x7a, 0x38, 0xc8, 0x65, 0x4a, 0x16, 0xd1, 0x17, 0x28, 0x7e, 0x22, 0x1a, 0xfb, 0x0d, 0x0b,
+	0x1a, 0xbb, 0xcf, 0xf6, 0xb6, 0x89, 0x0d, 0x4b, 0x7e, 0x38, 0x8c, 0x26, 0xa8, 0x76, 0xf9, 0xa4,
+	0x55, 0x79, 0xae, 0xac, 0x5c, 0x82, 0x16, 0xd3, 0xd6, 0xe8, 0xde, 0x08, 0x27, 0x37, 0x07, 0xd0,
+	0xb5, 0xa2, 0x2f, 0x63, 0x3f, 0x61, 0xbe, 0x93, 0xf4, 0x88, 0x1a, 0x4c, 0x23, 0x96, 0x2b, 0x9c,
+	0xff, 0x6c, 0xc0, 0xa2, 0xd0, 0xd5, 0xac, 0xbf, 0x61, 0xe6, 0x9f, 0x50, 0x31, 0x12, 0x51, 0x42,
+	0x2b, 0x97, 0xd0, 0x49, 0x94, 0xd1, 0x81, 0xb1, 0x0d, 0x26, 0x88, 0x54, 0x43, 0xde, 0xd0, 0x20,
+	0x46, 0xad, 0xcf, 0x46, 0xd6, 0x72, 0x4d, 0x10, 0x17, 0x0b, 0x81, 0x81, 0x3f, 0x62, 0x63, 0x6a,
+	0xb8, 0xb2, 0x88, 0x2b, 0x31, 0xf4, 0x62, 0x6f, 0xe8, 0x67, 0x33, 0x21, 0xdc, 0xaa, 0x8c, 0x6d,
+	0x07, 0xd1, 0xd0, 0x0b, 0x06, 0x87, 0x5e, 0xe0, 0x85, 0x43, 0x2a, 0xfc, 0x37, 0x13, 0x44, 0x17,
+	0x4d, 0x0c, 0x49, 0x92, 0x71, 0x37, 0xae, 0x80, 0xa2, 0xab, 0x37, 0x8c, 0x26, 0x13, 0x3f, 0x43,
+	0xcf, 0x8e, 0x59, 0xfd, 0xba, 0xab, 0x21, 0x6c, 0x26, 0xbc, 0x74, 0xca, 0x57, 0xaf, 0xc5, 0x7b,
+	0x33, 0x40, 0x6c, 0x05, 0x5d, 0x07, 0x54, 0x48, 0x2f, 0x4e, 0xfb, 0xc0, 0x5b, 0xc9, 0x11, 0xdc,
+	0x87, 0x69, 0x98, 0xd2, 0x2c, 0x0b, 0xe8, 0x48, 0x0d, 0xa8, 0xcd, 0xc8, 0xca, 0x15, 0xe4, 0x2e,
+	0xac, 0x71, 0x67, 0x33, 0xf5, 0xb2, 0x28, 0x3d, 0xf6, 0xd3, 0x41, 0x8a, 0x6e, 0x5b, 0x87, 0xd1,
+	0x57, 0x55, 0x91, 0xf7, 0xe1, 0x7c, 0x01, 0x4e, 0xe8, 0x90, 0xfa, 0x27, 0x74, 0xd4, 0x5f, 0x66,
+	0x5f, 0xcd, 0xab, 0x26, 0x57, 0xa1, 0x8d, 0x3e, 0xf6, 0x34, 0x1e, 0x79, 0x68, 0x87, 0xbb, 0x6c,
+	0x1f, 0x74, 0x88, 0xbc, 0x0b, 0xcb, 0x31, 0xe5, 0xc6, 0xf2, 0x38, 0x0b, 0x86, 0x69, 0xbf, 0xc7,
+	0x2c, 0x59, 0x5b, 0x08, 0x13, 0x72, 0xae, 0x6b, 0x52, 0x20, 0x53, 0x0e, 0x53, 0xe6, 0x6c, 0x79,
+	0xb3, 0xfe, 0x0a, 0x63, 0xb7, 0x1c, 0x60, 0x32, 0x92, 0xf8, 0x27, 0x5e, 0x46, 0xfb, 0xab, 0x8c,
+	0xb7, 0x64, 0xd1, 0xf9, 0x43, 0x0b, 0xd6, 0xf6, 0xfc, 0x34, 0x13, 0x4c, 0xa8, 0xd4, 0xf1, 0x5b,
+	0xd0, 0xe6, 0xec, 0x37, 0x88, 0xc2, 0x60, 0x26, 0x38, 0x12, 0x38, 0xf4, 0x34, 0x0c, 0x66, 0xe4,
+	0x53, 0xb0, 0xec, 0x87, 0x3a, 0x09, 0x97, 0xe1, 0x8e, 0x1f, 0x6a, 0x44, 0x6f, 0x41, 0x3b, 0x9e,
+	0x1e, 0x06, 0xfe, 0x90, 0x93, 0xd4, 0x79, 0x2b, 0x1c, 0x62, 0x04, 0xe8, 0x24, 0xf1, 0x91, 0x70,
+	0x8a, 0x06, 0xa3, 0x68, 0x0b, 0x0c, 0x49, 0x9c, 0xfb, 0xb0, 0x6e, 0x0e, 0x50, 0x28, 0xab, 0x9b,
+	0xb0, 0x24, 0x78, 0x3b, 0xed, 0xb7, 0xd9, 0xfa, 0x74, 0xc5, 0xfa, 0x08, 0x52, 0x57, 0xd5, 0x3b,
+	0x7f, 0xd2, 0x80, 0x35, 0x81, 0x6e, 0x07, 0x51, 0x4a, 0x0f, 0xa6, 0x93, 0x89, 0x97, 0x54, 0x08,
+	0x8d, 0x75, 0x86, 0xd0, 0xd4, 0x4c, 0xa1, 0x41, 0x56, 0x3e, 0xf6, 0xfc, 0x90, 0x7b, 0x78, 0x5c,
+	0xe2, 0x34, 0x84, 0xdc, 0x80, 0xde, 0x30, 0x88, 0x52, 0xee, 0xf5, 0xe8, 0xc7, 0xa7, 0x22, 0x5c,
+	0x16, 0xf2, 0x66, 0x95, 0x90, 0xeb, 0x42, 0xba, 0x50, 0x10, 0x52, 0x07, 0x3a, 0xd8, 0x28, 0x95,
+	0x3a, 0x67, 0x91, 0x7b, 0x61, 0x3a, 0x86, 0xe3, 0x29, 0x8a, 0x04, 0x97, 0xbf, 0x5e, 0x95, 0x40,
+	0xe0, 0xe9, 0x0c, 0x75, 0x9a, 0x46, 0xdd, 0x12, 0x02, 0x51, 0xae, 0x22, 0x0f, 0x01, 0x78, 0x5f,
+	0xcc, 0x8c, 0x03, 0x33, 0xe3, 0xef, 0x98, 0x3b, 0xa2, 0xaf, 0xfd, 0x6d, 0x2c, 0x4c, 0x13, 0xca,
+	0x0c, 0xb9, 0xf6, 0xa5, 0xf3, 0x09, 0xb4, 0xb5, 0x2a, 0xb2, 0x01, 0xab, 0xdb, 0x4f, 0x9f, 0xee,
+	0xef, 0xb8, 0xf7, 0x9e, 0x3d, 0xfe, 0xca, 0xce, 0x60, 0x7b, 0xef, 0xe9, 0xc1, 0xce, 0xca, 0x39,
+	0x84, 0xf7, 0x9e, 0x6e, 0xdf, 0xdb, 0x1b, 0x3c, 0x7c, 0xea, 0x6e, 0x4b, 0xd8, 0x42, 0x1b, 0xef,
+	0xee, 0x7c, 0xf4, 0xf4, 0xd9, 0x8e, 0x81, 0xd7, 0xc8, 0x0a, 0x74, 0xee, 0xbb, 0x3b, 0xf7, 0xb6,
+	0x77, 0x05, 0x52, 0x27, 0xeb, 0xb0, 0xf2, 0xf0, 0xe3, 0x27, 0x0f, 0x1e, 0x3f, 0x79, 0x34, 0xd8,
+	0xbe, 0xf7, 0x64, 0x7b, 0x67, 0x6f, 0xe7, 0xc1, 0x4a, 0xc3, 0xf9, 0x2b, 0x0b, 0x36, 0xd8, 0x28,
+	0x47, 0x45, 0x81, 0xb8, 0x0a, 0xed, 0x61, 0x14, 0xc5, 0x34, 0xf1, 0x34, 0x15, 0xad, 0x43, 0xc8,
+	0xec, 0x5c, 0x21, 0x1e, 0x45, 0xc9, 0x90, 0x0a, 0x79, 0x00, 0x06, 0x3d, 0x44, 0x04, 0x99, 0x5d,
+	0x6c, 0x27, 0xa7, 0xe0, 0xe2, 0xd0, 0xe6, 0x18, 0x27, 0xd9, 0x84, 0x85, 0xc3, 0x84, 0x7a, 0xc3,
+	0x63, 0x21, 0x09, 0xa2, 0x84, 0xa1, 0x05, 0xe9, 0x3e, 0x0f, 0x71, 0xb5, 0x03, 0x3a, 0x62, 0x1c,
+	0xb2, 0xe4, 0xf6, 0x04, 0xbe, 0x2d, 0x60, 0x67, 0x1f, 0x36, 0x8b, 0x33, 0x10, 0x12, 0xf3, 0x9e,
+	0x26, 0x31, 0xdc, 0x37, 0xb6, 0xe7, 0xef, 0x8f, 0x26, 0x3d, 0xff, 0x6a, 0x41, 0x03, 0xcd, 0xe7,
+	0x7c, 0x53, 0xab, 0x7b, 0x44, 0x75, 0xc3, 0x23, 0x62, 0xc1, 0x03, 0x3c, 0x53, 0x70, 0x85, 0xca,
+	0x8d, 0x8e, 0x86, 0xe4, 0xf5, 0x09, 0x1d, 0x9e, 0xf4, 0x9b, 0x7a, 0x3d, 0x22, 0xc8, 0xf2, 0xe8,
+	0x78, 0xb2, 0xaf, 0x05, 0xcb, 0xcb, 0xb2, 0xac, 0x63, 0x5f, 0x2e, 0xe6, 0x75, 0xec, 0xbb, 0x3e,
+	0x2c, 0xfa, 0xe1, 0x61, 0x34, 0x0d, 0x47, 0x8c, 0xc5, 0x97, 0x5c, 0x59, 0x44, 0x55, 0x19, 0x33,
+	0xd1, 0xf3, 0x27, 0x92, 0xa1, 0x73, 0xc0, 0x21, 0x78, 0x30, 0x49, 0x99, 0xbb, 0xa0, 0xbc, 0xc0,
+	0xf7, 0x60, 0x55, 0xc3, 0xc4, 0x6a, 0xbe, 0x0d, 0xcd, 0x18, 0x81, 0xbe, 0x65, 0x28, 0x67, 0x24,
+	0x72, 0x79, 0x8d, 0xb3, 0x82, 0x71, 0xc5, 0xec, 0x71, 0x78, 0x14, 0xc9, 0x96, 0x7e, 0x58, 0x87,
+	0x9e, 0x82, 0x44, 0x43, 0x37, 0xa0, 0xe7, 0x8f, 0x68, 0x98, 0xf9, 0xd9, 0x6c, 0x60, 0x9c, 0x7f,
+	0x8a, 0x30, 0xfa, 0x67, 0x5e, 0xe0, 0x7b, 0xa9, 0xf0, 0x00, 0x78, 0x81, 0x6c, 0xc1, 0x3a, 0x1a,
+	0x0f, 0x69, 0x0f, 0xd4, 0x16, 0xf3, 0x63, 0x58, 0x65, 0x1d, 0x8a, 0x37, 0xe2, 0x42, 0x7f, 0xab,
+	0x4f, 0xb8, 0x9f, 0x52, 0x55, 0x85, 0xab, 0xc6, 0x5b, 0xc2, 0x29, 0x37, 0xb9, 0x81, 0x51, 0x40,
+	0x29, 0x04, 0xb4, 0xc0, 0x95, 0x4f, 0x31, 0x04, 0xa4, 0x85, 0x91, 0x96, 0x4a, 0x61, 0x24, 0x54,
+	0x4e, 0xb3, 0x70, 0x48, 0x47, 0x83, 0x2c, 0x1a, 0x30, 0x25, 0xca, 0x76, 0x67, 0xc9, 0x2d, 0xc2,
+	0xb8, 0xb7, 0x19, 0x4d, 0xb3, 0x90, 0x66, 0x4c, 0xcf, 0x2c, 0xb9, 0xb2, 0x88, 0xf2, 0xc3, 0x48,
+	0xb8, 0x49, 0x68, 0xb9, 0xa2, 0x84, 0x8e, 0xe6, 0x34, 0xf1, 0xd3, 0x7e, 0x87, 0xa1, 0xec, 0x37,
+	0xf9, 0x0c, 0x6c, 0x1c, 0xd2, 0x34, 0x1b, 0x1c, 0x53, 0x6f, 0x44, 0x13, 0xb6, 0xfb, 0x3c, 0x3a,
+	0xc5, 0xed, 0x77, 0x75, 0x25, 0xf6, 0x7d, 0x42, 0x93, 0xd4, 0x8f, 0x42, 0x66, 0xb9, 0x5b, 0xae,
+	0x2c, 0x3a, 0xdf, 0x62, 0xfe, 0xb0, 0x8a, 0x9b, 0x7d, 0xcc, 0x8c, 0x39, 0xb9, 0x08, 0x2d, 0x3e,
+	0xc7, 0xf4, 0xd8, 0x13, 0x2e, 0xfa, 0x12, 0x03, 0x0e, 0x8e, 0x3d, 0xd4, 0x08, 0xc6, 0xb2, 0xf1,
+	0x40, 0x64, 0x9b, 0x61, 0xbb, 0x7c, 0xd5, 0xae, 0x41, 0x57, 0x46, 0xe4, 0xd2, 0x41, 0x40, 0x8f,
+	0x32, 0x79, 0xbc, 0x0e, 0xa7, 0x13, 0xec, 0x2e, 0xdd, 0xa3, 0x47, 0x99, 0xf3, 0x04, 0x56, 0x85,
+	0x0c, 0x3f, 0x8d, 0xa9, 0xec, 0xfa, 0x73, 0x55, 0xd6, 0xad, 0xbd, 0xb5, 0x66, 0x0a, 0x3d, 0x8b,
+	0x11, 0x14, 0x4c, 0x9e, 0xe3, 0x02, 0xd1, 0x75, 0x82, 0x68, 0x50, 0x98, 0x18, 0x79, 0x88, 0x17,
+	0xd3, 0x31, 0x30, 0x5c, 0x9f, 0x74, 0x3a, 0x1c, 0xa2, 0x26, 0xe0, 0x1a, 0x50, 0x16, 0x9d, 0xef,
+	0x59, 0xb0, 0xc6, 0x5a, 0x93, 0xf6, 0x59, 0x9d, 0xfc, 0xde, 0x7c, 0x98, 0x9d, 0xa1, 0x56, 0x42,
+	0x79, 0xd0, 0x75, 0x2d, 0x2f, 0xfc, 0xf8, 0x67, 0xd9, 0x46, 0xe9, 0x2c, 0xfb, 0x43, 0x0b, 0x56,
+	0xb9, 0x32, 0xcc, 0xbc, 0x6c, 0x9a, 0x8a, 0xe9, 0xff, 0x5f, 0x58, 0xe6, 0x76, 0x4a, 0x88, 0x93,
+	0x18, 0xe8, 0xba, 0x92, 0x7c, 0x86, 0x72, 0xe2, 0xdd, 0x73, 0xae, 0x49, 0x4c, 0xbe, 0x00, 0x1d,
+	0x3d, 0xac, 0xca, 0xc6, 0xdc, 0xde, 0xba, 0x20, 0x67, 0x59, 0xe2, 0x9c, 0xdd, 0x73, 0xae, 0xf1,
+	0x01, 0xf9, 0x90, 0x39, 0x1b, 0xe1, 0x80, 0x35, 0xdb, 0xaf, 0x9b, 0x9f, 0x97, 0x36, 0x6b, 0xf7,
+	0x9c, 0xab, 0x91, 0xdf, 0x5f, 0x82, 0x05, 0xee, 0x5d, 0x3a, 0x8f, 0x60, 0xd9, 0x18, 0xa9, 0x71,
+	0x46, 0xef, 0xf0, 0x33, 0x7a, 0x29, 0xa4, 0x53, 0x2b, 0x87, 0x74, 0x9c, 0x5f, 0xab, 0x03, 0x41,
+	0x6e, 0x2b, 0x6c, 0x27, 0xba, 0xb7, 0xd1, 0xc8, 0x38, 0xac, 0x74, 0x5c, 0x1d, 0x22, 0xb7, 0x81,
+	0x68, 0x45, 0x19, 0xf5, 0xe2, 0x76, 0xa3, 0xa2, 0x06, 0x15, 0x9c, 0x30, 0xac, 0xc2, 0x04, 0x8a,
+	0x63, 0x19, 0xdf, 0xb7, 0xca, 0x3a, 0x34, 0x0d, 0xf1, 0x14, 0x43, 0x6a, 0x5e, 0x26, 0x8f, 0x33,
+	0xb2, 0x5c, 0x64, 0x90, 0x85, 0x33, 0x19, 0x64, 0xb1, 0xc8, 0x20, 0xba, 0x43, 0xbd, 0x64, 0x38,
+	0xd4, 0xe8, 0xc8, 0x4d, 0xd0, 0xfd, 0xcb, 0x82, 0xe1, 0x60, 0x82, 0xbd, 0x8b, 0xd3, 0x8b, 0x01,
+	0x62, 0x4c, 0x52, 0xb8, 0x02, 0xb9, 0xd7, 0x0e, 0x6c, 0x8d, 0x4b, 0x38, 0x6a, 0x5e, 0xfc, 0x98,
+	0x69, 0x00, 0x76, 0x82, 0x69, 0xba, 0x39, 0xe0, 0xfc, 0xc0, 0x82, 0x15, 0xdc, 0x05, 0x83, 0x53,
+	0x3f, 0x00, 0x26, 0x28, 0x6f, 0xc8, 0xa8, 0x06, 0xed, 0x4f, 0xcf, 0xa7, 0xef, 0x43, 0x8b, 0x35,
+	0x18, 0xc5, 0x34, 0x14, 0x6c, 0xda, 0x37, 0xd9, 0x34, 0xd7, 0x51, 0xbb, 0xe7, 0xdc, 0x9c, 0x58,
+	0x63, 0xd2, 0x7f, 0xb0, 0xa0, 0x2d, 0x86, 0xf9, 0x13, 0x9f, 0xd3, 0x6d, 0x58, 0x42, 0x7e, 0xd5,
+	0x0e, 0xc3, 0xaa, 0x8c, 0xb6, 0x66, 0x82, 0xc1, 0x10, 0x34, 0xae, 0xc6, 0x19, 0xbd, 0x08, 0xa3,
+	0xa5, 0x64, 0xea, 0x38, 0x1d, 0x64, 0x7e, 0x30, 0x90, 0xb5, 0xe2, 0x8e, 0xa3, 0xaa, 0x0a, 0xb5,
+	0x52, 0x9a, 0x61, 0x90, 0x99, 0x1b, 0x41, 0x5e, 0xc0, 0x60, 0x84, 0x98, 0x50, 0xc1, 0xb3, 0x74,
+	0xfe, 0xb2, 0x03, 0xe7, 0x4b, 0x55, 0xea, 0x92, 0x50, 0x1c, 0x3e, 0x03, 0x7f, 0x72, 0x18, 0x29,
+	0x37, 0xdc, 0xd2, 0xcf, 0xa5, 0x46, 0x15, 0x19, 0xc3, 0x86, 0xb4, 0xf6, 0xb8, 0xa6, 0xb9, 0x6d,
+	0xaf, 0x31, 0x37, 0xe5, 0x5d, 0x93, 0x07, 0x8a, 0x1d, 0x4a, 0x5c, 0x97, 0xeb, 0xea, 0xf6, 0xc8,
+	0x31, 0xf4, 0x65, 0x85, 0x34, 0x00, 0x9a, 0xeb, 0x81, 0x7d, 0xdd, 0x3a, 0xa3, 0x2f, 0xc3, 0x4d,
+	0x75, 0xe7, 0xb6, 0x46, 0x66, 0x70, 0x45, 0xd6, 0x31, 0x0d, 0x5f, 0xee, 0xaf, 0xf1, 0x46, 0x73,
+	0x63, 0x2e, 0xb6, 0xd9, 0xe9, 0x19, 0x0d, 0x93, 0x6f, 0xc0, 0xe6, 0xa9, 0xe7, 0x67, 0x72, 0x58,
+	0x9a, 0xab, 0xd4, 0x64, 0x5d, 0x6e, 0x9d, 0xd1, 0xe5, 0x73, 0xfe, 0xb1, 0x61, 0xf6, 0xe6, 0xb4,
+	0x68, 0xff, 0x9d, 0x05, 0x5d, 0xb3, 0x1d, 0x64, 0x53, 0xa1, 0x0e, 0xa4, 0x5a, 0x94, 0xae, 0x61,
+	0x01, 0x2e, 0x9f, 0x64, 0x6b, 0x55, 0x27, 0x59, 0xfd, 0xfc, 0x58, 0x3f, 0x2b, 0xc8, 0xd3, 0x78,
+	0xb3, 0x20, 0x4f, 0xb3, 0x2a, 0xc8, 0x63, 0xff, 0xc8, 0x02, 0x52, 0xe6, 0x25, 0xf2, 0x88, 0x1f,
+	0xa5, 0x43, 0x1a, 0x08, 0x9d, 0xf4, 0x7f, 0xde, 0x8c, 0x1f, 0xe5, 0xda, 0xc9, 0xaf, 0x51, 0x30,
+	0x74, 0xa5, 0xa3, 0x3b, 0x50, 0xcb, 0x6e, 0x55, 0x55, 0x21, 0xec, 0xd4, 0x38, 0x3b, 0xec, 0xd4,
+	0x3c, 0x3b, 0xec, 0xb4, 0x50, 0x0c, 0x3b, 0xd9, 0xbf, 0x6e, 0xc1, 0x5a, 0xc5, 0xa6, 0xff, 0xec,
+	0x26, 0x8e, 0xdb, 0x64, 0xe8, 0x82, 0x9a, 0xd8, 0x26, 0x1d, 0xb4, 0x7f, 0x09, 0x96, 0x0d, 0x46,
+	0xff, 0xd9, 0xf5, 0x5f, 0xf4, 0x01, 0x39, 0x9f, 0x19, 0x98, 0xfd, 0x6f, 0x35, 0x20, 0x65, 0x61,
+	0xfb, 0x1f, 0x1d, 0x43, 0x79, 0x9d, 0xea, 0x15, 0xeb, 0xf4, 0xdf, 0x6a, 0x07, 0x6e, 0xc1, 0xaa,
+	0xc8, 0x28, 0xd0, 0x02, 0x28, 0x9c, 0x63, 0xca, 0x15, 0xe8, 0x05, 0x9b, 0x31, 0xbf, 0x25, 0xe3,
+	0x26, 0x5a, 0x33, 0x86, 0x85, 0xd0, 0x9f, 0x13, 0xc0, 0x9a, 0xcb, 0x9b, 0x9b, 0xed, 0x7b, 0xc3,
+	0x17, 0xaf, 0xbb, 0xfe, 0x29, 0x78, 0x3e, 0xb5, 0x33, 0x3d, 0x9f, 0x7a, 0xc9, 0x35, 0xfe, 0x9b,
+	0x3a, 0x2c, 0xcb, 0xee, 0x0e, 0x4e, 0x29, 0x8d, 0x0d, 0x3b, 0x6b, 0x15, 0xec, 0xec, 0x15, 0xe1,
+	0xb3, 0xea, 0x3a, 0x49, 0x43, 0x70, 0x27, 0x4f, 0xf9, 0xc5, 0x0b, 0x0f, 0x1b, 0x71, 0x3b, 0x6d,
+	0x60, 0xf9, 0xf5, 0x58, 0x43, 0xbb, 0x1e, 0xc3, 0x96, 0xd1, 0x45, 0x3c, 0xf2, 0x26, 0x7e, 0x30,
+	0x13, 0x07, 0x52, 0x0d, 0x41, 0xaf, 0x09, 0x4b, 0xdc, 0x7d, 0xe5, 0x96, 0x38, 0x07, 0xf4, 0x48,
+	0xc6, 0xa2, 0x19, 0xc9, 0x70, 0xa0, 0x83, 0x4c, 0x14, 0xd0, 0x41, 0x76, 0x4a, 0xbd, 0x17, 0xe2,
+	0x9c, 0x6a, 0x60, 0xa8, 0x04, 0xe5, 0x08, 0xd3, 0x61, 0xe2, 0xc7, 0xdc, 0xc9, 0x6b, 0xb9, 0x05,
+	0xd4, 0x0c, 0xca, 0x42, 0x31, 0x28, 0x7b, 0x09, 0x5a, 0xec, 0xec, 0xc7, 0xe2, 0x10, 0x6d, 0x5e,
+	0xab, 0x00, 0xe6, 0xc0, 0xca, 0xdb, 0xea, 0x0e, 0x5f, 0x55, 0x59, 0xc6, 0x2f, 0x63, 0x8f, 0xe5,
+	0x99, 0x64, 0x2f, 0xd9, 0x69, 0xb5, 0xe5, 0xe6, 0x00, 0xbb, 0x54, 0x49, 0x0f, 0x33, 0x71, 0x3c,
+	0x65, 0xbf, 0xf1, 0x8a, 0x08, 0xb5, 0x5e, 0x8f, 0x27, 0x08, 0x1c, 0x51, 0xea, 0xc4, 0xb0, 0x6e,
+	0x72, 0x8d, 0x0a, 0xab, 0xae, 0xa4, 0xb8, 0xad, 0x03, 0x4d, 0xcd, 0x71, 0x77, 0xa3, 0x84, 0x93,
+	0x5b, 0xb0, 0xc0, 0x30, 0xe9, 0x5c, 0x48, 0x07, 0xd3, 0xe0, 0x0f, 0x57, 0xd0, 0x60, 0x3e, 0x0d,
+	0xcf, 0xa4, 0xb9, 0xcf, 0x59, 0x5e, 0xfa, 0x3f, 0x7f, 0x60, 0xc1, 0x46, 0xa1, 0x22, 0xbf, 0xdf,
+	0xe7, 0x2e, 0x8e, 0xe9, 0xf7, 0x98, 0x20, 0xca, 0x99, 0xd0, 0xf7, 0x9a, 0x9c, 0x71, 0xad, 0x58,
+	0xae, 0x40, 0x39, 0x9e, 0x86, 0x65, 0x7a, 0xce, 0xe8, 0x55, 0x55, 0xce, 0x79, 0x9e, 0xef, 0x13,
+	0xd2, 0xa0, 0x30, 0xf0, 0x23, 0xd8, 0x2c, 0x56, 0xe4, 0x17, 0x84, 0xe6, 0x90, 0x65, 0x11, 0xcf,
+	0x32, 0x86, 0x3b, 0x65, 0x8e, 0xb7, 0xb2, 0xce, 0xf9, 0x73, 0x0b, 0xc8, 0x97, 0xa7, 0x34, 0x99,
+	0xb1, 0x7b, 0x7e, 0x15, 0x91, 0x3c, 0x5f, 0x8c, 0xc6, 0xe1, 0xc5, 0xdc, 0x97, 0xe8, 0x4c, 0x66,
+	0x83, 0xd4, 0xf2, 0x6c, 0x90, 0xcb, 0x00, 0x18, 0x44, 0x50, 0xc9, 0x03, 0xec, 0x0c, 0x11, 0x4e,
+	0x27, 0xbc, 0xc1, 0xca, 0x84, 0x8d, 0xc6, 0xd9, 0x09, 0x1b, 0xcd, 0xb3, 0x12, 0x36, 0x3e, 0x84,
+	0x35, 0x63, 0xdc, 0x6a, 0x5b, 0x65, 0x1a, 0x83, 0xf5, 0x9a, 0x34, 0x86, 0x7f, 0xb7, 0xa0, 0xbe,
+	0x1b, 0xc5, 0x7a, 0xf4, 0xdd, 0x32, 0xa3, 0xef, 0xc2, 0xe7, 0x19, 0x28, 0x97, 0x46, 0x98, 0x42,
+	0x03, 0x24, 0x37, 0xa1, 0xeb, 0x4d, 0x32, 0x0c, 0x1e, 0x1d, 0x45, 0xc9, 0xa9, 0x97, 0x8c, 0xf8,
+	0x5e, 0xdf, 0xaf, 0xf5, 0x2d, 0xb7, 0x50, 0x43, 0xd6, 0xb9, 0x98, 0x34, 0x14, 0x01, 0x16, 0xf1,
+	0x80, 0xc1, 0x6e, 0xee, 0xa4, 0x9a, 0x11, 0x25, 0x64, 0x25, 0xf3, 0x7b, 0x7e, 0xe0, 0xe3, 0x2a,
+	0xbe, 0xaa, 0x0a, 0x85, 0x1a, 0x97, 0x8f, 0x91, 0x89, 0x80, 0xa5, 0x2c, 0x3b, 0xff, 0x62, 0x41,
+	0x93, 0xad, 0x00, 0x1a, 0x25, 0xce, 0xe1, 0x2a, 0xcc, 0xce, 0x66, 0xbe, 0xec, 0x16, 0x61, 0xe2,
+	0x18, 0x59, 0x53, 0x35, 0x35, 0x6c, 0x0d, 0x25, 0x57, 0xa1, 0xc5, 0x4b, 0x2a, 0x43, 0x88, 0x91,
+	0xe4, 0x20, 0xb9, 0x82, 0xf9, 0x15, 0xb1, 0xf4, 0xa2, 0x41, 0xde, 0x32, 0x45, 0xb1, 0xcb, 0xf0,
+	0x7c, 0x3c, 0xd8, 0x1e, 0x1f, 0x3c, 0xf7, 0x8d, 0x8a, 0x30, 0x2a, 0x46, 0xd5, 0xac, 0xbe, 0x18,
+	0x05, 0xd4, 0xb9, 0x09, 0xbd, 0x27, 0xd1, 0x88, 0x6a, 0x91, 0xd1, 0xb9, 0xdc, 0xec, 0xfc, 0xb2,
+	0x05, 0x4b, 0x92, 0x98, 0xdc, 0x80, 0x06, 0xba, 0xbc, 0x85, 0x03, 0xad, 0xba, 0x5d, 0x46, 0x3a,
+	0x97, 0x51, 0xa0, 0x1e, 0x67, 0x71, 0xb3, 0xfc, 0xf8, 0x23, 0xa3, 0x66, 0x0a, 0xcb, 0x87, 0x5b,
+	0x70, 0x8a, 0x0b, 0xa8, 0xf3, 0xa7, 0x16, 0x2c, 0x1b, 0x7d, 0x60, 0x90, 0x23, 0xf0, 0xd2, 0x4c,
+	0xdc, 0xd8, 0x89, 0xed, 0xd1, 0x21, 0xdd, 0xc2, 0xd4, 0x4c, 0x0b, 0xa3, 0xa2, 0xb8, 0x75, 0x3d,
+	0x8a, 0x7b, 0x17, 0x5a, 0x79, 0x6e, 0x5b, 0xc3, 0xb0, 0xfd, 0xd8, 0xa3, 0xbc, 0x37, 0xcf, 0x89,
+	0xb0, 0x9d, 0x61, 0x14, 0x44, 0x89, 0xb8, 0x2a, 0xe2, 0x05, 0xe7, 0x43, 0x68, 0x6b, 0xf4, 0x38,
+	0x8c, 0x90, 0x66, 0xa7, 0x51, 0xf2, 0x42, 0x86, 0xec, 0x45, 0x51, 0xf9, 0x07, 0xb5, 0xdc, 0x3f,
+	0x70, 0xfe, 0xd6, 0x82, 0x65, 0xe4, 0x41, 0x3f, 0x1c, 0xef, 0x47, 0x81, 0x3f, 0x9c, 0xb1, 0xbd,
+	0x97, 0xec, 0x26, 0x34, 0x83, 0xe4, 0x45, 0x13, 0x46, 0xde, 0x96, 0x31, 0x0e, 0x21, 0x88, 0xaa,
+	0x8c, 0x92, 0x8a, 0x7c, 0x7e, 0xe8, 0xa5, 0x82, 0xf9, 0x85, 0x33, 0x66, 0x80, 0x28, 0x4f, 0x08,
+	0x24, 0x5e, 0x46, 0x07, 0x13, 0x3f, 0x08, 0x7c, 0x4e, 0xcb, 0xcd, 0x7e, 0x55, 0x15, 0xf6, 0x39,
+	0xf2, 0x53, 0xef, 0x30, 0xbf, 0x0e, 0x51, 0x65, 0xe7, 0xfb, 0x35, 0x68, 0x0b, 0xf5, 0xbc, 0x33,
+	0x1a, 0x53, 0xe9, 0x8a, 0xe0, 0x61, 0x48, 0xa9, 0x12, 0x0d, 0x39, 0xd3, 0x55, 0x29, 0x6c, 0x79,
+	0xbd, 0xbc, 0xe5, 0x18, 0x22, 0x8f, 0x46, 0xf4, 0x5d, 0x76, 0x4e, 0xe3, 0xf7, 0x7c, 0x39, 0x20,
+	0x6b, 0xb7, 0x58, 0x6d, 0x33, 0xaf, 0x65, 0xc0, 0x6b, 0x6f, 0xf6, 0xde, 0x87, 0x8e, 0x68, 0x86,
+	0xed, 0x49, 0x7f, 0xd1, 0x60, 0x7e, 0x63, 0xbf, 0x5c, 0x83, 0x52, 0x7e, 0xb9, 0x25, 0xbf, 0x5c,
+	0x3a, 0xeb, 0x4b, 0x49, 0xc9, 0xb2, 0x30, 0xf8, 0xda, 0x3c, 0x4a, 0xbc, 0xf8, 0x58, 0x9a, 0xbc,
+	0x11, 0x74, 0x74, 0x98, 0xdc, 0x84, 0x26, 0x7e, 0x26, 0x35, 0x79, 0xb5, 0x40, 0x72, 0x12, 0x72,
+	0x03, 0x9a, 0x74, 0x34, 0xa6, 0xd2, 0x59, 0x20, 0x66, 0x4c, 0x08, 0xf7, 0xc8, 0xe5, 0x04, 0xa8,
+	0x1e, 0x10, 0x2d, 0xa8, 0x07, 0xd3, 0x0a, 0x60, 0x64, 0x3f, 0x7c, 0x3c, 0xc2, 0x24, 0xe1, 0x27,
+	0x9c, 0xa3, 0x35, 0x72, 0x8c, 0x4d, 0xb6, 0x35, 0x18, 0x25, 0x7d, 0x8c, 0x03, 0x1e, 0x8c, 0x7c,
+	0x6f, 0x42, 0x33, 0x9a, 0x08, 0x2e, 0x2e, 0xa0, 0x48, 0xe7, 0x9d, 0x8c, 0x07, 0xd1, 0x34, 0x1b,
+	0x8c, 0xe8, 0x38, 0xa1, 0xdc, 0x30, 0x5b, 0x6e, 0x01, 0x45, 0xba, 0x89, 0xf7, 0x52, 0xa7, 0xe3,
+	0xfc, 0x50, 0x40, 0xe5, 0xad, 0x09, 0x5f, 0xa3, 0x46, 0x7e, 0x6b, 0xc2, 0x57, 0xa4, 0xa8, 0xa3,
+	0x9a, 0x15, 0x3a, 0xea, 0x3d, 0xd8, 0xe4, 0xda, 0x48, 0xc8, 0xed, 0xa0, 0xc0, 0x26, 0x73, 0x6a,
+	0xd1, 0x8f, 0xc3, 0x31, 0x4b, 0x06, 0x4f, 0xfd, 0x6f, 0xf1, 0x38, 0xa6, 0xe5, 0x96, 0x70, 0xa4,
+	0x65, 0x01, 0x45, 0x9d, 0x96, 0xdf, 0x0b, 0x97, 0x70, 0x46, 0xeb, 0xbd, 0x34, 0x69, 0x5b, 0x82,
+	0xb6, 0x80, 0x3b, 0xcb, 0xd0, 0x3e, 0xc8, 0xa2, 0x58, 0x6e, 0x4a, 0x17, 0x3a, 0xbc, 0x28, 0xb2,
+	0x70, 0x2e, 0xc2, 0x05, 0xc6, 0x45, 0xcf, 0xa2, 0x38, 0x0a, 0xa2, 0xf1, 0xec, 0x60, 0x7a, 0xc8,
+	0x1d, 0x67, 0xbc, 0x4d, 0xf9, 0x7b, 0x0b, 0xd6, 0x8c, 0x5a, 0x11, 0xda, 0xfc, 0x0c, 0x67, 0x69,
+	0x95, 0x3e, 0xc1, 0x19, 0x6f, 0x55, 0x53, 0x95, 0x9c, 0x90, 0x87, 0x9c, 0xf9, 0xef, 0x94, 0xdc,
+	0x83, 0x9e, 0x1c, 0x99, 0xfc, 0x90, 0x73, 0x61, 0xbf, 0xcc, 0x85, 0xe2, 0xfb, 0xae, 0xf8, 0x40,
+	0x36, 0xf1, 0xff, 0xc4, 0xfd, 0xfa, 0x88, 0xcd, 0x51, 0xc6, 0xb8, 0xd4, 0x0d, 0xaa, 0x7e, 0xd2,
+	0x95, 0x23, 0x18, 0x2a, 0x30, 0x75, 0x7e, 0xd3, 0x02, 0xc8, 0x47, 0x87, 0x8c, 0x91, 0xab, 0x7b,
+	0x9e, 0xf2, 0x9f, 0x03, 0x78, 0x2f, 0xa4, 0xee, 0xfe, 0x72, 0x0b, 0xd2, 0x96, 0x18, 0x3a, 0x79,
+	0xd7, 0xa1, 0x37, 0x0e, 0xa2, 0x43, 0x66, 0x7e, 0x59, 0x5a, 0x57, 0x2a, 0x72, 0x91, 0xba, 0x1c,
+	0x7e, 0x28, 0xd0, 0xdc, 0xdc, 0x34, 0x34, 0x73, 0xe3, 0x7c, 0xbb, 0x06, 0xab, 0xa5, 0x39, 0xcf,
+	0x95, 0x32, 0xb2, 0x55, 0x52, 0x8e, 0x73, 0x2e, 0x68, 0x58, 0x34, 0x77, 0xff, 0xcc, 0x60, 0xd3,
+	0x87, 0xd0, 0x4d, 0xb8, 0xf6, 0x91, 0xaa, 0xa9, 0xf1, 0x1a, 0xd5, 0xb4, 0x9c, 0xe8, 0x45, 0xbc,
+	0x0c, 0xf7, 0x46, 0x27, 0x34, 0xc9, 0x7c, 0x76, 0xdc, 0x67, 0x0e, 0x01, 0x57, 0xa8, 0x3d, 0x0d,
+	0x67, 0x76, 0xfa, 0x3a, 0xf4, 0x44, 0xfe, 0x97, 0xa2, 0x14, 0x39, 0xcb, 0x39, 0x8c, 0x84, 0xce,
+	0x1f, 0xc9, 0xcb, 0x29, 0x73, 0x0f, 0xe7, 0xaf, 0x88, 0x3e, 0xbb, 0x5a, 0x61, 0x76, 0x9f, 0x12,
+	0x17, 0x45, 0x23, 0x19, 0x53, 0xa8, 0x6b, 0xb9, 0x18, 0x23, 0x71, 0xb1, 0x67, 0x2e, 0x69, 0xe3,
+	0x4d, 0x96, 0x14, 0x83, 0xfd, 0x8b, 0xbb, 0x51, 0xbc, 0x2b, 0xb2, 0x52, 0x98, 0x20, 0xa8, 0xec,
+	0x4a, 0x59, 0x7c, 0x4d, 0xbe, 0x4a, 0xa5, 0x1d, 0x5e, 0x2e, 0xda, 0xe1, 0xff, 0x0f, 0x17, 0x11,
+	0x88, 0x93, 0x28, 0x8e, 0x12, 0x14, 0x46, 0x2f, 0xe0, 0x46, 0x37, 0x0a, 0xb3, 0x63, 0xa9, 0xc6,
+	0x5e, 0x47, 0xc2, 0x8e, 0x64, 0x78, 0x94, 0xe0, 0x8e, 0xb2, 0xf0, 0x1b, 0xb8, 0x76, 0x2b, 0x57,
+	0x38, 0x9f, 0x83, 0x16, 0x73, 0x7c, 0xd9, 0xb4, 0x6e, 0x41, 0xeb, 0x38, 0x8a, 0x07, 0xc7, 0x7e,
+	0x98, 0x49, 0xe1, 0xee, 0xe6, 0x1e, 0xe9, 0x2e, 0x5b, 0x10, 0x45, 0xe0, 0xfc, 0x5e, 0x13, 0x16,
+	0x1f, 0x87, 0x27, 0x91, 0x3f, 0x64, 0xf7, 0x58, 0x13, 0x3a, 0x89, 0x64, 0xc0, 0x03, 0x7f, 0xe3,
+	0x52, 0xb0, 0xbc, 0xab, 0x38, 0x13, 0x17, 0x51, 0xb2, 0x88, 0xe6, 0x3e, 0xc9, 0xf3, 0xc1, 0xb9,
+	0xe8, 0x68, 0x08, 0x3a, 0xfd, 0x89, 0x9e, 0x3a, 0x2f, 0x4a, 0x79, 0x34, 0xa2, 0xa9, 0x47, 0x23,
+	0xf0, 0xd6, 0x93, 0x67, 0xd0, 0xf4, 0x17, 0xc4, 0xad, 0x27, 0x2f, 0xb2, 0x43, 0x4a, 0x42, 0x79,
+	0x24, 0x92, 0x39, 0x0e, 0x8b, 0xe2, 0x90, 0xa2, 0x83, 0xe8, 0x5c, 0xf0, 0x0f, 0x38, 0x0d, 0x57,
+	0xbe, 0x3a, 0x84, 0x8e, 0x58, 0x31, 0xfb, 0x9e, 0x07, 0x1d, 0x8a, 0x30, 0x6a, 0xe8, 0x11, 0x55,
+	0x8a, 0x94, 0xcf, 0x01, 0x78, 0xbe, 0x7b, 0x11, 0xd7, 0x8e, 0x36, 0x3c, 0x35, 0x4e, 0x94, 0x18,
+	0xa3, 0x78, 0x41, 0x70, 0xe8, 0x0d, 0x5f, 0xb0, 0xc7, 0x15, 0x22, 0x04, 0x61, 0x82, 0x38, 0x6a,
+	0x6d, 0x37, 0x59, 0x24, 0xa2, 0xe1, 0xea, 0x10, 0xd9, 0x82, 0x36, 0x3b, 0xce, 0x89, 0xfd, 0xec,
+	0xb2, 0xfd, 0x5c, 0xd1, 0xcf, 0x7b, 0x6c, 0x47, 0x75, 0x22, 0xfd, 0x6e, 0xad, 0x67, 0xde, 0xad,
+	0x71, 0xa5, 0x29, 0x62, 0x3a, 0x2b, 0xac, 0xb7, 0x1c, 0x60, 0x91, 0x1b, 0xbe, 0x60, 0x9c, 0x60,
+	0x95, 0x11, 0x18, 0x18, 0xb9, 0x02, 0x4b, 0x78, 0x08, 0x89, 0x3d, 0x7f, 0xd4, 0x27, 0xea, 0x2c,
+	0xa4, 0x30, 0x6c, 0x43, 0xfe, 0x66, 0x57, 0x87, 0x6b, 0x6c, 0x55, 0x0c, 0x0c, 0xd7, 0x46, 0x95,
+	0x99, 0x10, 0xad, 0xf3, 0x1d, 0x35, 0x40, 0x27, 0x03, 0x72, 0x6f, 0x34, 0x12, 0xbc, 0xa9, 0x8e,
+	0xbe, 0x39, 0x57, 0x59, 0x06, 0x57, 0x55, 0xec, 0x6e, 0xad, 0x7a, 0x77, 0x5f, 0xbb, 0x06, 0xce,
+	0x0e, 0xb4, 0xf7, 0xb5, 0x07, 0x06, 0x8c, 0xc9, 0xe5, 0xd3, 0x02, 0x21, 0x18, 0x1a, 0xa2, 0x0d,
+	0xa7, 0xa6, 0x0f, 0xc7, 0xf9, 0x63, 0x0b, 0x08, 0x66, 0xbc, 0xa8, 0xe1, 0xf3, 0xbe, 0x1d, 0xe8,
+	0xa8, 0x00, 0x45, 0x9e, 0x15, 0x68, 0x60, 0x48, 0xc3, 0x86, 0x32, 0x88, 0x8e, 0x8e, 0x52, 0x2a,
+	0x33, 0x7e, 0x0c, 0x0c, 0x39, 0x14, 0x7d, 0x1c, 0xf4, 0x17, 0x7c, 0xde, 0x43, 0x2a, 0x32, 0x7f,
+	0x4a, 0x38, 0xea, 0xd9, 0x84, 0x62, 0x8a, 0x85, 0x12, 0x2d, 0x55, 0x56, 0xc9, 0x8b, 0xc5, 0x55,
+	0xbe, 0x89, 0xb7, 0x85, 0xa2, 0x5d, 0x53, 0x85, 0x48, 0x4a, 0x55, 0x8f, 0xaa, 0x8a, 0xf9, 0xf0,
+	0xc6, 0xa0, 0xb9, 0xda, 0x2c, 0x57, 0xe0, 0xd5, 0xf5, 0x91, 0x9f, 0x14, 0xc9, 0xeb, 0x8c, 0xbc,
+	0xa2, 0xc6, 0x79, 0x0e, 0x6b, 0xa2, 0x4b, 0xdd, 0xb9, 0x31, 0x37, 0xd1, 0x3a, 0x8b, 0x91, 0x6b,
+	0x65, 0x46, 0x76, 0xbe, 0x6f, 0xc1, 0xa2, 0xd8, 0x69, 0xb6, 0x2d, 0xc5, 0x97, 0x26, 0x2d, 0xd7,
+	0xc0, 0xaa, 0xdf, 0x18, 0x94, 0x95, 0x53, 0xbd, 0x4a, 0x39, 0x61, 0x40, 0xd1, 0xcb, 0x8e, 0xd9,
+	0xa9, 0x14, 0x03, 0x8a, 0x5e, 0x76, 0x2c, 0x03, 0x8a, 0x4d, 0x15, 0x50, 0xac, 0x7c, 0x66, 0xc3,
+	0x6d, 0x6d, 0x09, 0x77, 0x36, 0xf8, 0xbe, 0x89, 0x09, 0xa8, 0x9b, 0x50, 0x91, 0xea, 0x99, 0xc3,
+	0xf9, 0x7e, 0x8a, 0x26, 0x8a, 0xfb, 0x29, 0x48, 0x5d, 0x55, 0x8f, 0xd9, 0xfc, 0x0f, 0x68, 0x40,
+	0x33, 0x7a, 0x2f, 0x08, 0x8a, 0xed, 0x5f, 0x84, 0x0b, 0x15, 0x75, 0xc2, 0x1b, 0x7d, 0x08, 0xab,
+	0x0f, 0xe8, 0xe1, 0x74, 0xbc, 0x47, 0x4f, 0xf2, 0x64, 0x06, 0x02, 0x8d, 0xf4, 0x38, 0x3a, 0x15,
+	0x9c, 0xce, 0x7e, 0x63, 0x30, 0x2d, 0x40, 0x9a, 0x41, 0x1a, 0xd3, 0xa1, 0xcc, 0xae, 0x67, 0xc8,
+	0x41, 0x4c, 0x87, 0xce, 0x7b, 0x40, 0xf4, 0x76, 0xc4, 0x14, 0x50, 0xc1, 0x4f, 0x0f, 0x07, 0xe9,
+	0x2c, 0xcd, 0xe8, 0x44, 0x3e, 0x1b, 0xd0, 0x21, 0xe7, 0x3a, 0x74, 0xf6, 0x3d, 0x7c, 0x9d, 0x22,
+	0x1e, 0xfb, 0x60, 0x40, 0xc4, 0x9b, 0xa1, 0xdc, 0xab, 0x80, 0x08, 0xab, 0x76, 0xfe, 0xa3, 0x06,
+	0x0b, 0x9c, 0x12, 0x5b, 0x1d, 0xd1, 0x34, 0xf3, 0x43, 0x7e, 0x55, 0x2f, 0x5a, 0xd5, 0xa0, 0x12,
+	0x6f, 0xd4, 0x2a, 0x78, 0x43, 0x1c, 0x43, 0x64, 0xa6, 0xb2, 0x60, 0x02, 0x03, 0x43, 0x8e, 0xcd,
+	0x13, 0xa4, 0xf8, 0x89, 0x3c, 0x07, 0x0a, 0x11, 0xb2, 0xdc, 0x8c, 0xf0, 0xf1, 0x49, 0xb6, 0x17,
+	0xec, 0xa0, 0x43, 0x95, 0xc6, 0x8a, 0x47, 0xe4, 0x4b, 0x78, 0xd9, 0x28, 0x2d, 0xbd, 0x81, 0x51,
+	0xe2, 0x67, 0x93, 0xd7, 0x19, 0x25, 0x78, 0x03, 0xa3, 0x84, 0x69, 0x81, 0x0f, 0x29, 0x75, 0x29,
+	0xba, 0x3b, 0x92, 0x9d, 0xbe, 0x63, 0xc1, 0x8a, 0xf0, 0xd4, 0x54, 0x1d, 0x79, 0xdb, 0x70, 0xeb,
+	0x2a, 0xf3, 0x89, 0xaf, 0xc1, 0x32, 0x73, 0xb6, 0x54, 0x28, 0x50, 0xc4, 0x2d, 0x0d, 0x10, 0xe7,
+	0x21, 0x43, 0xed, 0x13, 0x3f, 0x10, 0x9b, 0xa2, 0x43, 0x32, 0x9a, 0x98, 0x78, 0x22, 0x87, 0xc9,
+	0x72, 0x55, 0xd9, 0xf9, 0x0b, 0x0b, 0x56, 0xb5, 0x01, 0x0b, 0x2e, 0xfc, 0x10, 0x64, 0x02, 0x15,
+	0x8f, 0x18, 0x72, 0x61, 0x3a, 0x6f, 0x7a, 0x9d, 0xf9, 0x67, 0x06, 0x31, 0xdb, 0x4c, 0x6f, 0xc6,
+	0x06, 0x98, 0x4e, 0x27, 0x42, 0x2b, 0xe9, 0x10, 0xbb, 0xcd, 0xa1, 0xf4, 0x85, 0x22, 0xe1, 0x7a,
+	0xd1, 0xc0, 0x70, 0xf2, 0x13, 0x74, 0x12, 0x15, 0x11, 0x37, 0x10, 0x26, 0xe8, 0xfc, 0xa3, 0x05,
+	0x6b, 0xdc, 0xdb, 0x17, 0x67, 0x29, 0xf5, 0xd8, 0x63, 0x81, 0x1f, 0x6f, 0xb8, 0x44, 0xee, 0x9e,
+	0x73, 0x45, 0x99, 0x7c, 0xf6, 0x0d, 0x4f, 0x28, 0x2a, 0x2f, 0x6a, 0xce, 0x5e, 0xd4, 0xab, 0xf6,
+	0xe2, 0x35, 0x2b, 0x5d, 0x15, 0x21, 0x6b, 0x56, 0x46, 0xc8, 0xf0, 0xcd, 0x67, 0x3a, 0x8c, 0x62,
+	0x8a, 0x37, 0x21, 0xe6, 0xe4, 0x84, 0x0a, 0xfa, 0xae, 0x05, 0xfd, 0x87, 0x3c, 0x5e, 0x8c, 0x77,
+	0x7d, 0x7e, 0x9a, 0x45, 0x89, 0x7a, 0xdd, 0x76, 0x05, 0x20, 0xcd, 0xbc, 0x24, 0xe3, 0xf7, 0x45,
+	0x22, 0x7e, 0x95, 0x23, 0x38, 0x46, 0x1a, 0x8e, 0x78, 0x2d, 0xdf, 0x1b, 0x55, 0x2e, 0x19, 0x65,
+	0x71, 0x1e, 0xd1, 0x31, 0x0c, 0x69, 0x48, 0xe3, 0x4b, 0x4f, 0x98, 0xaa, 0xe5, 0x8e, 0x7e, 0x01,
+	0x75, 0xfe, 0xcc, 0x82, 0x5e, 0x3e, 0xc8, 0x1d, 0x04, 0x4d, 0xed, 0x20, 0xec, 0x99, 0x02, 0x54,
+	0x64, 0xcd, 0x47, 0x03, 0x27, 0xc6, 0xa6, 0x21, 0x4c, 0x62, 0x45, 0x29, 0x9a, 0x4a, 0x8f, 0x41,
+	0x87, 0x78, 0x8a, 0x0f, 0x9a, 0x56, 0xe1, 0x26, 0x88, 0x12, 0x4b, 0x3b, 0x9e, 0x64, 0xec, 0xab,
+	0x05, 0x7e, 0xd2, 0x11, 0x45, 0x69, 0x9f, 0x16, 0x19, 0x8a, 0x3f, 0x9d, 0xdf, 0xb2, 0xe0, 0x42,
+	0xc5, 0xe2, 0x0a, 0xc9, 0x78, 0x00, 0xab, 0x47, 0xaa, 0x52, 0x2e, 0x00, 0x17, 0x8f, 0x4d, 0x79,
+	0xc1, 0x61, 0x4e, 0xda, 0x2d, 0x7f, 0xa0, 0x9c, 0x09, 0xbe, 0xa4, 0x46, 0xee, 0x5c, 0xb9, 0x62,
+	0xeb, 0xb7, 0xeb, 0xd0, 0xe5, 0x17, 0x5f, 0xfc, 0x9d, 0x39, 0x4d, 0xc8, 0x47, 0xb0, 0x28, 0xfe,
+	0x27, 0x80, 0x6c, 0x88, 0x6e, 0xcd, 0x7f, 0x26, 0xb0, 0x37, 0x8b, 0xb0, 0xe0, 0x9d, 0xb5, 0x5f,
+	0xfd, 0xc1, 0x3f, 0xff, 0x4e, 0x6d, 0x99, 0xb4, 0xef, 0x9c, 0xbc, 0x7b, 0x67, 0x4c, 0xc3, 0x14,
+	0xdb, 0xf8, 0x05, 0x80, 0xfc, 0x05, 0x3d, 0xe9, 0x2b, 0x27, 0xa8, 0xf0, 0xd7, 0x00, 0xf6, 0x85,
+	0x8a, 0x1a, 0xd1, 0xee, 0x05, 0xd6, 0xee, 0x9a, 0xd3, 0xc5, 0x76, 0xfd, 0xd0, 0xcf, 0xf8, 0x73,
+	0xfa, 0x0f, 0xac, 0x9b, 0x64, 0x04, 0x1d, 0xfd, 0x81, 0x3c, 0x91, 0xb1, 0x90, 0x8a, 0xe7, 0xf9,
+	0xf6, 0xc5, 0xca, 0x3a, 0x19, 0x08, 0x62, 0x7d, 0x6c, 0x38, 0x2b, 0xd8, 0xc7, 0x94, 0x51, 0xe4,
+	0xbd, 0x04, 0xd0, 0x35, 0xdf, 0xc1, 0x93, 0x4b, 0x9a, 0x58, 0x97, 0x5e, 0xe1, 0xdb, 0x97, 0xe7,
+	0xd4, 0x8a, 0xbe, 0x2e, 0xb3, 0xbe, 0xce, 0x3b, 0x04, 0xfb, 0x1a, 0x32, 0x1a, 0xf9, 0x0a, 0xff,
+	0x03, 0xeb, 0xe6, 0xd6, 0x8f, 0xae, 0x40, 0x4b, 0x45, 0x2f, 0xc9, 0x37, 0x60, 0xd9, 0xb8, 0x99,
+	0x24, 0x72, 0x1a, 0x55, 0x17, 0x99, 0xf6, 0xa5, 0xea, 0x4a, 0xd1, 0xf1, 0x15, 0xd6, 0x71, 0x9f,
+	0x6c, 0x62, 0xc7, 0xe2, 0x6a, 0xef, 0x0e, 0xcb, 0x1b, 0xe0, 0x49, 0xcd, 0x2f, 0xa0, 0x6b, 0xde,
+	0x26, 0x1a, 0xf3, 0x2c, 0xdd, 0x3e, 0xda, 0x97, 0xe7, 0xd4, 0x8a, 0xee, 0x2e, 0xb1, 0xee, 0x36,
+	0xc9, 0xba, 0xde, 0x9d, 0x8a, 0x2a, 0x52, 0x96, 0x86, 0xae, 0x3f, 0x93, 0x27, 0x97, 0x15, 0x63,
+	0x55, 0x3d, 0x9f, 0x57, 0x2c, 0x52, 0x7e, 0x43, 0xef, 0xf4, 0x59, 0x57, 0x84, 0xb0, 0xed, 0xd3,
+	0x5f, 0xc9, 0x93, 0xaf, 0x41, 0x4b, 0xbd, 0x09, 0x25, 0xe7, 0xb5, 0x87, 0xb8, 0xfa, 0x43, 0x55,
+	0xbb, 0x5f, 0xae, 0xa8, 0x62, 0x0c, 0xbd, 0x65, 0x64, 0x8c, 0x3d, 0xd8, 0x10, 0x4e, 0xf5, 0x21,
+	0xfd, 0x71, 0x66, 0x52, 0xf1, 0xb8, 0xff, 0xae, 0x45, 0x3e, 0x84, 0x25, 0xf9, 0xd4, 0x96, 0x6c,
+	0x56, 0x3f, 0x19, 0xb6, 0xcf, 0x97, 0x70, 0xa1, 0x3d, 0xee, 0x01, 0xe4, 0xcf, 0x44, 0x95, 0x9c,
+	0x95, 0x1e, 0xaf, 0xda, 0x17, 0x2a, 0x6a, 0x44, 0x13, 0x63, 0x58, 0x2d, 0xbd, 0x42, 0x25, 0x6f,
+	0xe5, 0xf4, 0x95, 0xef, 0x53, 0x5f, 0xd3, 0xa0, 0xb3, 0xc9, 0xd6, 0x6e, 0x85, 0x30, 0xc1, 0x0d,
+	0xe9, 0xa9, 0x7c, 0x90, 0xf1, 0x00, 0xda, 0xda, 0xd3, 0x53, 0x22, 0x5b, 0x28, 0x3f, 0x5b, 0xb5,
+	0xed, 0xaa, 0x2a, 0x31, 0xdc, 0x2f, 0xc2, 0xb2, 0xf1, 0x86, 0x54, 0x49, 0x46, 0xd5, 0x0b, 0x55,
+	0xfb, 0x52, 0x75, 0xa5, 0x68, 0xeb, 0xab, 0xd0, 0xd6, 0x5e, 0x7c, 0x12, 0x2d, 0xd5, 0xb4, 0xf0,
+	0xd6, 0xd3, 0xb6, 0xab, 0xaa, 0xc4, 0x7c, 0xd7, 0xd9, 0x7c, 0xbb, 0x4e, 0x0b, 0xe7, 0xcb, 0x5e,
+	0x25, 0x20, 0x93, 0x7c, 0x03, 0xba, 0xe6, 0x1b, 0x50, 0x25, 0x55, 0x95, 0xaf, 0x49, 0xed, 0xcb,
+	0x73, 0x6a, 0x4d, 0x86, 0xbc, 0xb9, 0xa6, 0x3a, 0xb9, 0xf3, 0x89, 0xb8, 0xd7, 0x7b, 0x45, 0xbe,
+	0x0c, 0x2d, 0xf5, 0x4c, 0x84, 0xe4, 0x2f, 0x5f, 0xcd, 0xc7, 0x24, 0x76, 0xbf, 0x5c, 0x21, 0x1a,
+	0x5f, 0x65, 0x8d, 0xb7, 0x49, 0x3e, 0x03, 0x6e, 0x0f, 0xd8, 0x73, 0x11, 0xcd, 0x1e, 0xe8, 0x2f,
+	0x4a, 0xec, 0xcd, 0x22, 0x5c, 0x6d, 0x0f, 0x32, 0x1f, 0xdb, 0x08, 0xa1, 0x57, 0xc8, 0xb5, 0x52,
+	0xc2, 0x52, 0x9d, 0x9c, 0x6a, 0x5f, 0x79, 0x7d, 0x8a, 0x96, 0xa9, 0x66, 0xa4, 0x7a, 0xb9, 0x23,
+	0x73, 0x89, 0x7f, 0x11, 0x3a, 0xfa, 0xdb, 0x3d, 0x65, 0x21, 0x2a, 0x5e, 0x1c, 0xda, 0x17, 0x2b,
+	0xeb, 0xcc, 0xcd, 0x25, 0x1d, 0xbd, 0x1b, 0xdc, 0x5c, 0xf3, 0xa9, 0x53, 0xae, 0x32, 0xab, 0xde,
+	0x70, 0xd9, 0x97, 0xe7, 0xd4, 0x9a, 0x9b, 0x4b, 0xd6, 0x8c, 0xb9, 0xf0, 0xa0, 0x2d, 0x49, 0x81,
+	0xec, 0xbc, 0xe4, 0xce, 0x74, 0x9e, 0x35, 0xa3, 0x26, 0x54, 0x91, 0x80, 0x65, 0x5f, 0xac, 0xac,
+	0x13, 0x7d, 0x5d, 0x63, 0x7d, 0x5d, 0x71, 0x2e, 0x18, 0x7d, 0xc9, 0x7f, 0xa0, 0x89, 0xbd, 0xe1,
+	0x0b, 0xe4, 0xde, 0xaf, 0x42, 0x4f, 0xcb, 0x9e, 0x3c, 0x98, 0x85, 0x43, 0x25, 0x1d, 0xe5, 0xcc,
+	0x7b, 0xbb, 0xca, 0xdd, 0x75, 0xce, 0xb3, 0x8e, 0x56, 0x1d, 0x63, 0xe5, 0xb0, 0xed, 0x6d, 0x68,
+	0x6b, 0x6d, 0xbc, 0xae, 0xdd, 0xf3, 0x5a, 0x95, 0x9e, 0x66, 0x7e, 0xd7, 0x22, 0xbf, 0x8f, 0xff,
+	0x35, 0xa1, 0xe7, 0x39, 0x1a, 0xf7, 0x21, 0x85, 0x76, 0xfa, 0x7a, 0x9d, 0xde, 0x90, 0xe3, 0xb2,
+	0x41, 0xee, 0xdd, 0xfc, 0xa2, 0xb1, 0x1a, 0x9f, 0x18, 0xc7, 0xa6, 0xdb, 0xc5, 0xff, 0x9d, 0x78,
+	0x55, 0x24, 0xd0, 0x5f, 0x27, 0xbc, 0xba, 0x6b, 0x91, 0x0f, 0xf8, 0x3f, 0xab, 0xc8, 0x30, 0x09,
+	0xd1, 0xb4, 0x77, 0x71, 0xc9, 0xf4, 0xbf, 0x15, 0xb9, 0x61, 0xdd, 0xb5, 0xc8, 0xd7, 0xa1, 0xa7,
+	0x7d, 0xcb, 0x56, 0xfe, 0x4d, 0xbf, 0x9f, 0xb3, 0xb7, 0x45, 0xf3, 0x75, 0x0f, 0xda, 0xda, 0xbf,
+	0x86, 0xe4, 0x7a, 0xb8, 0xf4, 0x4f, 0x22, 0xf3, 0x07, 0x39, 0x81, 0x9e, 0x46, 0x6e, 0xb0, 0xc7,
+	0x1b, 0x36, 0xe3, 0xdc, 0x64, 0x63, 0xbd, 0xe6, 0xbc, 0x35, 0x77, 0xac, 0x77, 0xd8, 0x31, 0x18,
+	0x47, 0xbc, 0x0f, 0x90, 0x87, 0x34, 0x49, 0x21, 0xa4, 0xa6, 0x4c, 0x51, 0x39, 0xea, 0x69, 0xf2,
+	0xa0, 0x8c, 0xbc, 0x61, 0x8b, 0x5f, 0xe3, 0xfa, 0x41, 0xd0, 0xa7, 0x6a, 0xf4, 0xe5, 0xd8, 0xa3,
+	0x6d, 0x57, 0x55, 0x55, 0x69, 0x07, 0xd9, 0x3e, 0xf9, 0x18, 0x96, 0xf7, 0xa2, 0xe8, 0xc5, 0x34,
+	0x96, 0x23, 0x26, 0x66, 0xd0, 0x08, 0x23, 0xa4, 0x76, 0x61, 0x16, 0xce, 0x55, 0xd6, 0x94, 0x4d,
+	0xfa, 0x5a, 0x53, 0x77, 0x3e, 0xc9, 0x43, 0xa6, 0xaf, 0x88, 0x07, 0xab, 0xca, 0xed, 0x50, 0x03,
+	0xb7, 0xcd, 0x66, 0xf4, 0x60, 0x5f, 0xa9, 0x0b, 0xc3, 0x11, 0x94, 0xa3, 0xbd, 0x93, 0xca, 0x36,
+	0xef, 0x5a, 0x64, 0x1f, 0x3a, 0x0f, 0xe8, 0x30, 0x1a, 0x51, 0x11, 0xe6, 0x59, 0xcb, 0x07, 0xae,
+	0xe2, 0x43, 0xf6, 0xb2, 0x01, 0x9a, 0x8a, 0x38, 0xf6, 0x66, 0x09, 0xfd, 0xe6, 0x9d, 0x4f, 0x44,
+	0x00, 0xe9, 0x95, 0x54, 0xc4, 0x62, 0xe6, 0xa6, 0x22, 0x2e, 0x44, 0xc9, 0xec, 0x8b, 0x95, 0x75,
+	0x55, 0x4b, 0x2d, 0x83, 0x6e, 0x24, 0x80, 0xd5, 0x52, 0x60, 0x4d, 0x39, 0x2f, 0xf3, 0xc2, 0x71,
+	0xf6, 0xd5, 0xf9, 0x04, 0x66, 0x6f, 0x37, 0xcd, 0xde, 0x0e, 0x60, 0xf9, 0x01, 0xe5, 0x8b, 0xc5,
+	0xb3, 0x10, 0x0a, 0xcf, 0x58, 0xf5, 0x8c, 0x05, 0x7b, 0xad, 0xa2, 0xce, 0xb4, 0xb4, 0x2c, 0x05,
+	0x80, 0x7c, 0x0d, 0xda, 0x8f, 0x68, 0x26, 0xd3, 0x0e, 0x94, 0x0b, 0x58, 0xc8, 0x43, 0xb0, 0x2b,
+	0xb2, 0x16, 0x4c, 0x9e, 0x61, 0xad, 0xdd, 0xc1, 0x3c, 0x06, 0xae, 0x9e, 0x06, 0xfe, 0xe8, 0x15,
+	0xf9, 0x39, 0xd6, 0xb8, 0xca, 0x62, 0xda, 0xd4, 0x6e, 0xab, 0xf5, 0xc6, 0x7b, 0x05, 0xbc, 0xaa,
+	0xe5, 0x30, 0x1a, 0x51, 0xcd, 0xe7, 0x08, 0xa1, 0xad, 0xa5, 0xd8, 0x29, 0x01, 0x2a, 0xa7, 0x0b,
+	0xda, 0x76, 0x55, 0x95, 0x58, 0xe7, 0x1b, 0xac, 0x1f, 0x87, 0x5c, 0xcd, 0xfb, 0xe1, 0x59, 0x78,
+	0x79, 0x4f, 0x77, 0x3e, 0xf1, 0x26, 0xd9, 0x2b, 0xf2, 0x9c, 0x3d, 0x69, 0xd5, 0x53, 0x2b, 0x72,
+	0x17, 0xb4, 0x98, 0x85, 0x61, 0x93, 0x72, 0x95, 0xe9, 0x96, 0xf2, 0xae, 0x98, 0x6b, 0xf2, 0x59,
+	0x00, 0x4c, 0x0e, 0x78, 0xe0, 0xd1, 0x49, 0x14, 0xe6, 0xba, 0x36, 0x4f, 0x1f, 0xb0, 0xd7, 0x0c,
+	0x4c, 0xf8, 0x8e, 0xcf, 0xb5, 0x43, 0x80, 0xbe, 0xc5, 0x44, 0x32, 0xd7, 0xdc, 0x0c, 0x03, 0xdb,
+	0xae, 0xa2, 0x50, 0x96, 0xed, 0x1e, 0x40, 0x1e, 0xc6, 0x55, 0x2e, 0x7d, 0x29, 0x42, 0x6c, 0x5f,
+	0xa8, 0xa8, 0x11, 0x63, 0xdb, 0x87, 0x56, 0x1e, 0x17, 0x3c, 0x9f, 0xa7, 0x49, 0x1a, 0x51, 0x44,
+	0xbb, 0x5f, 0xae, 0x10, 0xbb, 0xb2, 0xc2, 0x96, 0x0a, 0xc8, 0x12, 0x2e, 0x15, 0x0b, 0xc1, 0xf9,
+	0xb0, 0xc6, 0x07, 0xa8, 0x4c, 0x3c, 0xbb, 0x10, 0x97, 0x33, 0xa9, 0x88, 0x98, 0xd9, 0x17, 0x2b,
+	0xeb, 0xaa, 0x0e, 0xf7, 0xc8, 0xad, 0xfc, 0x32, 0x1e, 0x55, 0xf3, 0x04, 0x56, 0x4b, 0xd1, 0x12,
+	0x25, 0xd2, 0xf3, 0x82, 0x54, 0xf6, 0xd5, 0xf9, 0x04, 0xa2, 0xcb, 0x0d, 0xd6, 0x65, 0xcf, 0x01,
+	0xec, 0x32, 0x3d, 0xf5, 0xb3, 0xe1, 0xf1, 0x07, 0xd6, 0xcd, 0xc3, 0x05, 0xf6, 0x4f, 0x8c, 0x9f,
+	0xfe, 0xaf, 0x01, 0x00, 0xd4, 0x28, 0xee, 0x1b, 0xbb, 0x51, 0x00, 0x00,
 }
@@ -0,0 +1,144 @@
+package lnwallet
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// BestBlockCache is a BlockChainIO that serves the tip of the main chain from
+// memory, rather than querying the backend on each call to GetBestBlock. The
+// tip is kept up to date through the block epochs of a ChainNotifier. All
+// other queries are passed through to the wrapped BlockChainIO. As the cache
+// can be shared by all subsystems, looking up the current height no longer
+// requires a round trip to a remote backend.
+type BestBlockCache struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	BlockChainIO
+
+	notifier chainntnfs.ChainNotifier
+
+	// bestHash and bestHeight are the tip of the main chain, as of the
+	// latest block epoch. bestHash is nil until the cache is started.
+	bestHash   *chainhash.Hash
+	bestHeight int32
+	mu         sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile time check to ensure BestBlockCache implements the BlockChainIO
+// interface.
+var _ BlockChainIO = (*BestBlockCache)(nil)
+
+// NewBestBlockCache creates a new BestBlockCache wrapping the passed
+// BlockChainIO, whose tip is updated using the block epochs of the passed
+// notifier.
+func NewBestBlockCache(chainIO BlockChainIO,
+	notifier chainntnfs.ChainNotifier) *BestBlockCache {
+
+	return &BestBlockCache{
+		BlockChainIO: chainIO,
+		notifier:     notifier,
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start queries the current tip of the chain, and launches the goroutine that
+// keeps it up to date. The notifier MUST be started beforehand.
+func (c *BestBlockCache) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	bestHash, bestHeight, err := c.BlockChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	c.setBestBlock(bestHash, bestHeight)
+
+	// We'll pass in the tip we just queried, such that any block
+	// connected in the meantime is delivered as well.
+	blockEpochs, err := c.notifier.RegisterBlockEpochNtfn(
+		&chainntnfs.BlockEpoch{
+			Hash:   bestHash,
+			Height: bestHeight,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.updateBestBlock(blockEpochs)
+
+	return nil
+}
+
+// Stop signals the cache to stop tracking the tip of the chain, and waits
+// for it to do so.
+func (c *BestBlockCache) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	close(c.quit)
+	c.wg.Wait()
+
+	return nil
+}
+
+// GetBestBlock returns the tip of the main chain as of the latest block
+// epoch. Until the cache is started, the query is passed through to the
+// wrapped BlockChainIO.
+//
+// NOTE: This is part of the BlockChainIO interface.
+func (c *BestBlockCache) GetBestBlock() (*chainhash.Hash, int32, error) {
+	c.mu.RLock()
+	bestHash, bestHeight := c.bestHash, c.bestHeight
+	c.mu.RUnlock()
+
+	if bestHash == nil {
+		return c.BlockChainIO.GetBestBlock()
+	}
+
+	return bestHash, bestHeight, nil
+}
+
+// setBestBlock updates the cached tip of the main chain.
+func (c *BestBlockCache) setBestBlock(hash *chainhash.Hash, height int32) {
+	c.mu.Lock()
+	c.bestHash = hash
+	c.bestHeight = height
+	c.mu.Unlock()
+}
+
+// updateBestBlock updates the cached tip of the main chain upon each block
+// epoch.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *BestBlockCache) updateBestBlock(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer c.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			c.setBestBlock(epoch.Hash, epoch.Height)
+
+		case <-c.quit:
+			return
+		}
+	}
+}
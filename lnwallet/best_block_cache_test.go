@@ -0,0 +1,101 @@
+package lnwallet
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// mockBestBlockChainIO is a BlockChainIO that counts the queries of the tip
+// of the chain.
+type mockBestBlockChainIO struct {
+	BlockChainIO
+
+	queries uint32 // To be used atomically.
+}
+
+func (m *mockBestBlockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	atomic.AddUint32(&m.queries, 1)
+	return &chainhash.Hash{0x01}, 100, nil
+}
+
+// mockEpochNotifier is a ChainNotifier that only delivers block epochs.
+type mockEpochNotifier struct {
+	chainntnfs.ChainNotifier
+
+	epochs chan *chainntnfs.BlockEpoch
+}
+
+func (m *mockEpochNotifier) RegisterBlockEpochNtfn(
+	*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochs,
+		Cancel: func() {},
+	}, nil
+}
+
+// TestBestBlockCache tests that the best block cache serves the tip of the
+// chain from memory, and keeps it up to date using block epochs.
+func TestBestBlockCache(t *testing.T) {
+	t.Parallel()
+
+	chainIO := &mockBestBlockChainIO{}
+	notifier := &mockEpochNotifier{
+		epochs: make(chan *chainntnfs.BlockEpoch),
+	}
+	cache := NewBestBlockCache(chainIO, notifier)
+
+	// Before the cache is started, queries are passed through.
+	if _, _, err := cache.GetBestBlock(); err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+	if atomic.LoadUint32(&chainIO.queries) != 1 {
+		t.Fatalf("expected query to be passed through")
+	}
+
+	if err := cache.Start(); err != nil {
+		t.Fatalf("unable to start cache: %v", err)
+	}
+	defer cache.Stop()
+
+	hash, height, err := cache.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+	if *hash != (chainhash.Hash{0x01}) || height != 100 {
+		t.Fatalf("unexpected best block %v at height %v", hash, height)
+	}
+
+	// Once a block is connected, the cache should serve the new tip
+	// without querying the backend.
+	notifier.epochs <- &chainntnfs.BlockEpoch{
+		Hash:   &chainhash.Hash{0x02},
+		Height: 101,
+	}
+
+	deadline := time.After(5 * time.Second)
+	for {
+		_, height, err := cache.GetBestBlock()
+		if err != nil {
+			t.Fatalf("unable to get best block: %v", err)
+		}
+		if height == 101 {
+			break
+		}
+
+		select {
+		case <-deadline:
+			t.Fatalf("best block not updated")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	// Only the initial query upon starting should've reached the backend.
+	if queries := atomic.LoadUint32(&chainIO.queries); queries != 2 {
+		t.Fatalf("expected 2 queries, got %v", queries)
+	}
+}
@@ -2,6 +2,12 @@ package lnwallet
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -52,6 +58,15 @@ type FeeEstimator interface {
 	// sat/kw.
 	EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error)
 
+	// RelayFeePerKW returns the minimum fee rate, in sat/kw, that a
+	// transaction must pay to be relayed by the network.
+	RelayFeePerKW() SatPerKWeight
+
+	// SubscribeFeeUpdates returns a subscription that's notified whenever
+	// the estimated fee rate for the passed confirmation target changes,
+	// allowing callers to react to fee changes rather than polling.
+	SubscribeFeeUpdates(confTarget uint32) (*FeeSubscription, error)
+
 	// Start signals the FeeEstimator to start any processes or goroutines
 	// it needs to perform its duty.
 	Start() error
@@ -61,6 +76,159 @@ type FeeEstimator interface {
 	Stop() error
 }
 
+// FeePreference expresses the fee a subsystem is willing to pay for a
+// transaction it crafts, such as a sweep of on-chain outputs.
+type FeePreference struct {
+	// ConfTarget is the number of blocks within which the transaction
+	// should confirm. It's used to query the FeeEstimator if FeeRate isn't
+	// set.
+	ConfTarget uint32
+
+	// FeeRate, if non-zero, is the fee rate the transaction should pay,
+	// overriding ConfTarget.
+	FeeRate SatPerKWeight
+
+	// Deadline, if non-zero, is the absolute height by which the
+	// transaction must confirm. If the deadline is closer than ConfTarget,
+	// the confirmation target is reduced to match it.
+	Deadline uint32
+
+	// MaxFee, if non-zero, is the largest absolute fee the transaction may
+	// pay.
+	MaxFee btcutil.Amount
+
+	// MaxFeeRate, if non-zero, is the highest fee rate the transaction may
+	// pay. Higher fee rates, whether estimated or set through FeeRate, are
+	// capped at this value.
+	MaxFeeRate SatPerKWeight
+}
+
+// String returns a human readable version of the fee preference.
+func (p FeePreference) String() string {
+	if p.FeeRate != 0 {
+		return fmt.Sprintf("fee_rate=%v sat/kw, max_fee=%v, "+
+			"max_fee_rate=%v sat/kw", int64(p.FeeRate), p.MaxFee,
+			int64(p.MaxFeeRate))
+	}
+
+	return fmt.Sprintf("conf_target=%v, deadline=%v, max_fee=%v, "+
+		"max_fee_rate=%v sat/kw", p.ConfTarget, p.Deadline, p.MaxFee,
+		int64(p.MaxFeeRate))
+}
+
+// ErrFeeExceedsMax is returned when a transaction would pay more than the
+// MaxFee of its FeePreference.
+var ErrFeeExceedsMax = fmt.Errorf("fee exceeds maximum allowed fee")
+
+// FeeRateBoundsError is returned when the minimum relay fee rate exceeds the
+// MaxFeeRate of a FeePreference, such that no fee rate satisfies both.
+type FeeRateBoundsError struct {
+	// RelayFeePerKW is the minimum fee rate needed for the transaction to
+	// be relayed.
+	RelayFeePerKW SatPerKWeight
+
+	// MaxFeePerKW is the highest fee rate the transaction may pay.
+	MaxFeePerKW SatPerKWeight
+}
+
+// Error returns a human readable description of the conflicting bounds.
+func (e *FeeRateBoundsError) Error() string {
+	return fmt.Sprintf("relay fee rate of %v sat/kw exceeds maximum fee "+
+		"rate of %v sat/kw", int64(e.RelayFeePerKW),
+		int64(e.MaxFeePerKW))
+}
+
+// DetermineFeePerKw returns the fee rate that satisfies the passed fee
+// preference. The current height is used to derive a confirmation target
+// from the preference's deadline, and may be zero if the deadline should be
+// ignored. The fee rate is bounded by the estimator's relay fee rate and the
+// preference's MaxFeeRate, and a *FeeRateBoundsError is returned if these
+// conflict.
+func DetermineFeePerKw(feeEstimator FeeEstimator, pref FeePreference,
+	currentHeight uint32) (SatPerKWeight, error) {
+
+	relayFeePerKw := feeEstimator.RelayFeePerKW()
+	if relayFeePerKw < FeePerKwFloor {
+		relayFeePerKw = FeePerKwFloor
+	}
+	if pref.MaxFeeRate != 0 && relayFeePerKw > pref.MaxFeeRate {
+		return 0, &FeeRateBoundsError{
+			RelayFeePerKW: relayFeePerKw,
+			MaxFeePerKW:   pref.MaxFeeRate,
+		}
+	}
+
+	feePerKw, err := preferredFeePerKw(feeEstimator, pref, currentHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case feePerKw < relayFeePerKw:
+		return relayFeePerKw, nil
+
+	case pref.MaxFeeRate != 0 && feePerKw > pref.MaxFeeRate:
+		walletLog.Debugf("Fee rate of %v sat/kw exceeds maximum, "+
+			"using %v sat/kw instead", int64(feePerKw),
+			int64(pref.MaxFeeRate))
+
+		return pref.MaxFeeRate, nil
+	}
+
+	return feePerKw, nil
+}
+
+// preferredFeePerKw returns the fee rate the passed fee preference asks for,
+// either directly or by querying the fee estimator, before it's bounded.
+func preferredFeePerKw(feeEstimator FeeEstimator, pref FeePreference,
+	currentHeight uint32) (SatPerKWeight, error) {
+
+	if pref.FeeRate != 0 {
+		return pref.FeeRate, nil
+	}
+
+	confTarget := pref.EffectiveConfTarget(currentHeight)
+	if confTarget == 0 {
+		return 0, fmt.Errorf("fee preference %v has neither a fee "+
+			"rate nor a confirmation target", pref)
+	}
+
+	return feeEstimator.EstimateFeePerKW(confTarget)
+}
+
+// EffectiveConfTarget returns the confirmation target fee rates should be
+// estimated for as of the passed height. This is ConfTarget, unless the
+// Deadline is closer, in which case the number of blocks left until the
+// deadline is returned instead. The Deadline is ignored if the height is
+// zero.
+func (p FeePreference) EffectiveConfTarget(currentHeight uint32) uint32 {
+	confTarget := p.ConfTarget
+	if p.Deadline != 0 && currentHeight != 0 {
+		// If the deadline has already passed, then we'll aim for the
+		// next block.
+		blocksLeft := uint32(1)
+		if p.Deadline > currentHeight {
+			blocksLeft = p.Deadline - currentHeight
+		}
+
+		if confTarget == 0 || blocksLeft < confTarget {
+			confTarget = blocksLeft
+		}
+	}
+
+	return confTarget
+}
+
+// CheckFee returns ErrFeeExceedsMax if the passed fee exceeds the MaxFee of
+// the fee preference.
+func (p FeePreference) CheckFee(fee btcutil.Amount) error {
+	if p.MaxFee != 0 && fee > p.MaxFee {
+		return ErrFeeExceedsMax
+	}
+
+	return nil
+}
+
 // StaticFeeEstimator will return a static value for all fee calculation
 // requests. It is designed to be replaced by a proper fee calculation
 // implementation.
@@ -77,6 +245,26 @@ func (e StaticFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, e
 	return e.FeePerKW, nil
 }
 
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e StaticFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return FeePerKwFloor
+}
+
+// SubscribeFeeUpdates returns a subscription to changes of the estimated fee
+// rate. As the fee rate is static, it never receives any updates.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e StaticFeeEstimator) SubscribeFeeUpdates(
+	confTarget uint32) (*FeeSubscription, error) {
+
+	return &FeeSubscription{
+		Cancel: func() {},
+	}, nil
+}
+
 // Start signals the FeeEstimator to start any processes or goroutines
 // it needs to perform its duty.
 //
@@ -112,6 +300,9 @@ type BtcdFeeEstimator struct {
 	// through the network.
 	minFeePerKW SatPerKWeight
 
+	// feeUpdates notifies subscribers of changes to the fee estimates.
+	feeUpdates *feeUpdateNotifier
+
 	btcdConn *rpcclient.Client
 }
 
@@ -130,10 +321,15 @@ func NewBtcdFeeEstimator(rpcConfig rpcclient.ConnConfig,
 		return nil, err
 	}
 
-	return &BtcdFeeEstimator{
+	estimator := &BtcdFeeEstimator{
 		fallbackFeePerKW: fallBackFeeRate,
 		btcdConn:         chainConn,
-	}, nil
+	}
+	estimator.feeUpdates = newFeeUpdateNotifier(
+		estimator.EstimateFeePerKW, feeUpdateInterval,
+	)
+
+	return estimator, nil
 }
 
 // Start signals the FeeEstimator to start any processes or goroutines
@@ -172,14 +368,33 @@ func (b *BtcdFeeEstimator) Start() error {
 	walletLog.Debugf("Using minimum fee rate of %v sat/kw",
 		int64(b.minFeePerKW))
 
+	b.feeUpdates.start()
+
 	return nil
 }
 
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed, as queried from the backend node on start up.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (b *BtcdFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return b.minFeePerKW
+}
+
+// SubscribeFeeUpdates returns a subscription that's notified whenever the
+// estimated fee rate for the passed confirmation target changes.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (b *BtcdFeeEstimator) SubscribeFeeUpdates(confTarget uint32) (*FeeSubscription, error) {
+	return b.feeUpdates.subscribe(confTarget)
+}
+
 // Stop stops any spawned goroutines and cleans up the resources used
 // by the fee estimator.
 //
 // NOTE: This method is part of the FeeEstimator interface.
 func (b *BtcdFeeEstimator) Stop() error {
+	b.feeUpdates.stop()
 	b.btcdConn.Shutdown()
 
 	return nil
@@ -243,6 +458,18 @@ func (b *BtcdFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, erro
 // FeeEstimator interface.
 var _ FeeEstimator = (*BtcdFeeEstimator)(nil)
 
+const (
+	// EstimateModeEconomical instructs bitcoind to produce fee estimates
+	// that are more responsive to short-term drops in the prevailing fee
+	// market, potentially returning lower estimates.
+	EstimateModeEconomical = "ECONOMICAL"
+
+	// EstimateModeConservative instructs bitcoind to produce fee
+	// estimates that take a longer history into account, making them
+	// less likely to be insufficient for the desired confirmation target.
+	EstimateModeConservative = "CONSERVATIVE"
+)
+
 // BitcoindFeeEstimator is an implementation of the FeeEstimator interface
 // backed by the RPC interface of an active bitcoind node. This implementation
 // will proxy any fee estimation requests to bitcoind's RPC interface.
@@ -258,30 +485,51 @@ type BitcoindFeeEstimator struct {
 	// through the network.
 	minFeePerKW SatPerKWeight
 
+	// estimateMode is the estimate mode passed to bitcoind's
+	// estimatesmartfee command. If empty, bitcoind's default mode is
+	// used.
+	estimateMode string
+
+	// feeUpdates notifies subscribers of changes to the fee estimates.
+	feeUpdates *feeUpdateNotifier
+
 	bitcoindConn *rpcclient.Client
 }
 
 // NewBitcoindFeeEstimator creates a new BitcoindFeeEstimator given a fully
 // populated rpc config that is able to successfully connect and authenticate
-// with the bitcoind node, and also a fall back fee rate. The fallback fee rate
-// is used in the occasion that the estimator has insufficient data, or returns
-// zero for a fee estimate.
+// with the bitcoind node, the estimate mode to request fee estimates with, and
+// also a fall back fee rate. The estimate mode must either be empty, in which
+// case bitcoind's default is used, or one of EstimateModeEconomical and
+// EstimateModeConservative. The fallback fee rate is used in the occasion that
+// the estimator has insufficient data, or returns zero for a fee estimate.
 func NewBitcoindFeeEstimator(rpcConfig rpcclient.ConnConfig,
+	estimateMode string,
 	fallBackFeeRate SatPerKWeight) (*BitcoindFeeEstimator, error) {
 
-	rpcConfig.DisableConnectOnNew = true
-	rpcConfig.DisableAutoReconnect = false
-	rpcConfig.DisableTLS = true
-	rpcConfig.HTTPPostMode = true
-	chainConn, err := rpcclient.New(&rpcConfig, nil)
+	switch estimateMode {
+	case "", EstimateModeEconomical, EstimateModeConservative:
+	default:
+		return nil, fmt.Errorf("unknown estimate mode %q, must be "+
+			"either %v or %v", estimateMode, EstimateModeEconomical,
+			EstimateModeConservative)
+	}
+
+	chainConn, err := newBitcoindConn(rpcConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return &BitcoindFeeEstimator{
+	estimator := &BitcoindFeeEstimator{
 		fallbackFeePerKW: fallBackFeeRate,
+		estimateMode:     estimateMode,
 		bitcoindConn:     chainConn,
-	}, nil
+	}
+	estimator.feeUpdates = newFeeUpdateNotifier(
+		estimator.EstimateFeePerKW, feeUpdateInterval,
+	)
+
+	return estimator, nil
 }
 
 // Start signals the FeeEstimator to start any processes or goroutines
@@ -289,44 +537,31 @@ func NewBitcoindFeeEstimator(rpcConfig rpcclient.ConnConfig,
 //
 // NOTE: This method is part of the FeeEstimator interface.
 func (b *BitcoindFeeEstimator) Start() error {
-	// Once the connection to the backend node has been established, we'll
-	// query it for its minimum relay fee. Since the `getinfo` RPC has been
-	// deprecated for `bitcoind`, we'll need to send a `getnetworkinfo`
-	// command as a raw request.
-	resp, err := b.bitcoindConn.RawRequest("getnetworkinfo", nil)
+	minFeePerKW, err := fetchBitcoindMinFee(b.bitcoindConn)
 	if err != nil {
 		return err
 	}
+	b.minFeePerKW = minFeePerKW
 
-	// Parse the response to retrieve the relay fee in sat/KB.
-	info := struct {
-		RelayFee float64 `json:"relayfee"`
-	}{}
-	if err := json.Unmarshal(resp, &info); err != nil {
-		return err
-	}
-
-	relayFee, err := btcutil.NewAmount(info.RelayFee)
-	if err != nil {
-		return err
-	}
-
-	// The fee rate is expressed in sat/kb, so we'll manually convert it to
-	// our desired sat/kw rate.
-	minRelayFeePerKw := SatPerKVByte(relayFee).FeePerKWeight()
+	b.feeUpdates.start()
 
-	// By default, we'll use the backend node's minimum relay fee as the
-	// minimum fee rate we'll propose for transacations. However, if this
-	// happens to be lower than our fee floor, we'll enforce that instead.
-	b.minFeePerKW = minRelayFeePerKw
-	if b.minFeePerKW < FeePerKwFloor {
-		b.minFeePerKW = FeePerKwFloor
-	}
+	return nil
+}
 
-	walletLog.Debugf("Using minimum fee rate of %v sat/kw",
-		int64(b.minFeePerKW))
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed, as queried from the backend node on start up.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (b *BitcoindFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return b.minFeePerKW
+}
 
-	return nil
+// SubscribeFeeUpdates returns a subscription that's notified whenever the
+// estimated fee rate for the passed confirmation target changes.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (b *BitcoindFeeEstimator) SubscribeFeeUpdates(confTarget uint32) (*FeeSubscription, error) {
+	return b.feeUpdates.subscribe(confTarget)
 }
 
 // Stop stops any spawned goroutines and cleans up the resources used
@@ -334,6 +569,8 @@ func (b *BitcoindFeeEstimator) Start() error {
 //
 // NOTE: This method is part of the FeeEstimator interface.
 func (b *BitcoindFeeEstimator) Stop() error {
+	b.feeUpdates.stop()
+
 	return nil
 }
 
@@ -367,10 +604,19 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 	if err != nil {
 		return 0, err
 	}
-	// TODO: Allow selection of economical/conservative modifiers.
-	resp, err := b.bitcoindConn.RawRequest(
-		"estimatesmartfee", []json.RawMessage{target},
-	)
+	params := []json.RawMessage{target}
+
+	// If an estimate mode was configured, we'll pass it along as well,
+	// otherwise bitcoind will fall back to its default mode.
+	if b.estimateMode != "" {
+		mode, err := json.Marshal(b.estimateMode)
+		if err != nil {
+			return 0, err
+		}
+		params = append(params, mode)
+	}
+
+	resp, err := b.bitcoindConn.RawRequest("estimatesmartfee", params)
 	if err != nil {
 		return 0, err
 	}
@@ -413,3 +659,605 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 // A compile-time assertion to ensure that BitcoindFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
+
+// newBitcoindConn creates an RPC client for the bitcoind node described by the
+// passed config, which is suitable for sending raw requests.
+func newBitcoindConn(rpcConfig rpcclient.ConnConfig) (*rpcclient.Client, error) {
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	rpcConfig.DisableTLS = true
+	rpcConfig.HTTPPostMode = true
+
+	return rpcclient.New(&rpcConfig, nil)
+}
+
+// fetchBitcoindMinFee queries the bitcoind node for its minimum relay fee, and
+// returns the minimum fee rate we should propose for transactions in sat/kw.
+func fetchBitcoindMinFee(bitcoindConn *rpcclient.Client) (SatPerKWeight,
+	error) {
+
+	// Since the `getinfo` RPC has been deprecated for `bitcoind`, we'll
+	// need to send a `getnetworkinfo` command as a raw request.
+	resp, err := bitcoindConn.RawRequest("getnetworkinfo", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// Parse the response to retrieve the relay fee in sat/KB.
+	info := struct {
+		RelayFee float64 `json:"relayfee"`
+	}{}
+	if err := json.Unmarshal(resp, &info); err != nil {
+		return 0, err
+	}
+
+	relayFee, err := btcutil.NewAmount(info.RelayFee)
+	if err != nil {
+		return 0, err
+	}
+
+	// The fee rate is expressed in sat/kb, so we'll manually convert it to
+	// our desired sat/kw rate.
+	minFeePerKW := SatPerKVByte(relayFee).FeePerKWeight()
+
+	// By default, we'll use the backend node's minimum relay fee as the
+	// minimum fee rate we'll propose for transacations. However, if this
+	// happens to be lower than our fee floor, we'll enforce that instead.
+	if minFeePerKW < FeePerKwFloor {
+		minFeePerKW = FeePerKwFloor
+	}
+
+	walletLog.Debugf("Using minimum fee rate of %v sat/kw",
+		int64(minFeePerKW))
+
+	return minFeePerKW, nil
+}
+
+// mempoolCacheTimeout is the duration the fee histogram of the mempool is
+// used for before it's rebuilt. As the verbose mempool can be large, we avoid
+// fetching it for every estimate.
+const mempoolCacheTimeout = time.Minute
+
+// mempoolTx is the fee rate and virtual size of a transaction within the
+// mempool.
+type mempoolTx struct {
+	feePerKw SatPerKWeight
+	vsize    int64
+}
+
+// MempoolFeeEstimator is an implementation of the FeeEstimator interface
+// backed by the mempool of an active bitcoind node. Rather than relying on
+// the fee rates of past blocks, it builds a fee histogram of the transactions
+// currently awaiting confirmation, and returns the fee rate needed to outbid
+// enough of them to be included within the confirmation target.
+type MempoolFeeEstimator struct {
+	// fallbackFeePerKW is the fallback fee rate in sat/kw that is returned
+	// if the mempool can't be queried.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	// It's returned whenever the mempool holds less than the confirmation
+	// target's worth of blocks.
+	minFeePerKW SatPerKWeight
+
+	// histogram holds the transactions of the mempool, sorted by
+	// decreasing fee rate.
+	histogram []mempoolTx
+
+	// lastUpdate is the time the histogram was last built.
+	lastUpdate time.Time
+
+	mu sync.Mutex
+
+	// feeUpdates notifies subscribers of changes to the fee estimates.
+	feeUpdates *feeUpdateNotifier
+
+	bitcoindConn *rpcclient.Client
+}
+
+// NewMempoolFeeEstimator creates a new MempoolFeeEstimator given a fully
+// populated rpc config that is able to successfully connect and authenticate
+// with the bitcoind node, and a fall back fee rate, used in the occasion that
+// the mempool can't be queried.
+func NewMempoolFeeEstimator(rpcConfig rpcclient.ConnConfig,
+	fallBackFeeRate SatPerKWeight) (*MempoolFeeEstimator, error) {
+
+	chainConn, err := newBitcoindConn(rpcConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	estimator := &MempoolFeeEstimator{
+		fallbackFeePerKW: fallBackFeeRate,
+		bitcoindConn:     chainConn,
+	}
+	estimator.feeUpdates = newFeeUpdateNotifier(
+		estimator.EstimateFeePerKW, feeUpdateInterval,
+	)
+
+	return estimator, nil
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MempoolFeeEstimator) Start() error {
+	minFeePerKW, err := fetchBitcoindMinFee(m.bitcoindConn)
+	if err != nil {
+		return err
+	}
+	m.minFeePerKW = minFeePerKW
+
+	m.feeUpdates.start()
+
+	return nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed, as queried from the backend node on start up.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MempoolFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return m.minFeePerKW
+}
+
+// SubscribeFeeUpdates returns a subscription that's notified whenever the
+// estimated fee rate for the passed confirmation target changes.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MempoolFeeEstimator) SubscribeFeeUpdates(confTarget uint32) (*FeeSubscription, error) {
+	return m.feeUpdates.subscribe(confTarget)
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MempoolFeeEstimator) Stop() error {
+	m.feeUpdates.stop()
+
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MempoolFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if time.Since(m.lastUpdate) >= mempoolCacheTimeout {
+		if err := m.updateHistogram(); err != nil {
+			walletLog.Errorf("unable to query mempool: %v", err)
+			return m.fallbackFeePerKW, nil
+		}
+	}
+
+	// We'll assume each of the upcoming blocks is filled with the highest
+	// paying transactions of the mempool. The fee rate needed to land
+	// within the confirmation target is then the one of the last
+	// transaction that fits within the target's worth of blocks.
+	const blockVSize = blockchain.MaxBlockWeight /
+		blockchain.WitnessScaleFactor
+	capacity := int64(numBlocks) * blockVSize
+
+	feePerKw := m.minFeePerKW
+	var vsize int64
+	for _, tx := range m.histogram {
+		vsize += tx.vsize
+		if vsize >= capacity {
+			feePerKw = tx.feePerKw
+			break
+		}
+	}
+
+	// If the mempool holds less than the target's worth of blocks, or the
+	// marginal transaction pays less than our minimum, we'll enforce our
+	// fee floor.
+	if feePerKw < m.minFeePerKW {
+		feePerKw = m.minFeePerKW
+	}
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(feePerKw), numBlocks)
+
+	return feePerKw, nil
+}
+
+// updateHistogram fetches the transactions of the mempool, and rebuilds the
+// fee histogram from them.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (m *MempoolFeeEstimator) updateHistogram() error {
+	verbose, err := json.Marshal(true)
+	if err != nil {
+		return err
+	}
+	resp, err := m.bitcoindConn.RawRequest(
+		"getrawmempool", []json.RawMessage{verbose},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Depending on the version of bitcoind, the virtual size and the fee
+	// of each transaction are returned within different fields, so we'll
+	// parse all of them.
+	var entries map[string]struct {
+		Size  int64   `json:"size"`
+		VSize int64   `json:"vsize"`
+		Fee   float64 `json:"fee"`
+		Fees  struct {
+			Base float64 `json:"base"`
+		} `json:"fees"`
+	}
+	if err := json.Unmarshal(resp, &entries); err != nil {
+		return err
+	}
+
+	histogram := make([]mempoolTx, 0, len(entries))
+	for _, entry := range entries {
+		vsize := entry.VSize
+		if vsize == 0 {
+			vsize = entry.Size
+		}
+		btcFee := entry.Fees.Base
+		if btcFee == 0 {
+			btcFee = entry.Fee
+		}
+		if vsize <= 0 {
+			continue
+		}
+
+		fee, err := btcutil.NewAmount(btcFee)
+		if err != nil {
+			return err
+		}
+
+		satPerKB := SatPerKVByte(fee * 1000 / btcutil.Amount(vsize))
+		histogram = append(histogram, mempoolTx{
+			feePerKw: satPerKB.FeePerKWeight(),
+			vsize:    vsize,
+		})
+	}
+
+	sort.Slice(histogram, func(i, j int) bool {
+		return histogram[i].feePerKw > histogram[j].feePerKw
+	})
+
+	m.histogram = histogram
+	m.lastUpdate = time.Now()
+
+	return nil
+}
+
+// A compile-time assertion to ensure that MempoolFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*MempoolFeeEstimator)(nil)
+
+const (
+	// webAPIMinRetryBackoff is the duration the fee API isn't queried for
+	// after it first fails to be. It doubles with each consecutive
+	// failure, up to webAPIMaxRetryBackoff, such that an unavailable API
+	// isn't queried for every estimate.
+	webAPIMinRetryBackoff = 30 * time.Second
+
+	// webAPIMaxRetryBackoff is the longest the fee API isn't queried for
+	// after failing to be.
+	webAPIMaxRetryBackoff = 10 * time.Minute
+
+	// webAPITimeout is the time limit of queries to the fee API made with
+	// the default client, such that an unresponsive API can't stall fee
+	// estimation.
+	webAPITimeout = 10 * time.Second
+)
+
+// WebAPIConfig houses the parameters of a WebAPIFeeEstimator.
+type WebAPIConfig struct {
+	// URL is the HTTPS endpoint of the fee API. A GET request to it
+	// should return a JSON object mapping confirmation targets to fee
+	// rates in sat/kb, for example:
+	//
+	//   {"fee_by_block_target": {"2": 40000, "6": 20000, "144": 2000}}
+	URL string
+
+	// Client is the HTTP client used to query the fee API. If nil, a
+	// client whose queries time out after webAPITimeout is used.
+	Client *http.Client
+
+	// CacheTimeout is the duration the fee rates returned by the API are
+	// used for before they're queried again.
+	CacheTimeout time.Duration
+
+	// MaxCacheAge is the longest the fee rates returned by the API are
+	// used for while the API can't be queried, after which the fallback
+	// estimator is used instead. If zero, expired fee rates are never
+	// used.
+	MaxCacheAge time.Duration
+
+	// MaxFeePerKW is the highest fee rate returned by the API that's
+	// considered sane. Higher fee rates are capped at this value. If
+	// zero, fee rates aren't capped.
+	MaxFeePerKW SatPerKWeight
+
+	// Fallback is the estimator used whenever the fee API can't be
+	// queried, or doesn't return a usable fee rate.
+	Fallback FeeEstimator
+}
+
+// WebAPIFeeEstimator is an implementation of the FeeEstimator interface that
+// queries an external fee API over HTTPS. This allows nodes without a local
+// source of fee data, such as neutrino nodes, to still use live fee
+// estimates.
+type WebAPIFeeEstimator struct {
+	cfg WebAPIConfig
+
+	// feeByBlockTarget is the cache of fee rates returned by the API,
+	// keyed by confirmation target.
+	feeByBlockTarget map[uint32]SatPerKWeight
+
+	// lastUpdate is the time the cache was last populated.
+	lastUpdate time.Time
+
+	// lastFailure is the time the fee API last failed to be queried.
+	lastFailure time.Time
+
+	// retryBackoff is the duration after lastFailure that the fee API
+	// isn't queried for. It's reset once the API is queried successfully.
+	retryBackoff time.Duration
+
+	mu sync.Mutex
+
+	// feeUpdates notifies subscribers of changes to the fee estimates.
+	feeUpdates *feeUpdateNotifier
+}
+
+// NewWebAPIFeeEstimator creates a new WebAPIFeeEstimator from the passed
+// config, which must specify an HTTPS URL and a fallback estimator.
+func NewWebAPIFeeEstimator(cfg WebAPIConfig) (*WebAPIFeeEstimator, error) {
+	apiURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee API URL: %v", err)
+	}
+	if apiURL.Scheme != "https" {
+		return nil, fmt.Errorf("fee API URL %v must use https",
+			cfg.URL)
+	}
+	if cfg.Fallback == nil {
+		return nil, fmt.Errorf("fallback fee estimator required")
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: webAPITimeout}
+	}
+
+	estimator := &WebAPIFeeEstimator{
+		cfg: cfg,
+	}
+	estimator.feeUpdates = newFeeUpdateNotifier(
+		estimator.EstimateFeePerKW, feeUpdateInterval,
+	)
+
+	return estimator, nil
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) Start() error {
+	if err := w.cfg.Fallback.Start(); err != nil {
+		return err
+	}
+
+	// We'll attempt to populate the cache right away, but since we can
+	// rely on the fallback estimator, failing to do so isn't fatal.
+	w.refreshCache()
+
+	w.feeUpdates.start()
+
+	return nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed. As the fee API has no notion of it, the fallback estimator is
+// queried.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return w.cfg.Fallback.RelayFeePerKW()
+}
+
+// SubscribeFeeUpdates returns a subscription that's notified whenever the
+// estimated fee rate for the passed confirmation target changes.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) SubscribeFeeUpdates(confTarget uint32) (*FeeSubscription, error) {
+	return w.feeUpdates.subscribe(confTarget)
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) Stop() error {
+	w.feeUpdates.stop()
+
+	return w.cfg.Fallback.Stop()
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feePerKw, err := w.cachedEstimate(numBlocks)
+	if err != nil {
+		walletLog.Debugf("Using fallback estimator: %v", err)
+
+		return w.cfg.Fallback.EstimateFeePerKW(numBlocks)
+	}
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(feePerKw), numBlocks)
+
+	return feePerKw, nil
+}
+
+// cachedEstimate returns the fee rate for the passed confirmation target,
+// refreshing the cache first if it has expired. Should the refresh fail, the
+// expired cache is used as long as it's no older than MaxCacheAge. If the API
+// doesn't have an estimate for the exact target, the estimate for the closest
+// lower target is used, as it'll only confirm faster.
+func (w *WebAPIFeeEstimator) cachedEstimate(numBlocks uint32) (SatPerKWeight,
+	error) {
+
+	w.mu.Lock()
+	expired := time.Since(w.lastUpdate) >= w.cfg.CacheTimeout
+	w.mu.Unlock()
+
+	// The cache is refreshed without holding the mutex, as that involves
+	// querying the fee API.
+	var refreshErr error
+	if expired {
+		refreshErr = w.refreshCache()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if refreshErr != nil {
+		cacheAge := time.Since(w.lastUpdate)
+		if w.feeByBlockTarget == nil || cacheAge >= w.cfg.MaxCacheAge {
+			return 0, refreshErr
+		}
+
+		walletLog.Debugf("Using fee rates cached %v ago: %v", cacheAge,
+			refreshErr)
+	}
+
+	var (
+		closestTarget uint32
+		feePerKw      SatPerKWeight
+	)
+	for target, targetFee := range w.feeByBlockTarget {
+		if target > numBlocks || target < closestTarget {
+			continue
+		}
+		closestTarget = target
+		feePerKw = targetFee
+	}
+
+	// If all of the API's targets are further out than the requested
+	// one, we can't provide a fee rate that's likely to be sufficient.
+	if closestTarget == 0 {
+		return 0, fmt.Errorf("no estimate for conf target of %v",
+			numBlocks)
+	}
+
+	return feePerKw, nil
+}
+
+// refreshCache populates the cache by querying the fee API, unless it has
+// failed to be queried within the current retry backoff. Each consecutive
+// failure doubles the backoff.
+//
+// NOTE: The mutex MUST NOT be held when calling this method, as it's only
+// acquired to access the cache, and not while the fee API is queried.
+func (w *WebAPIFeeEstimator) refreshCache() error {
+	w.mu.Lock()
+	sinceFailure := time.Since(w.lastFailure)
+	retryBackoff := w.retryBackoff
+	w.mu.Unlock()
+
+	if sinceFailure < retryBackoff {
+		return fmt.Errorf("fee API failed %v ago, retrying in %v",
+			sinceFailure, retryBackoff-sinceFailure)
+	}
+
+	feeByBlockTarget, err := w.queryFees()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err != nil {
+		w.retryBackoff *= 2
+		switch {
+		case w.retryBackoff == 0:
+			w.retryBackoff = webAPIMinRetryBackoff
+		case w.retryBackoff > webAPIMaxRetryBackoff:
+			w.retryBackoff = webAPIMaxRetryBackoff
+		}
+		w.lastFailure = time.Now()
+
+		walletLog.Warnf("Unable to query fee API, retrying in %v: %v",
+			w.retryBackoff, err)
+
+		return err
+	}
+
+	w.retryBackoff = 0
+	w.feeByBlockTarget = feeByBlockTarget
+	w.lastUpdate = time.Now()
+
+	return nil
+}
+
+// queryFees queries the fee API, returning the fee rates it returned for each
+// confirmation target, bounded to sane values.
+func (w *WebAPIFeeEstimator) queryFees() (map[uint32]SatPerKWeight, error) {
+	resp, err := w.cfg.Client.Get(w.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fee API returned status %v",
+			resp.Status)
+	}
+
+	var fees struct {
+		FeeByBlockTarget map[uint32]uint32 `json:"fee_by_block_target"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&fees); err != nil {
+		return nil, fmt.Errorf("unable to decode fee API response: %v",
+			err)
+	}
+	if len(fees.FeeByBlockTarget) == 0 {
+		return nil, fmt.Errorf("fee API returned no estimates")
+	}
+
+	feeByBlockTarget := make(map[uint32]SatPerKWeight)
+	for target, satPerKB := range fees.FeeByBlockTarget {
+		if target == 0 {
+			continue
+		}
+
+		// Since we use fee rates in sat/kw internally, we'll convert
+		// the fee rate from its sat/kb representation, and then bound
+		// it to ensure a faulty API can neither stall our
+		// transactions, nor drain our funds.
+		feePerKw := SatPerKVByte(satPerKB).FeePerKWeight()
+		if feePerKw < FeePerKwFloor {
+			feePerKw = FeePerKwFloor
+		}
+		if w.cfg.MaxFeePerKW != 0 && feePerKw > w.cfg.MaxFeePerKW {
+			walletLog.Warnf("Fee API returned %v sat/kw for conf "+
+				"target of %v, capping at %v sat/kw",
+				int64(feePerKw), target,
+				int64(w.cfg.MaxFeePerKW))
+
+			feePerKw = w.cfg.MaxFeePerKW
+		}
+
+		feeByBlockTarget[target] = feePerKw
+	}
+
+	return feeByBlockTarget, nil
+}
+
+// A compile-time assertion to ensure that WebAPIFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*WebAPIFeeEstimator)(nil)
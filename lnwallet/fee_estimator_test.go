@@ -1,8 +1,16 @@
 package lnwallet_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
@@ -91,3 +99,561 @@ func TestStaticFeeEstimator(t *testing.T) {
 		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
 	}
 }
+
+// confTargetEstimator is a FeeEstimator that returns a fee rate derived from
+// the requested confirmation target, allowing tests to observe the target.
+type confTargetEstimator struct {
+	lnwallet.StaticFeeEstimator
+
+	// relayFeePerKw, if non-zero, overrides the relay fee rate of the
+	// StaticFeeEstimator.
+	relayFeePerKw lnwallet.SatPerKWeight
+}
+
+func (c confTargetEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return lnwallet.SatPerKWeight(numBlocks * 1000), nil
+}
+
+func (c confTargetEstimator) RelayFeePerKW() lnwallet.SatPerKWeight {
+	if c.relayFeePerKw != 0 {
+		return c.relayFeePerKw
+	}
+
+	return c.StaticFeeEstimator.RelayFeePerKW()
+}
+
+// TestDetermineFeePerKw tests that the fee rate selected for a fee preference
+// honors its fee rate, confirmation target and deadline, and is bounded by the
+// relay fee rate and maximum fee rate.
+func TestDetermineFeePerKw(t *testing.T) {
+	t.Parallel()
+
+	const currentHeight = 100
+
+	testCases := []struct {
+		name     string
+		pref     lnwallet.FeePreference
+		height   uint32
+		relayFee lnwallet.SatPerKWeight
+		expected lnwallet.SatPerKWeight
+		fail     bool
+	}{
+		{
+			name:     "conf target",
+			pref:     lnwallet.FeePreference{ConfTarget: 6},
+			height:   currentHeight,
+			expected: 6000,
+		},
+		{
+			name: "fee rate overrides conf target",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				FeeRate:    5000,
+			},
+			height:   currentHeight,
+			expected: 5000,
+		},
+		{
+			name:     "fee rate below floor",
+			pref:     lnwallet.FeePreference{FeeRate: 1},
+			height:   currentHeight,
+			expected: lnwallet.FeePerKwFloor,
+		},
+		{
+			name: "deadline closer than conf target",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				Deadline:   currentHeight + 3,
+			},
+			height:   currentHeight,
+			expected: 3000,
+		},
+		{
+			name: "deadline further than conf target",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				Deadline:   currentHeight + 10,
+			},
+			height:   currentHeight,
+			expected: 6000,
+		},
+		{
+			name: "deadline passed",
+			pref: lnwallet.FeePreference{
+				Deadline: currentHeight - 1,
+			},
+			height:   currentHeight,
+			expected: 1000,
+		},
+		{
+			name: "deadline without height",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				Deadline:   currentHeight + 3,
+			},
+			expected: 6000,
+		},
+		{
+			name:   "empty preference",
+			pref:   lnwallet.FeePreference{},
+			height: currentHeight,
+			fail:   true,
+		},
+		{
+			name: "fee rate above max fee rate",
+			pref: lnwallet.FeePreference{
+				FeeRate:    5000,
+				MaxFeeRate: 3000,
+			},
+			height:   currentHeight,
+			expected: 3000,
+		},
+		{
+			name: "estimate above max fee rate",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				MaxFeeRate: 2000,
+			},
+			height:   currentHeight,
+			expected: 2000,
+		},
+		{
+			name:     "estimate below relay fee rate",
+			pref:     lnwallet.FeePreference{ConfTarget: 1},
+			height:   currentHeight,
+			relayFee: 2000,
+			expected: 2000,
+		},
+		{
+			name: "relay fee rate above max fee rate",
+			pref: lnwallet.FeePreference{
+				ConfTarget: 6,
+				MaxFeeRate: 1000,
+			},
+			height:   currentHeight,
+			relayFee: 2000,
+			fail:     true,
+		},
+	}
+
+	for _, test := range testCases {
+		estimator := confTargetEstimator{relayFeePerKw: test.relayFee}
+		feePerKw, err := lnwallet.DetermineFeePerKw(
+			estimator, test.pref, test.height,
+		)
+		switch {
+		case test.fail && err == nil:
+			t.Fatalf("%s: expected failure", test.name)
+
+		case !test.fail && err != nil:
+			t.Fatalf("%s: unable to determine fee rate: %v",
+				test.name, err)
+		}
+
+		if feePerKw != test.expected {
+			t.Fatalf("%s: expected %v sat/kw, got %v sat/kw",
+				test.name, test.expected, feePerKw)
+		}
+	}
+
+	// Conflicting bounds should be reported through a typed error.
+	_, err := lnwallet.DetermineFeePerKw(
+		confTargetEstimator{relayFeePerKw: 2000},
+		lnwallet.FeePreference{ConfTarget: 6, MaxFeeRate: 1000}, 0,
+	)
+	boundsErr, ok := err.(*lnwallet.FeeRateBoundsError)
+	if !ok {
+		t.Fatalf("expected FeeRateBoundsError, got %v", err)
+	}
+	if boundsErr.RelayFeePerKW != 2000 || boundsErr.MaxFeePerKW != 1000 {
+		t.Fatalf("unexpected bounds: %v", boundsErr)
+	}
+
+	pref := lnwallet.FeePreference{ConfTarget: 6, MaxFee: 1000}
+	if err := pref.CheckFee(1000); err != nil {
+		t.Fatalf("fee at maximum rejected: %v", err)
+	}
+	if err := pref.CheckFee(1001); err != lnwallet.ErrFeeExceedsMax {
+		t.Fatalf("expected ErrFeeExceedsMax, got %v", err)
+	}
+}
+
+// newMockBitcoind starts an HTTP server mocking bitcoind's RPC interface,
+// which responds to each request with the JSON result returned by the passed
+// handler. It returns the config needed to connect to the server, along with
+// a closure that shuts it down.
+func newMockBitcoind(handler func(method string,
+	params []json.RawMessage) string) (rpcclient.ConnConfig, func()) {
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     uint64            `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			result := handler(req.Method, req.Params)
+			fmt.Fprintf(w, `{"result": %s, "error": null, "id": %d}`,
+				result, req.ID)
+		},
+	))
+
+	rpcConfig := rpcclient.ConnConfig{
+		Host: strings.TrimPrefix(server.URL, "http://"),
+	}
+
+	return rpcConfig, server.Close
+}
+
+// TestBitcoindFeeEstimatorMode tests that the BitcoindFeeEstimator requests
+// fee estimates with its configured estimate mode, and rejects unknown modes.
+func TestBitcoindFeeEstimatorMode(t *testing.T) {
+	t.Parallel()
+
+	// We'll mock bitcoind's RPC interface, recording the parameters of
+	// each estimatesmartfee request.
+	params := make(chan []json.RawMessage, 1)
+	rpcConfig, cleanUp := newMockBitcoind(
+		func(method string, reqParams []json.RawMessage) string {
+			switch method {
+			case "getnetworkinfo":
+				return `{"relayfee": 0.00001}`
+			case "estimatesmartfee":
+				params <- reqParams
+				return `{"feerate": 0.0002, "blocks": 6}`
+			}
+
+			return "null"
+		},
+	)
+	defer cleanUp()
+
+	_, err := lnwallet.NewBitcoindFeeEstimator(rpcConfig, "FAST", 250)
+	if err == nil {
+		t.Fatalf("expected unknown estimate mode to be rejected")
+	}
+
+	testCases := []struct {
+		mode   string
+		params []string
+	}{
+		{
+			mode:   "",
+			params: []string{"6"},
+		},
+		{
+			mode:   lnwallet.EstimateModeEconomical,
+			params: []string{"6", `"ECONOMICAL"`},
+		},
+		{
+			mode:   lnwallet.EstimateModeConservative,
+			params: []string{"6", `"CONSERVATIVE"`},
+		},
+	}
+
+	for _, test := range testCases {
+		estimator, err := lnwallet.NewBitcoindFeeEstimator(
+			rpcConfig, test.mode, 250,
+		)
+		if err != nil {
+			t.Fatalf("unable to create estimator: %v", err)
+		}
+		if err := estimator.Start(); err != nil {
+			t.Fatalf("unable to start estimator: %v", err)
+		}
+
+		feeRate, err := estimator.EstimateFeePerKW(6)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+
+		// The 20000 sat/kb returned by bitcoind is 5000 sat/kw.
+		if feeRate != 5000 {
+			t.Fatalf("mode %q: expected 5000 sat/kw, got %v",
+				test.mode, feeRate)
+		}
+
+		reqParams := <-params
+		if len(reqParams) != len(test.params) {
+			t.Fatalf("mode %q: expected params %v, got %s",
+				test.mode, test.params, reqParams)
+		}
+		for i, param := range reqParams {
+			if string(param) != test.params[i] {
+				t.Fatalf("mode %q: expected params %v, got %s",
+					test.mode, test.params, reqParams)
+			}
+		}
+
+		estimator.Stop()
+	}
+}
+
+// TestWebAPIFeeEstimator tests that the WebAPIFeeEstimator caches and bounds
+// the fee rates returned by the API, and falls back to its fallback estimator
+// whenever the API can't provide a fee rate.
+func TestWebAPIFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	var (
+		numRequests int
+		failing     bool
+		mu          sync.Mutex
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			numRequests++
+			if failing {
+				http.Error(w, "unavailable",
+					http.StatusServiceUnavailable)
+				return
+			}
+
+			fmt.Fprint(w, `{"fee_by_block_target": {"2": 400000, `+
+				`"6": 20000, "144": 100}}`)
+		},
+	))
+	defer server.Close()
+
+	fallback := lnwallet.StaticFeeEstimator{FeePerKW: 1234}
+	cfg := lnwallet.WebAPIConfig{
+		URL:          "http://example.com",
+		Client:       server.Client(),
+		CacheTimeout: time.Hour,
+		MaxFeePerKW:  50000,
+		Fallback:     fallback,
+	}
+
+	// The fee API must be queried over HTTPS.
+	if _, err := lnwallet.NewWebAPIFeeEstimator(cfg); err == nil {
+		t.Fatalf("expected non-HTTPS URL to be rejected")
+	}
+
+	cfg.URL = server.URL
+	estimator, err := lnwallet.NewWebAPIFeeEstimator(cfg)
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	testCases := []struct {
+		name     string
+		target   uint32
+		expected lnwallet.SatPerKWeight
+	}{
+		{
+			name:     "exact target",
+			target:   6,
+			expected: 5000,
+		},
+		{
+			name:     "closest lower target",
+			target:   100,
+			expected: 5000,
+		},
+		{
+			name:     "capped at max fee rate",
+			target:   2,
+			expected: 50000,
+		},
+		{
+			name:     "raised to fee floor",
+			target:   1008,
+			expected: lnwallet.FeePerKwFloor,
+		},
+		{
+			name:     "no lower target",
+			target:   1,
+			expected: fallback.FeePerKW,
+		},
+	}
+
+	for _, test := range testCases {
+		feePerKw, err := estimator.EstimateFeePerKW(test.target)
+		if err != nil {
+			t.Fatalf("%s: unable to estimate fee: %v", test.name,
+				err)
+		}
+		if feePerKw != test.expected {
+			t.Fatalf("%s: expected %v sat/kw, got %v sat/kw",
+				test.name, test.expected, feePerKw)
+		}
+	}
+
+	// All of the estimates should have been served from the cache
+	// populated on start up.
+	mu.Lock()
+	if numRequests != 1 {
+		t.Fatalf("expected 1 request, got %v", numRequests)
+	}
+	mu.Unlock()
+
+	// Once the cache expires and the API fails, the expired cache should
+	// still be used while it's no older than MaxCacheAge.
+	cfg.CacheTimeout = 0
+	cfg.MaxCacheAge = time.Hour
+	estimator, err = lnwallet.NewWebAPIFeeEstimator(cfg)
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+
+	for i := 0; i < 2; i++ {
+		feePerKw, err := estimator.EstimateFeePerKW(6)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+		if feePerKw != 5000 {
+			t.Fatalf("expected cached fee rate of 5000 sat/kw, "+
+				"got %v sat/kw", feePerKw)
+		}
+	}
+
+	// The API should only have been queried once since it started
+	// failing, as it's backed off from afterwards.
+	mu.Lock()
+	if numRequests != 3 {
+		t.Fatalf("expected 3 requests, got %v", numRequests)
+	}
+	mu.Unlock()
+
+	// Without a usable cache, the fallback estimator should be used.
+	cfg.MaxCacheAge = 0
+	estimator, err = lnwallet.NewWebAPIFeeEstimator(cfg)
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	feePerKw, err := estimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if feePerKw != fallback.FeePerKW {
+		t.Fatalf("expected fallback fee rate of %v sat/kw, got %v "+
+			"sat/kw", fallback.FeePerKW, feePerKw)
+	}
+}
+
+// TestMempoolFeeEstimator tests that the MempoolFeeEstimator returns the fee
+// rate needed to be included within the confirmation target, assuming the
+// upcoming blocks are filled with the highest paying transactions.
+func TestMempoolFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	// The mempool holds a block's worth of transactions paying 50 sat/vb,
+	// a block's worth paying 20 sat/vb, and half a block's worth paying
+	// 10 sat/vb. We'll mix the fields used by different bitcoind versions.
+	const (
+		blockVSize = 1000000
+		txVSize    = 50000
+	)
+	var mempool []string
+	for i := 0; i < 2*blockVSize/txVSize; i++ {
+		fee := 0.025
+		if i >= blockVSize/txVSize {
+			fee = 0.01
+		}
+		mempool = append(mempool, fmt.Sprintf(`"%064x": {"size": %d, `+
+			`"fee": %v}`, i, txVSize, fee))
+	}
+	for i := 0; i < blockVSize/txVSize/2; i++ {
+		mempool = append(mempool, fmt.Sprintf(`"%064x": {"vsize": %d, `+
+			`"fees": {"base": 0.005}}`, 100+i, txVSize))
+	}
+
+	var (
+		numRequests int
+		mu          sync.Mutex
+	)
+	rpcConfig, cleanUp := newMockBitcoind(
+		func(method string, _ []json.RawMessage) string {
+			switch method {
+			case "getnetworkinfo":
+				return `{"relayfee": 0.00001}`
+			case "getrawmempool":
+				mu.Lock()
+				numRequests++
+				mu.Unlock()
+
+				return "{" + strings.Join(mempool, ",") + "}"
+			}
+
+			return "null"
+		},
+	)
+	defer cleanUp()
+
+	estimator, err := lnwallet.NewMempoolFeeEstimator(rpcConfig, 250)
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	testCases := []struct {
+		numBlocks uint32
+		expected  lnwallet.SatPerKWeight
+	}{
+		{
+			numBlocks: 1,
+			expected:  lnwallet.SatPerKVByte(50000).FeePerKWeight(),
+		},
+		{
+			numBlocks: 2,
+			expected:  lnwallet.SatPerKVByte(20000).FeePerKWeight(),
+		},
+
+		// Once the target exceeds the mempool's worth of blocks, any
+		// transaction paying the minimum fee will do.
+		{
+			numBlocks: 3,
+			expected:  lnwallet.FeePerKwFloor,
+		},
+	}
+
+	for _, test := range testCases {
+		feePerKw, err := estimator.EstimateFeePerKW(test.numBlocks)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+		if feePerKw != test.expected {
+			t.Fatalf("conf target %v: expected %v sat/kw, got %v "+
+				"sat/kw", test.numBlocks, test.expected,
+				feePerKw)
+		}
+	}
+
+	// The histogram should've been built only once.
+	mu.Lock()
+	defer mu.Unlock()
+	if numRequests != 1 {
+		t.Fatalf("expected mempool to be fetched once, was fetched "+
+			"%v times", numRequests)
+	}
+}
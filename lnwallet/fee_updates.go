@@ -0,0 +1,181 @@
+package lnwallet
+
+import (
+	"sync"
+	"time"
+)
+
+// feeUpdateInterval is the interval at which estimators backed by an external
+// source of fee data check whether the estimates of their subscribers have
+// changed.
+const feeUpdateInterval = time.Minute
+
+// FeeSubscription is a subscription to changes of the estimated fee rate for
+// a confirmation target.
+type FeeSubscription struct {
+	// Updates receives the new estimated fee rate each time it changes.
+	// Only the latest fee rate is buffered, so a slow subscriber only
+	// misses intermediate fee rates. The channel may be nil if the
+	// estimate never changes.
+	Updates <-chan SatPerKWeight
+
+	// Cancel ends the subscription, after which no further updates are
+	// sent.
+	Cancel func()
+}
+
+// feeSubscriber is the state of a single fee subscription.
+type feeSubscriber struct {
+	confTarget uint32
+	lastFee    SatPerKWeight
+	updates    chan SatPerKWeight
+}
+
+// feeUpdateNotifier periodically queries a fee estimator for the confirmation
+// targets of its subscribers, and notifies them whenever their estimate
+// changes. This allows subscribers to react to fee changes as they happen,
+// rather than each of them polling the estimator.
+type feeUpdateNotifier struct {
+	// estimateFee returns the current fee estimate for a confirmation
+	// target.
+	estimateFee func(uint32) (SatPerKWeight, error)
+
+	// interval is the interval at which fee estimates are checked.
+	interval time.Duration
+
+	subscribers map[uint64]*feeSubscriber
+	nextID      uint64
+	mu          sync.Mutex
+
+	started sync.Once
+	stopped sync.Once
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newFeeUpdateNotifier creates a new feeUpdateNotifier that checks the fee
+// estimates returned by estimateFee at the passed interval.
+func newFeeUpdateNotifier(estimateFee func(uint32) (SatPerKWeight, error),
+	interval time.Duration) *feeUpdateNotifier {
+
+	return &feeUpdateNotifier{
+		estimateFee: estimateFee,
+		interval:    interval,
+		subscribers: make(map[uint64]*feeSubscriber),
+		quit:        make(chan struct{}),
+	}
+}
+
+// start launches the goroutine that checks the fee estimates.
+func (n *feeUpdateNotifier) start() {
+	n.started.Do(func() {
+		n.wg.Add(1)
+		go n.pollFees()
+	})
+}
+
+// stop signals the notifier to exit, and waits for it to do so.
+func (n *feeUpdateNotifier) stop() {
+	n.stopped.Do(func() {
+		close(n.quit)
+		n.wg.Wait()
+	})
+}
+
+// subscribe returns a subscription to changes of the fee estimate for the
+// passed confirmation target, relative to its current estimate.
+func (n *feeUpdateNotifier) subscribe(confTarget uint32) (*FeeSubscription,
+	error) {
+
+	feePerKw, err := n.estimateFee(confTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+
+	sub := &feeSubscriber{
+		confTarget: confTarget,
+		lastFee:    feePerKw,
+		updates:    make(chan SatPerKWeight, 1),
+	}
+	n.subscribers[id] = sub
+
+	return &FeeSubscription{
+		Updates: sub.updates,
+		Cancel: func() {
+			n.mu.Lock()
+			delete(n.subscribers, id)
+			n.mu.Unlock()
+		},
+	}, nil
+}
+
+// pollFees checks the fee estimates of all subscribers at each interval.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *feeUpdateNotifier) pollFees() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			n.notifySubscribers()
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// notifySubscribers queries the fee estimate for each distinct confirmation
+// target among the subscribers, and sends it to those whose estimate changed.
+func (n *feeUpdateNotifier) notifySubscribers() {
+	n.mu.Lock()
+	confTargets := make(map[uint32]struct{})
+	for _, sub := range n.subscribers {
+		confTargets[sub.confTarget] = struct{}{}
+	}
+	n.mu.Unlock()
+
+	// We'll query the estimates without holding the mutex, as they may
+	// require a round trip to the backend.
+	estimates := make(map[uint32]SatPerKWeight, len(confTargets))
+	for confTarget := range confTargets {
+		feePerKw, err := n.estimateFee(confTarget)
+		if err != nil {
+			walletLog.Errorf("Unable to estimate fee for conf "+
+				"target of %v: %v", confTarget, err)
+			continue
+		}
+		estimates[confTarget] = feePerKw
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, sub := range n.subscribers {
+		feePerKw, ok := estimates[sub.confTarget]
+		if !ok || feePerKw == sub.lastFee {
+			continue
+		}
+		sub.lastFee = feePerKw
+
+		// If the subscriber hasn't received the previous update yet,
+		// we'll replace it with the latest one. This is safe as we're
+		// the only sender, and hold the mutex.
+		select {
+		case <-sub.updates:
+		default:
+		}
+		sub.updates <- feePerKw
+	}
+}
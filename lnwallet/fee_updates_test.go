@@ -0,0 +1,81 @@
+package lnwallet
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestFeeUpdateNotifier tests that subscribers are notified of changes to the
+// fee estimate of their confirmation target only.
+func TestFeeUpdateNotifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		feeRates = map[uint32]SatPerKWeight{2: 2000, 6: 1000}
+		mu       sync.Mutex
+	)
+	estimateFee := func(confTarget uint32) (SatPerKWeight, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return feeRates[confTarget], nil
+	}
+	setFeeRate := func(confTarget uint32, feePerKw SatPerKWeight) {
+		mu.Lock()
+		feeRates[confTarget] = feePerKw
+		mu.Unlock()
+	}
+
+	notifier := newFeeUpdateNotifier(estimateFee, 10*time.Millisecond)
+	notifier.start()
+	defer notifier.stop()
+
+	sub2, err := notifier.subscribe(2)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub2.Cancel()
+	sub6, err := notifier.subscribe(6)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	assertNoUpdate := func(sub *FeeSubscription) {
+		t.Helper()
+
+		select {
+		case feePerKw := <-sub.Updates:
+			t.Fatalf("unexpected update to %v sat/kw", feePerKw)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	assertUpdate := func(sub *FeeSubscription, expected SatPerKWeight) {
+		t.Helper()
+
+		select {
+		case feePerKw := <-sub.Updates:
+			if feePerKw != expected {
+				t.Fatalf("expected update to %v sat/kw, got "+
+					"%v sat/kw", expected, feePerKw)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no update received")
+		}
+	}
+
+	// As long as the estimates don't change, no updates should be sent.
+	assertNoUpdate(sub2)
+	assertNoUpdate(sub6)
+
+	// A change of one target's estimate should only be sent to the
+	// subscribers of that target.
+	setFeeRate(6, 3000)
+	assertUpdate(sub6, 3000)
+	assertNoUpdate(sub2)
+
+	// Once cancelled, a subscription receives no further updates.
+	sub6.Cancel()
+	setFeeRate(6, 4000)
+	assertNoUpdate(sub6)
+}
@@ -0,0 +1,119 @@
+package lnwallet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// OutputType is a tag that uniquely identifies a kind of SerializableOutput.
+// Stores track the type of the outputs they persist, allowing them to
+// reconstruct the outputs using DecodeSpendableOutput.
+type OutputType uint16
+
+const (
+	// OutputTypeBreached is an output of a revoked commitment that we're
+	// entitled to sweep.
+	OutputTypeBreached OutputType = 1
+
+	// OutputTypeKid is a time locked output incubated by the utxo nursery.
+	OutputTypeKid OutputType = 2
+
+	// OutputTypeBaby is an outgoing HTLC on our commitment incubated by
+	// the utxo nursery, which first requires its timeout transaction to be
+	// broadcast.
+	OutputTypeBaby OutputType = 3
+)
+
+// SerializableOutput is an output that can be persisted by any subsystem,
+// and later reconstructed using the constructor registered for its
+// OutputType.
+type SerializableOutput interface {
+	// OutputType returns the tag identifying the kind of output.
+	OutputType() OutputType
+
+	// Encode writes the output to the passed io.Writer.
+	Encode(w io.Writer) error
+
+	// Decode reconstructs the output from the passed io.Reader.
+	Decode(r io.Reader) error
+}
+
+var (
+	// ErrUnknownOutputType is returned when attempting to decode an output
+	// whose type hasn't been registered.
+	ErrUnknownOutputType = fmt.Errorf("unknown output type")
+
+	// ErrOutputTypeRegistered is returned when attempting to register an
+	// output type for the second time.
+	ErrOutputTypeRegistered = fmt.Errorf("output type already registered")
+)
+
+var (
+	// outputTypes maps each registered output type to a function that
+	// returns a new, empty instance of the output to decode into.
+	outputTypes = make(map[OutputType]func() SerializableOutput)
+
+	outputTypesMtx sync.RWMutex
+)
+
+// RegisterOutputType registers the constructor for outputs of the passed
+// type, such that they can be decoded using DecodeSpendableOutput.
+func RegisterOutputType(outputType OutputType,
+	newOutput func() SerializableOutput) error {
+
+	outputTypesMtx.Lock()
+	defer outputTypesMtx.Unlock()
+
+	if _, ok := outputTypes[outputType]; ok {
+		return ErrOutputTypeRegistered
+	}
+	outputTypes[outputType] = newOutput
+
+	return nil
+}
+
+// DecodeSpendableOutput reconstructs an output of the passed type from r,
+// using the constructor registered for the type. The output is expected to
+// have been written using its Encode method.
+func DecodeSpendableOutput(outputType OutputType,
+	r io.Reader) (SerializableOutput, error) {
+
+	outputTypesMtx.RLock()
+	newOutput, ok := outputTypes[outputType]
+	outputTypesMtx.RUnlock()
+	if !ok {
+		return nil, ErrUnknownOutputType
+	}
+
+	output := newOutput()
+	if err := output.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// WriteOutPoint writes the passed outpoint to w as its 32 byte txid followed
+// by its big endian output index. This is the encoding shared by all stores
+// that persist the outputs they sweep.
+func WriteOutPoint(w io.Writer, op *wire.OutPoint) error {
+	if _, err := w.Write(op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, op.Index)
+}
+
+// ReadOutPoint reads an outpoint previously written using WriteOutPoint from
+// r.
+func ReadOutPoint(r io.Reader, op *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, binary.BigEndian, &op.Index)
+}
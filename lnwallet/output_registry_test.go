@@ -0,0 +1,102 @@
+package lnwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// testOutputType is the output type registered for mockOutput.
+const testOutputType OutputType = 0xffff
+
+// mockOutput is a minimal SerializableOutput used to test the registry.
+type mockOutput struct {
+	value uint64
+}
+
+func (m *mockOutput) OutputType() OutputType {
+	return testOutputType
+}
+
+func (m *mockOutput) Encode(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, m.value)
+}
+
+func (m *mockOutput) Decode(r io.Reader) error {
+	return binary.Read(r, binary.BigEndian, &m.value)
+}
+
+// TestOutputRegistry tests that registered outputs can be decoded, and that
+// unregistered output types are rejected.
+func TestOutputRegistry(t *testing.T) {
+	output := &mockOutput{value: 1337}
+
+	var b bytes.Buffer
+	if err := output.Encode(&b); err != nil {
+		t.Fatalf("unable to encode output: %v", err)
+	}
+
+	// Before the type is registered, we should be unable to decode it.
+	_, err := DecodeSpendableOutput(
+		testOutputType, bytes.NewReader(b.Bytes()),
+	)
+	if err != ErrUnknownOutputType {
+		t.Fatalf("expected ErrUnknownOutputType, got %v", err)
+	}
+
+	err = RegisterOutputType(testOutputType, func() SerializableOutput {
+		return &mockOutput{}
+	})
+	if err != nil {
+		t.Fatalf("unable to register output type: %v", err)
+	}
+
+	// Registering the same type a second time should fail.
+	err = RegisterOutputType(testOutputType, func() SerializableOutput {
+		return &mockOutput{}
+	})
+	if err != ErrOutputTypeRegistered {
+		t.Fatalf("expected ErrOutputTypeRegistered, got %v", err)
+	}
+
+	decoded, err := DecodeSpendableOutput(testOutputType, &b)
+	if err != nil {
+		t.Fatalf("unable to decode output: %v", err)
+	}
+	decodedOutput, ok := decoded.(*mockOutput)
+	if !ok {
+		t.Fatalf("expected *mockOutput, got %T", decoded)
+	}
+	if decodedOutput.value != output.value {
+		t.Fatalf("expected value %v, got %v", output.value,
+			decodedOutput.value)
+	}
+}
+
+// TestOutPointCodec tests that an outpoint written using WriteOutPoint is read
+// back unchanged by ReadOutPoint, using its fixed size encoding.
+func TestOutPointCodec(t *testing.T) {
+	op := wire.OutPoint{
+		Hash:  [32]byte{0x01, 0x02, 0x03},
+		Index: 7,
+	}
+
+	var b bytes.Buffer
+	if err := WriteOutPoint(&b, &op); err != nil {
+		t.Fatalf("unable to write outpoint: %v", err)
+	}
+	if b.Len() != 36 {
+		t.Fatalf("expected 36 byte outpoint, got %v", b.Len())
+	}
+
+	var decoded wire.OutPoint
+	if err := ReadOutPoint(&b, &decoded); err != nil {
+		t.Fatalf("unable to read outpoint: %v", err)
+	}
+	if decoded != op {
+		t.Fatalf("expected outpoint %v, got %v", op, decoded)
+	}
+}
@@ -0,0 +1,317 @@
+package lnwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// psbtMagic is the magic prefix of a serialized BIP 174 partially signed
+// bitcoin transaction.
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+const (
+	// psbtGlobalUnsignedTx is the key type of the unsigned transaction
+	// within the global map of a PSBT.
+	psbtGlobalUnsignedTx = 0x00
+
+	// psbtInWitnessUtxo is the key type of the output spent by a segwit
+	// input.
+	psbtInWitnessUtxo = 0x01
+
+	// psbtInPartialSig is the key type of a signature of an input, whose
+	// key also holds the public key the signature is valid for.
+	psbtInPartialSig = 0x02
+
+	// psbtInSighashType is the key type of the sighash type an input
+	// should be signed with.
+	psbtInSighashType = 0x03
+
+	// psbtInWitnessScript is the key type of the witness script of an
+	// input.
+	psbtInWitnessScript = 0x05
+
+	// psbtInFinalScriptWitness is the key type of the complete witness of
+	// a finalized input.
+	psbtInFinalScriptWitness = 0x08
+
+	// maxPsbtPairSize is the largest key or value of a PSBT map we'll read
+	// when decoding a PSBT.
+	maxPsbtPairSize = wire.MaxMessagePayload
+)
+
+// PsbtInput holds the data a signer needs to sign an input of a PSBT.
+type PsbtInput struct {
+	// WitnessUtxo is the segwit output spent by the input.
+	WitnessUtxo *wire.TxOut
+
+	// WitnessScript is the witness script of the output, if it's a P2WSH
+	// output.
+	WitnessScript []byte
+
+	// SigHashType is the sighash type the input should be signed with.
+	SigHashType txscript.SigHashType
+}
+
+// EncodePsbt serializes the passed unsigned transaction as a BIP 174 partially
+// signed bitcoin transaction, with the passed data for each of its inputs. The
+// result can be handed to external tools to sign and finalize the
+// transaction.
+func EncodePsbt(tx *wire.MsgTx, inputs []PsbtInput) ([]byte, error) {
+	if len(inputs) != len(tx.TxIn) {
+		return nil, fmt.Errorf("psbt has %v inputs, but %v were "+
+			"described", len(tx.TxIn), len(inputs))
+	}
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return nil, fmt.Errorf("psbt transaction must be " +
+				"unsigned")
+		}
+	}
+
+	var b bytes.Buffer
+	b.Write(psbtMagic)
+
+	var txBuf bytes.Buffer
+	if err := tx.SerializeNoWitness(&txBuf); err != nil {
+		return nil, err
+	}
+	err := writePsbtPair(&b, psbtGlobalUnsignedTx, txBuf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	b.WriteByte(0x00)
+
+	for _, input := range inputs {
+		if input.WitnessUtxo != nil {
+			var utxo bytes.Buffer
+			err := wire.WriteTxOut(&utxo, 0, 0, input.WitnessUtxo)
+			if err != nil {
+				return nil, err
+			}
+			err = writePsbtPair(&b, psbtInWitnessUtxo, utxo.Bytes())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if input.SigHashType != 0 {
+			var sigHash [4]byte
+			binary.LittleEndian.PutUint32(
+				sigHash[:], uint32(input.SigHashType),
+			)
+			err := writePsbtPair(&b, psbtInSighashType, sigHash[:])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(input.WitnessScript) != 0 {
+			err := writePsbtPair(
+				&b, psbtInWitnessScript, input.WitnessScript,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		b.WriteByte(0x00)
+	}
+
+	// We don't have any data to add for the outputs, so each of their
+	// maps only consists of the separator.
+	for range tx.TxOut {
+		b.WriteByte(0x00)
+	}
+
+	return b.Bytes(), nil
+}
+
+// writePsbtPair writes a key-value pair of a PSBT map, whose key consists of
+// just the passed key type.
+func writePsbtPair(w io.Writer, keyType byte, value []byte) error {
+	if err := wire.WriteVarBytes(w, 0, []byte{keyType}); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// NewSweepPsbt serializes the passed unsigned sweep transaction as a PSBT,
+// describing each of its inputs using the sign descriptor of the output it
+// spends, such that it can be signed by an external signer. The key
+// derivation and tweak of each input aren't part of the PSBT, and must be
+// handed to the signer separately.
+func NewSweepPsbt(tx *wire.MsgTx, signDescs []*SignDescriptor) ([]byte, error) {
+	inputs := make([]PsbtInput, 0, len(signDescs))
+	for _, signDesc := range signDescs {
+		inputs = append(inputs, PsbtInput{
+			WitnessUtxo:   signDesc.Output,
+			WitnessScript: signDesc.WitnessScript,
+			SigHashType:   signDesc.HashType,
+		})
+	}
+
+	return EncodePsbt(tx, inputs)
+}
+
+// PsbtPartialSig is a signature of an input found within a PSBT.
+type PsbtPartialSig struct {
+	// PubKey is the public key the signature is valid for.
+	PubKey *btcec.PublicKey
+
+	// Signature is the DER encoded signature, followed by its sighash
+	// flag.
+	Signature []byte
+}
+
+// SignedPsbtInput holds the signing data an external signer added to an
+// input of a PSBT.
+type SignedPsbtInput struct {
+	// PartialSigs are the signatures of the input.
+	PartialSigs []PsbtPartialSig
+
+	// FinalWitness is the complete witness of the input, if the signer
+	// finalized it.
+	FinalWitness wire.TxWitness
+}
+
+// DecodeSignedPsbt parses a PSBT previously created for the passed unsigned
+// transaction, and returns the signing data of each of its inputs. An error
+// is returned if the PSBT is for a different transaction. Any data we don't
+// know about is ignored.
+func DecodeSignedPsbt(psbt []byte, tx *wire.MsgTx) ([]SignedPsbtInput, error) {
+	r := bytes.NewReader(psbt)
+
+	magic := make([]byte, len(psbtMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, psbtMagic) {
+		return nil, fmt.Errorf("invalid psbt magic: %x", magic)
+	}
+
+	// The global map must hold the unsigned transaction, which must be
+	// the one we're expecting signatures for.
+	var unsignedTx *wire.MsgTx
+	err := readPsbtMap(r, func(key, value []byte) error {
+		if key[0] != psbtGlobalUnsignedTx {
+			return nil
+		}
+
+		unsignedTx = &wire.MsgTx{}
+		return unsignedTx.DeserializeNoWitness(bytes.NewReader(value))
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case unsignedTx == nil:
+		return nil, fmt.Errorf("psbt lacks unsigned transaction")
+
+	case unsignedTx.TxHash() != tx.TxHash():
+		return nil, fmt.Errorf("psbt is for transaction %v, expected "+
+			"%v", unsignedTx.TxHash(), tx.TxHash())
+	}
+
+	inputs := make([]SignedPsbtInput, len(tx.TxIn))
+	for i := range inputs {
+		input := &inputs[i]
+		err := readPsbtMap(r, func(key, value []byte) error {
+			switch key[0] {
+			case psbtInPartialSig:
+				pubKey, err := btcec.ParsePubKey(
+					key[1:], btcec.S256(),
+				)
+				if err != nil {
+					return err
+				}
+				if len(value) == 0 {
+					return fmt.Errorf("empty partial " +
+						"signature")
+				}
+
+				input.PartialSigs = append(
+					input.PartialSigs, PsbtPartialSig{
+						PubKey:    pubKey,
+						Signature: value,
+					},
+				)
+
+			case psbtInFinalScriptWitness:
+				witness, err := readPsbtWitness(value)
+				if err != nil {
+					return err
+				}
+				input.FinalWitness = witness
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read psbt input %v: %v",
+				i, err)
+		}
+	}
+
+	return inputs, nil
+}
+
+// readPsbtMap reads the key-value pairs of a PSBT map from r, up until its
+// separator, and hands each of them to the passed function. Keys are never
+// empty, as an empty key marks the separator.
+func readPsbtMap(r io.Reader, handlePair func(key, value []byte) error) error {
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxPsbtPairSize, "psbt key")
+		if err != nil {
+			return err
+		}
+		if len(key) == 0 {
+			return nil
+		}
+
+		value, err := wire.ReadVarBytes(
+			r, 0, maxPsbtPairSize, "psbt value",
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := handlePair(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+// readPsbtWitness parses a witness serialized within a PSBT, which uses the
+// same encoding as the witness of a transaction input.
+func readPsbtWitness(value []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(value)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if numItems > uint64(len(value)) {
+		return nil, fmt.Errorf("witness of %v items exceeds its size",
+			numItems)
+	}
+
+	witness := make(wire.TxWitness, 0, numItems)
+	for i := uint64(0); i < numItems; i++ {
+		item, err := wire.ReadVarBytes(
+			r, 0, maxPsbtPairSize, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+		witness = append(witness, item)
+	}
+
+	return witness, nil
+}
@@ -0,0 +1,158 @@
+package lnwallet
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// PsbtSigner is a Signer that hands out the signatures an external signer
+// added to a PSBT, rather than signing itself. This allows the witnesses of a
+// transaction signed externally to be assembled using the same witness
+// generators as those signed in-process.
+//
+// NOTE: The sweeps crafted by the utxo nursery, the breach arbiter and the
+// contract court are always signed in-process, as the PSBTs describing them
+// lack the key derivation and tweak an external signer would need to sign
+// their inputs. Signing through PSBTs is meant for sweeps handed to the user
+// along with that data, such as those of a recovery pack.
+type PsbtSigner struct {
+	inputs []SignedPsbtInput
+}
+
+// NewPsbtSigner creates a new PsbtSigner handing out the signatures of the
+// passed PSBT inputs.
+func NewPsbtSigner(inputs []SignedPsbtInput) *PsbtSigner {
+	return &PsbtSigner{
+		inputs: inputs,
+	}
+}
+
+// A compile time check to ensure PsbtSigner implements the Signer interface.
+var _ Signer = (*PsbtSigner)(nil)
+
+// SignOutputRaw returns the signature of the input described by the passed
+// sign descriptor, which must be valid for the key the descriptor signs
+// with. If the descriptor lacks its public key, the input must carry a single
+// signature.
+//
+// NOTE: This is part of the Signer interface.
+func (p *PsbtSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *SignDescriptor) ([]byte, error) {
+
+	if signDesc.InputIndex >= len(p.inputs) {
+		return nil, fmt.Errorf("psbt lacks input %v",
+			signDesc.InputIndex)
+	}
+	input := p.inputs[signDesc.InputIndex]
+
+	var sig []byte
+	signingKey := signDescSigningKey(signDesc)
+	for _, partialSig := range input.PartialSigs {
+		switch {
+		case signingKey != nil && partialSig.PubKey.IsEqual(signingKey):
+			sig = partialSig.Signature
+
+		case signingKey == nil && len(input.PartialSigs) == 1:
+			sig = partialSig.Signature
+		}
+	}
+	if sig == nil {
+		return nil, fmt.Errorf("psbt lacks signature of input %v",
+			signDesc.InputIndex)
+	}
+
+	// The witness generators append the sighash flag themselves, so we'll
+	// strip it after making sure it's the one they expect.
+	sigHashType := txscript.SigHashType(sig[len(sig)-1])
+	if sigHashType != signDesc.HashType {
+		return nil, fmt.Errorf("signature of input %v has sighash "+
+			"type %v, expected %v", signDesc.InputIndex,
+			sigHashType, signDesc.HashType)
+	}
+
+	return sig[:len(sig)-1], nil
+}
+
+// ComputeInputScript returns the final witness of the input described by the
+// passed sign descriptor, if the external signer finalized it.
+//
+// NOTE: This is part of the Signer interface.
+func (p *PsbtSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *SignDescriptor) (*InputScript, error) {
+
+	if signDesc.InputIndex >= len(p.inputs) {
+		return nil, fmt.Errorf("psbt lacks input %v",
+			signDesc.InputIndex)
+	}
+
+	witness := p.inputs[signDesc.InputIndex].FinalWitness
+	if witness == nil {
+		return nil, fmt.Errorf("psbt input %v isn't finalized",
+			signDesc.InputIndex)
+	}
+
+	return &InputScript{
+		Witness: witness,
+	}, nil
+}
+
+// signDescSigningKey returns the public key of the private key that signs
+// for the passed sign descriptor, taking its tweaks into account. Nil is
+// returned if the descriptor lacks its public key.
+func signDescSigningKey(signDesc *SignDescriptor) *btcec.PublicKey {
+	pubKey := signDesc.KeyDesc.PubKey
+	switch {
+	case pubKey == nil:
+		return nil
+
+	case signDesc.SingleTweak != nil:
+		return TweakPubKeyWithTweak(pubKey, signDesc.SingleTweak)
+
+	case signDesc.DoubleTweak != nil:
+		return DeriveRevocationPubkey(
+			pubKey, signDesc.DoubleTweak.PubKey(),
+		)
+	}
+
+	return pubKey
+}
+
+// AddPsbtWitnesses attaches a witness to each input of the passed sweep
+// transaction, using the signatures of the PSBT the transaction was signed
+// with externally. The inputs are the outputs spent by the transaction, in
+// the order of its inputs. Inputs finalized by the signer use the witness it
+// assembled, while the witnesses of all others are generated as they would
+// be when signing in-process.
+func AddPsbtWitnesses(tx *wire.MsgTx, psbt []byte,
+	inputs []SpendableOutput) error {
+
+	if len(inputs) != len(tx.TxIn) {
+		return fmt.Errorf("transaction has %v inputs, but %v were "+
+			"described", len(tx.TxIn), len(inputs))
+	}
+
+	signedInputs, err := DecodeSignedPsbt(psbt, tx)
+	if err != nil {
+		return err
+	}
+	signer := NewPsbtSigner(signedInputs)
+
+	hashCache := txscript.NewTxSigHashes(tx)
+	for i, input := range inputs {
+		if witness := signedInputs[i].FinalWitness; witness != nil {
+			tx.TxIn[i].Witness = witness
+			continue
+		}
+
+		witness, err := input.BuildWitness(signer, tx, hashCache, i)
+		if err != nil {
+			return err
+		}
+		tx.TxIn[i].Witness = witness
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package lnwallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// TestEncodePsbt tests that an unsigned transaction is serialized as a BIP 174
+// PSBT, carrying the data of its inputs.
+func TestEncodePsbt(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+		Sequence:         144,
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x00, 0x14}})
+
+	utxo := &wire.TxOut{Value: 2000, PkScript: []byte{0x00, 0x20}}
+	witnessScript := []byte{0x51}
+	psbt, err := EncodePsbt(tx, []PsbtInput{{
+		WitnessUtxo:   utxo,
+		WitnessScript: witnessScript,
+		SigHashType:   txscript.SigHashAll,
+	}})
+	if err != nil {
+		t.Fatalf("unable to encode psbt: %v", err)
+	}
+
+	var txBuf bytes.Buffer
+	if err := tx.SerializeNoWitness(&txBuf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	var utxoBuf bytes.Buffer
+	if err := wire.WriteTxOut(&utxoBuf, 0, 0, utxo); err != nil {
+		t.Fatalf("unable to serialize utxo: %v", err)
+	}
+
+	var expected bytes.Buffer
+	expected.Write([]byte("psbt\xff"))
+	expected.Write([]byte{0x01, 0x00, byte(txBuf.Len())})
+	expected.Write(txBuf.Bytes())
+	expected.WriteByte(0x00)
+	expected.Write([]byte{0x01, 0x01, byte(utxoBuf.Len())})
+	expected.Write(utxoBuf.Bytes())
+	expected.Write([]byte{0x01, 0x03, 0x04, 0x01, 0x00, 0x00, 0x00})
+	expected.Write([]byte{0x01, 0x05, 0x01, 0x51})
+	expected.WriteByte(0x00)
+	expected.WriteByte(0x00)
+
+	if !bytes.Equal(psbt, expected.Bytes()) {
+		t.Fatalf("unexpected psbt: expected %x, got %x",
+			expected.Bytes(), psbt)
+	}
+
+	// A transaction whose inputs don't match those described, or that's
+	// already signed, should be rejected.
+	if _, err := EncodePsbt(tx, nil); err == nil {
+		t.Fatalf("expected psbt with undescribed input to be rejected")
+	}
+	tx.TxIn[0].Witness = wire.TxWitness{{0x01}}
+	if _, err := EncodePsbt(tx, []PsbtInput{{}}); err == nil {
+		t.Fatalf("expected psbt of signed tx to be rejected")
+	}
+}
+
+// psbtTestOutput is a SpendableOutput whose witness is generated according to
+// its witness type.
+type psbtTestOutput struct {
+	mockSpendableOutput
+
+	signDesc SignDescriptor
+}
+
+func (o *psbtTestOutput) SignDesc() *SignDescriptor {
+	return &o.signDesc
+}
+
+func (o *psbtTestOutput) BuildWitness(signer Signer, txn *wire.MsgTx,
+	hashCache *txscript.TxSigHashes, txinIdx int) ([][]byte, error) {
+
+	genWitness := CommitmentTimeLock.GenWitnessFunc(signer, &o.signDesc)
+	return genWitness(txn, hashCache, txinIdx)
+}
+
+// TestAddPsbtWitnesses tests that the signatures an external signer added to
+// the PSBT of a sweep are assembled into valid witnesses.
+func TestAddPsbtWitnesses(t *testing.T) {
+	t.Parallel()
+
+	basePriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	commitPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// The output pays to our delayed key, which is tweaked by the
+	// commitment point.
+	tweak := SingleTweakBytes(commitPriv.PubKey(), basePriv.PubKey())
+	delayKey := TweakPubKeyWithTweak(basePriv.PubKey(), tweak)
+	witnessScript, err := CommitScriptToSelf(
+		144, delayKey, commitPriv.PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	pkScript, err := WitnessScriptHash(witnessScript)
+	if err != nil {
+		t.Fatalf("unable to create pkscript: %v", err)
+	}
+
+	output := &psbtTestOutput{
+		signDesc: SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: basePriv.PubKey(),
+			},
+			SingleTweak:   tweak,
+			WitnessScript: witnessScript,
+			Output: &wire.TxOut{
+				Value:    100000,
+				PkScript: pkScript,
+			},
+			HashType: txscript.SigHashAll,
+		},
+	}
+
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{Sequence: 144})
+	sweepTx.AddTxOut(&wire.TxOut{Value: 90000, PkScript: []byte{0x51}})
+
+	psbt, err := NewSweepPsbt(sweepTx, []*SignDescriptor{&output.signDesc})
+	if err != nil {
+		t.Fatalf("unable to create psbt: %v", err)
+	}
+
+	// Without any signatures, the witness can't be assembled.
+	inputs := []SpendableOutput{output}
+	if err := AddPsbtWitnesses(sweepTx, psbt, inputs); err == nil {
+		t.Fatalf("expected unsigned psbt to be rejected")
+	}
+
+	// Act as the external signer, and add our signature to the input map,
+	// which is followed by the separators of the input and output maps.
+	hashCache := txscript.NewTxSigHashes(sweepTx)
+	sig, err := txscript.RawTxInWitnessSignature(
+		sweepTx, hashCache, 0, output.signDesc.Output.Value,
+		witnessScript, txscript.SigHashAll,
+		TweakPrivKey(basePriv, tweak),
+	)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	var pair bytes.Buffer
+	key := append([]byte{psbtInPartialSig}, delayKey.SerializeCompressed()...)
+	if err := wire.WriteVarBytes(&pair, 0, key); err != nil {
+		t.Fatalf("unable to write key: %v", err)
+	}
+	if err := wire.WriteVarBytes(&pair, 0, sig); err != nil {
+		t.Fatalf("unable to write signature: %v", err)
+	}
+	signedPsbt := append([]byte{}, psbt[:len(psbt)-2]...)
+	signedPsbt = append(signedPsbt, pair.Bytes()...)
+	signedPsbt = append(signedPsbt, 0x00, 0x00)
+
+	// The PSBT of a different transaction should be rejected.
+	otherTx := sweepTx.Copy()
+	otherTx.TxOut[0].Value--
+	if err := AddPsbtWitnesses(otherTx, signedPsbt, inputs); err == nil {
+		t.Fatalf("expected psbt of other transaction to be rejected")
+	}
+
+	if err := AddPsbtWitnesses(sweepTx, signedPsbt, inputs); err != nil {
+		t.Fatalf("unable to add witnesses: %v", err)
+	}
+	vm, err := txscript.NewEngine(
+		pkScript, sweepTx, 0, txscript.StandardVerifyFlags, nil,
+		nil, output.signDesc.Output.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("assembled witness is invalid: %v", err)
+	}
+}
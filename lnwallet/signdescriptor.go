@@ -15,6 +15,36 @@ var (
 	// ErrTweakOverdose signals a SignDescriptor is invalid because both of its
 	// SingleTweak and DoubleTweak are non-nil.
 	ErrTweakOverdose = errors.New("sign descriptor should only have one tweak")
+
+	// ErrUnknownSignDescriptorVersion is returned when decoding a
+	// SignDescriptor serialized using a newer version of its format.
+	ErrUnknownSignDescriptorVersion = errors.New("unknown sign descriptor " +
+		"version")
+)
+
+const (
+	// signDescriptorVersionMarker marks the start of a versioned
+	// SignDescriptor, with the version held in its last byte. The original
+	// format has no version, and instead starts with the key family of the
+	// descriptor. As key families are small integers, they never collide
+	// with the marker, which allows us to decode both formats.
+	signDescriptorVersionMarker uint32 = 0xffffff00
+
+	// signDescriptorVersionMask masks out the version of a versioned
+	// SignDescriptor, leaving its marker.
+	signDescriptorVersionMask uint32 = 0xffffff00
+
+	// SignDescriptorVersionLegacy is the original, unversioned format of a
+	// serialized SignDescriptor.
+	SignDescriptorVersionLegacy uint8 = 1
+
+	// SignDescriptorVersionTaproot extends the legacy format with the
+	// TaprootMerkleRoot and AuxData of the SignDescriptor.
+	SignDescriptorVersionTaproot uint8 = 2
+
+	// maxAuxDataSize is the largest AuxData we'll read when decoding a
+	// SignDescriptor.
+	maxAuxDataSize = 65535
 )
 
 // SignDescriptor houses the necessary information required to successfully sign
@@ -76,15 +106,48 @@ type SignDescriptor struct {
 	// InputIndex is the target input within the transaction that should be
 	// signed.
 	InputIndex int
+
+	// TaprootMerkleRoot is the root of the tap tree committed to by the
+	// taproot output being signed, which is needed to tweak the internal
+	// key when spending through the key path.
+	//
+	// NOTE: This value is nil for outputs that aren't taproot outputs, or
+	// that commit to no scripts.
+	TaprootMerkleRoot []byte
+
+	// AuxData holds arbitrary data a subsystem needs to persist along with
+	// the descriptor in order to sign the output later on, such as data
+	// particular to the type of channel the output originates from.
+	AuxData []byte
 }
 
 // WriteSignDescriptor serializes a SignDescriptor struct into the passed
-// io.Writer stream.
+// io.Writer stream, using the latest version of the format.
 //
 // NOTE: We assume the SigHashes and InputIndex fields haven't been assigned
 // yet, since that is usually done just before broadcast by the witness
 // generator.
 func WriteSignDescriptor(w io.Writer, sd *SignDescriptor) error {
+	header := signDescriptorVersionMarker |
+		uint32(SignDescriptorVersionTaproot)
+	if err := binary.Write(w, binary.BigEndian, header); err != nil {
+		return err
+	}
+
+	if err := writeLegacySignDescriptor(w, sd); err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarBytes(w, 0, sd.TaprootMerkleRoot); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, sd.AuxData)
+}
+
+// writeLegacySignDescriptor serializes the fields of a SignDescriptor covered
+// by the original, unversioned format into the passed io.Writer stream.
+func writeLegacySignDescriptor(w io.Writer, sd *SignDescriptor) error {
 	err := binary.Write(w, binary.BigEndian, sd.KeyDesc.Family)
 	if err != nil {
 		return err
@@ -136,13 +199,72 @@ func WriteSignDescriptor(w io.Writer, sd *SignDescriptor) error {
 }
 
 // ReadSignDescriptor deserializes a SignDescriptor struct from the passed
-// io.Reader stream.
+// io.Reader stream. Both the legacy and the versioned formats are accepted,
+// such that descriptors persisted by earlier versions can still be read.
 func ReadSignDescriptor(r io.Reader, sd *SignDescriptor) error {
-	err := binary.Read(r, binary.BigEndian, &sd.KeyDesc.Family)
-	if err != nil {
+	// The first four bytes either hold the version marker, or the key
+	// family of a descriptor in the legacy format.
+	var header uint32
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
 		return err
 	}
-	err = binary.Read(r, binary.BigEndian, &sd.KeyDesc.Index)
+
+	version := SignDescriptorVersionLegacy
+	if header&signDescriptorVersionMask == signDescriptorVersionMarker {
+		version = uint8(header)
+		if version != SignDescriptorVersionTaproot {
+			return ErrUnknownSignDescriptorVersion
+		}
+
+		err := binary.Read(r, binary.BigEndian, &sd.KeyDesc.Family)
+		if err != nil {
+			return err
+		}
+	} else {
+		sd.KeyDesc.Family = keychain.KeyFamily(header)
+	}
+
+	switch version {
+	case SignDescriptorVersionLegacy:
+		return readLegacySignDescriptor(r, sd)
+
+	case SignDescriptorVersionTaproot:
+		if err := readLegacySignDescriptor(r, sd); err != nil {
+			return err
+		}
+
+		merkleRoot, err := wire.ReadVarBytes(
+			r, 0, 32, "taprootMerkleRoot",
+		)
+		if err != nil {
+			return err
+		}
+		if len(merkleRoot) != 0 {
+			sd.TaprootMerkleRoot = merkleRoot
+		}
+
+		auxData, err := wire.ReadVarBytes(
+			r, 0, maxAuxDataSize, "auxData",
+		)
+		if err != nil {
+			return err
+		}
+		if len(auxData) != 0 {
+			sd.AuxData = auxData
+		}
+
+		return nil
+
+	default:
+		return ErrUnknownSignDescriptorVersion
+	}
+}
+
+// readLegacySignDescriptor deserializes the fields of a SignDescriptor covered
+// by the original, unversioned format from the passed io.Reader stream, after
+// its key family has been read.
+func readLegacySignDescriptor(r io.Reader, sd *SignDescriptor) error {
+	err := binary.Read(r, binary.BigEndian, &sd.KeyDesc.Index)
 	if err != nil {
 		return err
 	}
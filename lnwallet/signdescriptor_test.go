@@ -127,3 +127,73 @@ func TestSignDescriptorSerialization(t *testing.T) {
 		}
 	}
 }
+
+// TestSignDescriptorVersions tests that sign descriptors carrying taproot
+// fields survive serialization, that descriptors serialized in the legacy
+// format can still be read, and that unknown versions are rejected.
+func TestSignDescriptorVersions(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	sd := &SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyDelayBase,
+				Index:  7,
+			},
+			PubKey: privKey.PubKey(),
+		},
+		DoubleTweak:   privKey,
+		WitnessScript: []byte{0x51},
+		Output: &wire.TxOut{
+			Value:    1000,
+			PkScript: []byte{0x51, 0x20},
+		},
+		HashType:          txscript.SigHashAll,
+		TaprootMerkleRoot: bytes.Repeat([]byte{0x01}, 32),
+		AuxData:           []byte{0x02, 0x03},
+	}
+
+	var b bytes.Buffer
+	if err := WriteSignDescriptor(&b, sd); err != nil {
+		t.Fatalf("unable to serialize sign descriptor: %v", err)
+	}
+	var decoded SignDescriptor
+	if err := ReadSignDescriptor(&b, &decoded); err != nil {
+		t.Fatalf("unable to deserialize sign descriptor: %v", err)
+	}
+	if !reflect.DeepEqual(sd, &decoded) {
+		t.Fatalf("expected %+v, got %+v", sd, decoded)
+	}
+
+	// A descriptor in the legacy format lacks the taproot fields, but
+	// should otherwise decode to the same descriptor.
+	b.Reset()
+	if err := writeLegacySignDescriptor(&b, sd); err != nil {
+		t.Fatalf("unable to serialize legacy sign descriptor: %v", err)
+	}
+	decoded = SignDescriptor{}
+	if err := ReadSignDescriptor(&b, &decoded); err != nil {
+		t.Fatalf("unable to deserialize legacy sign descriptor: %v",
+			err)
+	}
+	legacy := *sd
+	legacy.TaprootMerkleRoot = nil
+	legacy.AuxData = nil
+	if !reflect.DeepEqual(&legacy, &decoded) {
+		t.Fatalf("expected %+v, got %+v", legacy, decoded)
+	}
+
+	// A descriptor of a version we don't know should be rejected.
+	b.Reset()
+	b.Write([]byte{0xff, 0xff, 0xff, 0x03})
+	err = ReadSignDescriptor(&b, &SignDescriptor{})
+	if err != ErrUnknownSignDescriptorVersion {
+		t.Fatalf("expected ErrUnknownSignDescriptorVersion, got %v",
+			err)
+	}
+}
@@ -71,6 +71,16 @@ const (
 	//      - pubkey
 	P2PKHScriptSigSize = 1 + 73 + 1 + 33
 
+	// NestedP2WKHScriptSigSize 23 bytes
+	//      - OP_DATA: 1 byte (redeem script length)
+	//      - redeem script (p2wpkh): 22 bytes
+	NestedP2WKHScriptSigSize = 1 + P2WPKHSize
+
+	// NestedP2WSHScriptSigSize 35 bytes
+	//      - OP_DATA: 1 byte (redeem script length)
+	//      - redeem script (p2wsh): 34 bytes
+	NestedP2WSHScriptSigSize = 1 + P2WSHSize
+
 	// P2WKHWitnessSize 109 bytes
 	//      - number_of_witness_elements: 1 byte
 	//      - signature_length: 1 byte
@@ -79,6 +89,26 @@ const (
 	//      - pubkey
 	P2WKHWitnessSize = 1 + 1 + 73 + 1 + 33
 
+	// TaprootSignatureSize 65 bytes
+	//	- schnorr_signature: 64 bytes
+	//	- sighash_flag: 1 byte (omitted for SIGHASH_DEFAULT)
+	TaprootSignatureSize = 64 + 1
+
+	// TaprootKeySpendWitnessSize 67 bytes
+	//	- number_of_witness_elements: 1 byte
+	//	- signature_length: 1 byte
+	//	- signature
+	TaprootKeySpendWitnessSize = 1 + 1 + TaprootSignatureSize
+
+	// TaprootBaseControlBlockSize 33 bytes
+	//	- leaf_version_and_parity: 1 byte
+	//	- internal_key: 32 bytes
+	TaprootBaseControlBlockSize = 1 + 32
+
+	// TaprootControlBlockNodeSize 32 bytes
+	//	- merkle_path_node: 32 bytes (for each level of the tap tree)
+	TaprootControlBlockNodeSize = 32
+
 	// MultiSigSize 71 bytes
 	//	- OP_2: 1 byte
 	//	- OP_DATA: 1 byte (pubKeyAlice length)
@@ -376,6 +406,13 @@ func estimateCommitTxWeight(count int, prediction bool) int64 {
 	return htlcWeight + baseWeight + witnessWeight
 }
 
+// TaprootControlBlockSize returns the size of the control block revealing a
+// leaf at the passed depth of a tap tree. A leaf at depth zero is the only
+// leaf of the tree.
+func TaprootControlBlockSize(depth int) int {
+	return TaprootBaseControlBlockSize + depth*TaprootControlBlockNodeSize
+}
+
 // TxWeightEstimator is able to calculate weight estimates for transactions
 // based on the input and output types. For purposes of estimation, all
 // signatures are assumed to be of the maximum possible size, 73 bytes. Each
@@ -391,7 +428,8 @@ type TxWeightEstimator struct {
 }
 
 // AddP2PKHInput updates the weight estimate to account for an additional input
-// spending a P2PKH output.
+// spending a P2PKH output. If the transaction has any witness inputs, the
+// input still carries an empty witness, which is accounted for as well.
 func (twe *TxWeightEstimator) AddP2PKHInput() *TxWeightEstimator {
 	twe.inputSize += InputSize + P2PKHScriptSigSize
 	twe.inputWitnessSize++
@@ -420,12 +458,39 @@ func (twe *TxWeightEstimator) AddWitnessInput(witnessSize int) *TxWeightEstimato
 	return twe
 }
 
+// AddTaprootKeySpendInput updates the weight estimate to account for an
+// additional input spending a taproot output through its key path.
+func (twe *TxWeightEstimator) AddTaprootKeySpendInput() *TxWeightEstimator {
+	twe.AddWitnessInput(TaprootKeySpendWitnessSize)
+
+	return twe
+}
+
+// AddTaprootScriptSpendInput updates the weight estimate to account for an
+// additional input spending a taproot output through one of its script
+// leaves. The leaf witness size is the size of the witness elements
+// satisfying the leaf script, including their length prefixes. The number of
+// witness elements, the leaf script and the control block are accounted for
+// separately.
+func (twe *TxWeightEstimator) AddTaprootScriptSpendInput(leafWitnessSize,
+	leafScriptSize, controlBlockSize int) *TxWeightEstimator {
+
+	witnessSize := 1 + leafWitnessSize +
+		wire.VarIntSerializeSize(uint64(leafScriptSize)) +
+		leafScriptSize +
+		wire.VarIntSerializeSize(uint64(controlBlockSize)) +
+		controlBlockSize
+	twe.AddWitnessInput(witnessSize)
+
+	return twe
+}
+
 // AddNestedP2WKHInput updates the weight estimate to account for an additional
 // input spending a P2SH output with a nested P2WKH redeem script.
 func (twe *TxWeightEstimator) AddNestedP2WKHInput() *TxWeightEstimator {
-	twe.inputSize += InputSize + P2WPKHSize
+	twe.inputSize += InputSize + NestedP2WKHScriptSigSize
 	twe.inputWitnessSize += P2WKHWitnessSize
-	twe.inputSize++
+	twe.inputCount++
 	twe.hasWitness = true
 
 	return twe
@@ -434,9 +499,9 @@ func (twe *TxWeightEstimator) AddNestedP2WKHInput() *TxWeightEstimator {
 // AddNestedP2WSHInput updates the weight estimate to account for an additional
 // input spending a P2SH output with a nested P2WSH redeem script.
 func (twe *TxWeightEstimator) AddNestedP2WSHInput(witnessSize int) *TxWeightEstimator {
-	twe.inputSize += InputSize + P2WSHSize
+	twe.inputSize += InputSize + NestedP2WSHScriptSigSize
 	twe.inputWitnessSize += witnessSize
-	twe.inputSize++
+	twe.inputCount++
 	twe.hasWitness = true
 
 	return twe
@@ -62,6 +62,8 @@ func TestTxWeightEstimator(t *testing.T) {
 		numP2WSHInputs       int
 		numNestedP2WKHInputs int
 		numNestedP2WSHInputs int
+		numTaprootKeySpends  int
+		numTapscriptSpends   int
 		numP2PKHOutputs      int
 		numP2WKHOutputs      int
 		numP2WSHOutputs      int
@@ -103,6 +105,38 @@ func TestTxWeightEstimator(t *testing.T) {
 			numNestedP2WSHInputs: 1,
 			numP2WKHOutputs:      1,
 		},
+		{
+			numP2PKHInputs:       1,
+			numNestedP2WKHInputs: 1,
+			numP2WKHOutputs:      1,
+			numP2PKHOutputs:      1,
+		},
+		{
+			numP2PKHInputs:       2,
+			numNestedP2WKHInputs: 1,
+			numNestedP2WSHInputs: 1,
+			numP2SHOutputs:       1,
+		},
+		// The input count only takes up more than a single byte once
+		// there are more than 252 inputs.
+		{
+			numNestedP2WKHInputs: 253,
+			numP2WKHOutputs:      1,
+		},
+		{
+			numP2PKHInputs:       200,
+			numNestedP2WSHInputs: 53,
+			numP2WKHOutputs:      1,
+		},
+		{
+			numTaprootKeySpends: 1,
+			numP2WKHOutputs:     1,
+		},
+		{
+			numTaprootKeySpends: 1,
+			numTapscriptSpends:  2,
+			numP2WKHOutputs:     1,
+		},
 	}
 
 	for i, test := range testCases {
@@ -164,6 +198,30 @@ func TestTxWeightEstimator(t *testing.T) {
 
 			tx.AddTxIn(&wire.TxIn{SignatureScript: scriptSig, Witness: witness})
 		}
+		for j := 0; j < test.numTaprootKeySpends; j++ {
+			weightEstimate.AddTaprootKeySpendInput()
+
+			signature := make([]byte, lnwallet.TaprootSignatureSize)
+			witness := wire.TxWitness{signature}
+			tx.AddTxIn(&wire.TxIn{Witness: witness})
+		}
+		for j := 0; j < test.numTapscriptSpends; j++ {
+			// The leaf is satisfied by a single signature, and sits
+			// at depth two of the tap tree.
+			controlBlockSize := lnwallet.TaprootControlBlockSize(2)
+			weightEstimate.AddTaprootScriptSpendInput(
+				1+lnwallet.TaprootSignatureSize, 34,
+				controlBlockSize,
+			)
+
+			signature := make([]byte, lnwallet.TaprootSignatureSize)
+			leafScript := make([]byte, 34)
+			controlBlock := make([]byte, controlBlockSize)
+			witness := wire.TxWitness{
+				signature, leafScript, controlBlock,
+			}
+			tx.AddTxIn(&wire.TxIn{Witness: witness})
+		}
 		for j := 0; j < test.numP2PKHOutputs; j++ {
 			weightEstimate.AddP2PKHOutput()
 			tx.AddTxOut(&wire.TxOut{PkScript: p2pkhScript})
@@ -0,0 +1,129 @@
+package lnwallet
+
+import (
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// SpendableOutput is an interface which can be used by any subsystem to
+// construct a transaction spending from outputs we control.
+type SpendableOutput interface {
+	// Amount returns the number of satoshis contained within the output.
+	Amount() btcutil.Amount
+
+	// Outpoint returns the reference to the output being spent, used to
+	// construct the corresponding transaction input.
+	OutPoint() *wire.OutPoint
+
+	// WitnessType returns an enum specifying the type of witness that must
+	// be generated in order to spend this output.
+	WitnessType() WitnessType
+
+	// SignDesc returns a reference to a spendable output's sign descriptor,
+	// which is used during signing to compute a valid witness that spends
+	// this output.
+	SignDesc() *SignDescriptor
+
+	// BuildWitness returns a valid witness allowing this output to be
+	// spent, the witness should be attached to the transaction at the
+	// location determined by the given `txinIdx`.
+	BuildWitness(signer Signer, txn *wire.MsgTx,
+		hashCache *txscript.TxSigHashes,
+		txinIdx int) ([][]byte, error)
+
+	// RequiredSequence returns the sequence number the input spending the
+	// output must carry to satisfy its relative timelock (CSV). A value of
+	// zero means the output has no relative timelock.
+	RequiredSequence() uint32
+
+	// RequiredLockTime returns the absolute timelock (CLTV) of the output,
+	// which the lock time of the spending transaction must be at least,
+	// along with whether the output has an absolute timelock at all.
+	RequiredLockTime() (uint32, bool)
+
+	// ConfTarget returns the number of blocks within which a transaction
+	// spending the output should confirm. A value of zero means the output
+	// has no preference of its own.
+	ConfTarget() uint32
+
+	// Deadline returns the absolute height by which a transaction spending
+	// the output must confirm, as after it the output may be claimed by
+	// the remote party. A value of zero means there's no deadline.
+	Deadline() uint32
+}
+
+// NoDeadline can be embedded by implementations of SpendableOutput whose
+// outputs can be swept at leisure. It reports neither a confirmation target
+// nor a deadline, leaving the fee decision to the sweeping subsystem.
+type NoDeadline struct{}
+
+// ConfTarget returns zero, as the output has no preference of its own.
+func (NoDeadline) ConfTarget() uint32 {
+	return 0
+}
+
+// Deadline returns zero, as the output has no deadline.
+func (NoDeadline) Deadline() uint32 {
+	return 0
+}
+
+// deadlineOutput wraps a SpendableOutput, overriding its confirmation target
+// and deadline.
+type deadlineOutput struct {
+	SpendableOutput
+
+	confTarget uint32
+	deadline   uint32
+}
+
+// WithDeadline wraps the passed output such that it reports the passed
+// confirmation target and deadline, where zero means there's none. This
+// allows the caller to attach the urgency of an output it knows about to an
+// output that's unaware of it.
+func WithDeadline(output SpendableOutput, confTarget,
+	deadline uint32) SpendableOutput {
+
+	return &deadlineOutput{
+		SpendableOutput: output,
+		confTarget:      confTarget,
+		deadline:        deadline,
+	}
+}
+
+// ConfTarget returns the confirmation target the output was wrapped with.
+//
+// NOTE: Part of the SpendableOutput interface.
+func (o *deadlineOutput) ConfTarget() uint32 {
+	return o.confTarget
+}
+
+// Deadline returns the deadline the output was wrapped with.
+//
+// NOTE: Part of the SpendableOutput interface.
+func (o *deadlineOutput) Deadline() uint32 {
+	return o.deadline
+}
+
+// ForOutputs returns the fee preference adjusted for a transaction spending
+// the passed outputs. The confirmation target is lowered to the most
+// pressing one among the outputs, and likewise for the deadline, such that
+// the transaction confirms in time for each of them. An explicit FeeRate is
+// left untouched.
+func (p FeePreference) ForOutputs(outputs ...SpendableOutput) FeePreference {
+	for _, output := range outputs {
+		confTarget := output.ConfTarget()
+		if confTarget != 0 &&
+			(p.ConfTarget == 0 || confTarget < p.ConfTarget) {
+
+			p.ConfTarget = confTarget
+		}
+
+		deadline := output.Deadline()
+		if deadline != 0 && (p.Deadline == 0 || deadline < p.Deadline) {
+			p.Deadline = deadline
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,120 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// mockSpendableOutput is a minimal SpendableOutput without a deadline.
+type mockSpendableOutput struct {
+	NoDeadline
+}
+
+func (m *mockSpendableOutput) Amount() btcutil.Amount {
+	return 0
+}
+
+func (m *mockSpendableOutput) OutPoint() *wire.OutPoint {
+	return &wire.OutPoint{}
+}
+
+func (m *mockSpendableOutput) WitnessType() WitnessType {
+	return CommitmentTimeLock
+}
+
+func (m *mockSpendableOutput) SignDesc() *SignDescriptor {
+	return &SignDescriptor{}
+}
+
+func (m *mockSpendableOutput) BuildWitness(Signer, *wire.MsgTx,
+	*txscript.TxSigHashes, int) ([][]byte, error) {
+
+	return nil, nil
+}
+
+func (m *mockSpendableOutput) RequiredSequence() uint32 {
+	return 0
+}
+
+func (m *mockSpendableOutput) RequiredLockTime() (uint32, bool) {
+	return 0, false
+}
+
+// TestFeePreferenceForOutputs tests that the fee preference of a transaction
+// is adjusted to the most pressing confirmation target and deadline among the
+// outputs it spends.
+func TestFeePreferenceForOutputs(t *testing.T) {
+	t.Parallel()
+
+	leisurely := &mockSpendableOutput{}
+	if leisurely.ConfTarget() != 0 || leisurely.Deadline() != 0 {
+		t.Fatalf("expected output without deadline")
+	}
+
+	urgent := WithDeadline(leisurely, 2, 0)
+	expiring := WithDeadline(leisurely, 0, 500)
+	if urgent.ConfTarget() != 2 || expiring.Deadline() != 500 {
+		t.Fatalf("wrapped output lacks confirmation target or deadline")
+	}
+
+	testCases := []struct {
+		name     string
+		pref     FeePreference
+		outputs  []SpendableOutput
+		expected FeePreference
+	}{
+		{
+			name:     "no deadlines",
+			pref:     FeePreference{ConfTarget: 6},
+			outputs:  []SpendableOutput{leisurely},
+			expected: FeePreference{ConfTarget: 6},
+		},
+		{
+			name: "lower conf target",
+			pref: FeePreference{ConfTarget: 6},
+			outputs: []SpendableOutput{
+				leisurely, urgent,
+			},
+			expected: FeePreference{ConfTarget: 2},
+		},
+		{
+			name: "higher conf target",
+			pref: FeePreference{ConfTarget: 1},
+			outputs: []SpendableOutput{
+				urgent,
+			},
+			expected: FeePreference{ConfTarget: 1},
+		},
+		{
+			name: "earlier deadline",
+			pref: FeePreference{ConfTarget: 6, Deadline: 600},
+			outputs: []SpendableOutput{
+				expiring, WithDeadline(leisurely, 0, 700),
+			},
+			expected: FeePreference{ConfTarget: 6, Deadline: 500},
+		},
+		{
+			name: "deadline and conf target",
+			pref: FeePreference{FeeRate: 1000},
+			outputs: []SpendableOutput{
+				urgent, expiring,
+			},
+			expected: FeePreference{
+				FeeRate:    1000,
+				ConfTarget: 2,
+				Deadline:   500,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		pref := test.pref.ForOutputs(test.outputs...)
+		if pref != test.expected {
+			t.Fatalf("%v: expected %v, got %v", test.name,
+				test.expected, pref)
+		}
+	}
+}
@@ -174,6 +174,14 @@ type NurseryStore interface {
 	// the provided channel point, this method should only be called if
 	// IsMatureChannel indicates the channel is ready for removal.
 	RemoveChannel(*wire.OutPoint) error
+
+	// RemoveOutput erases an ungraduated output of the provided channel
+	// from both the channel and height indexes, such that it's no longer
+	// incubated. If the output belongs to a kindergarten class for which a
+	// sweep txn was finalized, then the txn is erased as well, as it can
+	// no longer confirm, and the height of the class is returned.
+	// Otherwise, zero is returned.
+	RemoveOutput(chanPoint, outpoint *wire.OutPoint) (uint32, error)
 }
 
 var (
@@ -436,6 +444,12 @@ func (ns *nurseryStore) PreschoolToKinder(kid *kidOutput) error {
 			return err
 		}
 
+		// If the output has since been removed, then there's nothing
+		// left to move.
+		if chanBucket.Get(pfxOutputKey) == nil {
+			return nil
+		}
+
 		// And remove the old serialized output from the database.
 		if err := chanBucket.Delete(pfxOutputKey); err != nil {
 			return err
@@ -544,8 +558,7 @@ func (ns *nurseryStore) GraduateKinder(height uint32) error {
 		// channel index.
 		return ns.forEachHeightPrefix(tx, kndrPrefix, height,
 			func(v []byte) error {
-				var kid kidOutput
-				err := kid.Decode(bytes.NewReader(v))
+				kid, err := decodeKidOutput(bytes.NewReader(v))
 				if err != nil {
 					return err
 				}
@@ -601,8 +614,8 @@ func (ns *nurseryStore) GraduateKinder(height uint32) error {
 
 // FinalizeKinder accepts a block height and a finalized kindergarten sweep
 // transaction, persisting the transaction at the appropriate height bucket. The
-// nursery store's last finalized height is also updated with the provided
-// height.
+// nursery store's last finalized height is also raised to the provided height,
+// if it's below it.
 func (ns *nurseryStore) FinalizeKinder(height uint32,
 	finalTx *wire.MsgTx) error {
 
@@ -648,13 +661,13 @@ func (ns *nurseryStore) FetchClass(
 				// stored with the crib prefix into babyOutputs,
 				// since this is the expected type that would
 				// have been serialized previously.
-				var baby babyOutput
 				babyReader := bytes.NewReader(buf)
-				if err := baby.Decode(babyReader); err != nil {
+				baby, err := decodeBabyOutput(babyReader)
+				if err != nil {
 					return err
 				}
 
-				babies = append(babies, baby)
+				babies = append(babies, *baby)
 
 				return nil
 
@@ -670,13 +683,13 @@ func (ns *nurseryStore) FetchClass(
 				// stored with the kindergarten prefix into
 				// kidOutputs, since this is the expected type
 				// that would have been serialized previously.
-				var kid kidOutput
 				kidReader := bytes.NewReader(buf)
-				if err := kid.Decode(kidReader); err != nil {
+				kid, err := decodeKidOutput(kidReader)
+				if err != nil {
 					return err
 				}
 
-				kids = append(kids, kid)
+				kids = append(kids, *kid)
 
 				return nil
 
@@ -741,16 +754,15 @@ func (ns *nurseryStore) FetchPreschools() ([]kidOutput, error) {
 				// Deserialize each output as a kidOutput, since
 				// this should have been the type that was
 				// serialized when it was written to disk.
-				var psclOutput kidOutput
 				psclReader := bytes.NewReader(v)
-				err := psclOutput.Decode(psclReader)
+				psclOutput, err := decodeKidOutput(psclReader)
 				if err != nil {
 					return err
 				}
 
 				// Add the deserialized output to our list of
 				// preschool outputs.
-				kids = append(kids, psclOutput)
+				kids = append(kids, *psclOutput)
 			}
 		}
 
@@ -916,8 +928,8 @@ func (ns *nurseryStore) RemoveChannel(chanPoint *wire.OutPoint) error {
 			copy(kndrKey[:4], kndrPrefix)
 
 			// Decode each to retrieve the output's maturity height.
-			var kid kidOutput
-			if err := kid.Decode(bytes.NewReader(v)); err != nil {
+			kid, err := decodeKidOutput(bytes.NewReader(v))
+			if err != nil {
 				return err
 			}
 
@@ -938,6 +950,109 @@ func (ns *nurseryStore) RemoveChannel(chanPoint *wire.OutPoint) error {
 	})
 }
 
+// RemoveOutput erases an ungraduated output of the provided channel from both
+// the channel and height indexes, such that it's no longer incubated. If the
+// output belongs to a kindergarten class for which a sweep txn was finalized,
+// then the txn is erased as well, as it can no longer confirm, and the height
+// of the class is returned. Otherwise, zero is returned.
+func (ns *nurseryStore) RemoveOutput(chanPoint,
+	outpoint *wire.OutPoint) (uint32, error) {
+
+	var classHeight uint32
+	err := ns.db.Update(func(tx *bolt.Tx) error {
+		chanBucket := ns.getChannelBucket(tx, chanPoint)
+		if chanBucket == nil {
+			return nil
+		}
+
+		// The output may be in any of the ungraduated states, so we'll
+		// look for it under each of their prefixes.
+		prefixes := [][]byte{psclPrefix, cribPrefix, kndrPrefix}
+		for _, prefix := range prefixes {
+			pfxOutputKey, err := prefixOutputKey(prefix, outpoint)
+			if err != nil {
+				return err
+			}
+
+			outputBytes := chanBucket.Get(pfxOutputKey)
+			if outputBytes == nil {
+				continue
+			}
+
+			// Determine the height at which the output is indexed,
+			// if any, before removing it from the channel index.
+			var height uint32
+			switch {
+			case bytes.Equal(prefix, cribPrefix):
+				var baby babyOutput
+				err := baby.Decode(bytes.NewReader(outputBytes))
+				if err != nil {
+					return err
+				}
+				height = baby.expiry
+
+			case bytes.Equal(prefix, kndrPrefix):
+				kid, err := decodeKidOutput(
+					bytes.NewReader(outputBytes),
+				)
+				if err != nil {
+					return err
+				}
+
+				height = kid.absoluteMaturity
+				if kid.BlocksToMaturity() != 0 {
+					height = kid.ConfHeight() +
+						kid.BlocksToMaturity()
+				}
+			}
+
+			if err := chanBucket.Delete(pfxOutputKey); err != nil {
+				return err
+			}
+
+			// Preschool outputs aren't present in the height
+			// index, so there's nothing left to remove.
+			if height == 0 {
+				return nil
+			}
+
+			// The finalized sweep txn of a kindergarten class
+			// spends each of its outputs, so it's erased first,
+			// such that the height bucket can be pruned along with
+			// the output below.
+			if bytes.Equal(prefix, kndrPrefix) {
+				finalTx, err := ns.getFinalizedTxn(tx, height)
+				if err != nil {
+					return err
+				}
+				if finalTx != nil {
+					hghtBucket := ns.getHeightBucket(
+						tx, height,
+					)
+					err := hghtBucket.Delete(
+						finalizedKndrTxnKey,
+					)
+					if err != nil {
+						return err
+					}
+					classHeight = height
+				}
+			}
+
+			return ns.removeOutputFromHeight(
+				tx, height, chanPoint, pfxOutputKey,
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return classHeight, nil
+}
+
 // LastFinalizedHeight returns the last block height for which the nursery
 // store has finalized a kindergarten class.
 func (ns *nurseryStore) LastFinalizedHeight() (uint32, error) {
@@ -1356,8 +1471,9 @@ func (ns *nurseryStore) getLastFinalizedHeight(tx *bolt.Tx) (uint32, error) {
 }
 
 // finalizeKinder records a finalized kindergarten sweep txn to the given height
-// bucket. It also updates the nursery store's last finalized height, so that we
-// do not finalize the same height twice. If the finalized txn is nil, i.e. if
+// bucket. It also raises the nursery store's last finalized height, so that we
+// do not finalize the same height twice. The height is never lowered, as the
+// sweep of a deferred class is finalized after the heights above it. If the finalized txn is nil, i.e. if
 // the height has no kindergarten outputs, the height will be marked as
 // finalized, and we skip the process of writing the txn. When the class is
 // loaded, a nil value will be returned if no txn has been written to a
@@ -1365,24 +1481,29 @@ func (ns *nurseryStore) getLastFinalizedHeight(tx *bolt.Tx) (uint32, error) {
 func (ns *nurseryStore) finalizeKinder(tx *bolt.Tx, height uint32,
 	finalTx *wire.MsgTx) error {
 
-	// TODO(conner) ensure height is greater that current finalized height.
-
-	// 1. Write the last finalized height to the chain bucket.
-
-	// Ensure that the chain bucket for this nursery store exists.
-	chainBucket, err := tx.CreateBucketIfNotExists(ns.pfxChainKey)
+	// 1. Write the last finalized height to the chain bucket, unless a
+	// greater height has already been finalized.
+	lastFinalizedHeight, err := ns.getLastFinalizedHeight(tx)
 	if err != nil {
 		return err
 	}
 
-	// Serialize the provided last-finalized height, and store it in the
-	// top-level chain bucket for this nursery store.
-	var lastHeightBytes [4]byte
-	byteOrder.PutUint32(lastHeightBytes[:], height)
+	if height > lastFinalizedHeight {
+		// Ensure that the chain bucket for this nursery store exists.
+		chainBucket, err := tx.CreateBucketIfNotExists(ns.pfxChainKey)
+		if err != nil {
+			return err
+		}
 
-	err = chainBucket.Put(lastFinalizedHeightKey, lastHeightBytes[:])
-	if err != nil {
-		return err
+		// Serialize the provided last-finalized height, and store it
+		// in the top-level chain bucket for this nursery store.
+		var lastHeightBytes [4]byte
+		byteOrder.PutUint32(lastHeightBytes[:], height)
+
+		err = chainBucket.Put(lastFinalizedHeightKey, lastHeightBytes[:])
+		if err != nil {
+			return err
+		}
 	}
 
 	// 2. Write the finalized txn in the appropriate height bucket.
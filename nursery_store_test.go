@@ -511,6 +511,85 @@ func TestNurseryStoreGraduate(t *testing.T) {
 	assertHeightIsPurged(t, ns, maturityHeight)
 }
 
+// TestNurseryStoreRemoveOutput verifies that the nursery store removes an
+// ungraduated output from both the channel and height indexes, along with the
+// finalized sweep txn of its kindergarten class, which it can't resurrect.
+func TestNurseryStoreRemoveOutput(t *testing.T) {
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channel db: %v", err)
+	}
+	defer cleanUp()
+
+	ns, err := newNurseryStore(&bitcoinTestnetGenesis, cdb)
+	if err != nil {
+		t.Fatalf("unable to open nursery store: %v", err)
+	}
+
+	kid := &kidOutputs[3]
+	baby := &babyOutputs[0]
+	chanPoint := kid.OriginChanPoint()
+	maturityHeight := kid.ConfHeight() + kid.BlocksToMaturity()
+
+	err = ns.Incubate([]kidOutput{*kid}, []babyOutput{*baby})
+	if err != nil {
+		t.Fatalf("unable to incubate outputs: %v", err)
+	}
+	assertNumChanOutputs(t, ns, chanPoint, 2)
+
+	// Removing the crib output should remove it from its expiry height,
+	// without invalidating any kindergarten class.
+	classHeight, err := ns.RemoveOutput(chanPoint, baby.OutPoint())
+	if err != nil {
+		t.Fatalf("unable to remove crib output: %v", err)
+	}
+	if classHeight != 0 {
+		t.Fatalf("expected no class height, got %v", classHeight)
+	}
+	assertCribNotAtExpiryHeight(t, ns, baby)
+	assertNumChanOutputs(t, ns, chanPoint, 1)
+
+	// Move the commitment output to the kindergarten bucket, and finalize
+	// the sweep txn of its class.
+	if err := ns.PreschoolToKinder(kid); err != nil {
+		t.Fatalf("unable to move pscl output to kndr: %v", err)
+	}
+	if err := ns.FinalizeKinder(maturityHeight, timeoutTx); err != nil {
+		t.Fatalf("unable to finalize kndr at height=%d: %v",
+			maturityHeight, err)
+	}
+
+	// Removing the kindergarten output should erase the finalized sweep
+	// txn, and report the height of its class, which is then purged.
+	classHeight, err = ns.RemoveOutput(chanPoint, kid.OutPoint())
+	if err != nil {
+		t.Fatalf("unable to remove kndr output: %v", err)
+	}
+	if classHeight != maturityHeight {
+		t.Fatalf("expected class height %v, got %v", maturityHeight,
+			classHeight)
+	}
+	assertKndrNotAtMaturityHeight(t, ns, kid)
+	assertHeightIsPurged(t, ns, maturityHeight)
+	assertNumChanOutputs(t, ns, chanPoint, 0)
+	assertChannelMaturity(t, ns, chanPoint, true)
+
+	// The removed output shouldn't be resurrected by a late confirmation
+	// of its preschool output, and removing it again is a no-op.
+	if err := ns.PreschoolToKinder(kid); err != nil {
+		t.Fatalf("unable to move pscl output to kndr: %v", err)
+	}
+	assertKndrNotAtMaturityHeight(t, ns, kid)
+
+	classHeight, err = ns.RemoveOutput(chanPoint, kid.OutPoint())
+	if err != nil {
+		t.Fatalf("unable to remove output again: %v", err)
+	}
+	if classHeight != 0 {
+		t.Fatalf("expected no class height, got %v", classHeight)
+	}
+}
+
 // assertNumChanOutputs checks that the channel bucket has the expected number
 // of outputs.
 func assertNumChanOutputs(t *testing.T, ns NurseryStore,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -229,6 +231,13 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ExportRecoveryPack": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -1544,9 +1553,8 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				}
 
 				for _, htlcReport := range nurseryInfo.htlcs {
-					// TODO(conner) set incoming flag
-					// appropriately after handling incoming
-					// incubation
+					// The incoming flag is set below once
+					// we consult the chain arbitrator.
 					htlc := &lnrpc.PendingHTLC{
 						Incoming:       false,
 						Amount:         int64(htlcReport.amount),
@@ -1568,6 +1576,24 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				resp.TotalLimboBalance += int64(nurseryInfo.limboBalance)
 			}
 
+			// The chain arbitrator may also be resolving outputs
+			// that haven't been handed off to the nursery, such as
+			// contested HTLCs or outputs that can be swept
+			// directly.
+			arbReport, err := r.server.chainArb.ResolutionReport(
+				chanPoint,
+			)
+			if err != nil && err != contractcourt.ErrArbitratorNotFound {
+				return nil, fmt.Errorf("unable to obtain "+
+					"resolution report for ChannelPoint(%v): %v",
+					chanPoint, err)
+			}
+			if arbReport != nil {
+				resp.TotalLimboBalance += mergeResolutionReport(
+					forceClose, arbReport, currentHeight,
+				)
+			}
+
 			resp.PendingForceClosingChannels = append(
 				resp.PendingForceClosingChannels,
 				forceClose,
@@ -1611,6 +1637,78 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	return resp, nil
 }
 
+// mergeResolutionReport folds the chain arbitrator's resolution report into
+// the force close details that were populated from the utxo nursery. Outputs
+// already known to the nursery only have their incoming flag updated, while
+// outputs that are solely tracked by the arbitrator are accounted for, and
+// appended if they're still pending. The limbo balance that was added to the
+// force closed channel is returned.
+func mergeResolutionReport(
+	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel,
+	arbReport *contractcourt.ResolutionReport, currentHeight int32) int64 {
+
+	nurseryHtlcs := make(map[string]*lnrpc.PendingHTLC)
+	for _, htlc := range forceClose.PendingHtlcs {
+		nurseryHtlcs[htlc.Outpoint] = htlc
+	}
+
+	// The commitment output is reported by the nursery if it was time
+	// locked, so we'll only account for it if the nursery doesn't know of
+	// this channel.
+	nurseryHasChannel := forceClose.LimboBalance != 0 ||
+		forceClose.RecoveredBalance != 0
+
+	// We'll first determine what to merge from the arbitrator's report,
+	// before modifying the response.
+	var (
+		limboBalance     int64
+		recoveredBalance int64
+		pendingHtlcs     []*lnrpc.PendingHTLC
+	)
+	for _, contract := range arbReport.Contracts {
+		outpoint := contract.Outpoint.String()
+		incoming := contract.Type == contractcourt.ReportOutputIncomingHtlc
+
+		switch {
+		case contract.Type == contractcourt.ReportOutputCommit:
+			if nurseryHasChannel {
+				continue
+			}
+
+		// If the nursery is already incubating this HTLC, then we only
+		// need to note its direction.
+		case nurseryHtlcs[outpoint] != nil:
+			nurseryHtlcs[outpoint].Incoming = incoming
+			continue
+
+		// Only HTLCs that are still awaiting resolution are pending.
+		case contract.LimboBalance != 0:
+			htlc := &lnrpc.PendingHTLC{
+				Incoming:       incoming,
+				Amount:         int64(contract.Amount),
+				Outpoint:       outpoint,
+				MaturityHeight: contract.MaturityHeight,
+				Stage:          contract.Stage,
+			}
+			if htlc.MaturityHeight != 0 {
+				htlc.BlocksTilMaturity =
+					int32(htlc.MaturityHeight) - currentHeight
+			}
+
+			pendingHtlcs = append(pendingHtlcs, htlc)
+		}
+
+		limboBalance += int64(contract.LimboBalance)
+		recoveredBalance += int64(contract.RecoveredBalance)
+	}
+
+	forceClose.PendingHtlcs = append(forceClose.PendingHtlcs, pendingHtlcs...)
+	forceClose.LimboBalance += limboBalance
+	forceClose.RecoveredBalance += recoveredBalance
+
+	return limboBalance
+}
+
 // ClosedChannels returns a list of all the channels have been closed.
 // This does not include channels that are still in the process of closing.
 func (r *rpcServer) ClosedChannels(ctx context.Context,
@@ -1691,6 +1789,106 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
+// ExportRecoveryPack returns an unsigned sweep of every output of a closed
+// channel that the node has yet to sweep, paying to the requested address.
+// This allows a user abandoning a broken node to recover their funds using
+// external tools.
+func (r *rpcServer) ExportRecoveryPack(ctx context.Context,
+	in *lnrpc.RecoveryPackRequest) (*lnrpc.RecoveryPackResponse, error) {
+
+	addr, err := btcutil.DecodeAddress(in.Addr, activeNetParams.Params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode address: %v", err)
+	}
+	sweepScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[exportrecoverypack] addr=%v, sat/kw=%v", in.Addr,
+		int64(feePerKw))
+
+	// The outputs incubated by the nursery come first, as it knows
+	// whether their second-level transactions have confirmed. Outputs
+	// that are also reported by a resolver are then skipped.
+	outputs, err := r.server.utxoNursery.RecoveryOutputs()
+	if err != nil {
+		return nil, err
+	}
+	arbOutputs, err := r.server.chainArb.RecoveryOutputs()
+	if err != nil {
+		return nil, err
+	}
+	outputs = append(outputs, arbOutputs...)
+
+	pack, err := contractcourt.NewRecoveryPack(
+		outputs, sweepScript, feePerKw,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.RecoveryPackResponse{
+		SweepFeePerKw: int64(pack.SweepFeePerKw),
+		Sweeps:        make([]*lnrpc.RecoverySweep, 0, len(pack.Sweeps)),
+	}
+	for _, sweep := range pack.Sweeps {
+		rpcSweep, err := marshallRecoverySweep(sweep)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Sweeps = append(resp.Sweeps, rpcSweep)
+	}
+
+	return resp, nil
+}
+
+// marshallRecoverySweep converts a sweep of a recovery pack into its RPC
+// representation. As in the JSON encoding of the pack, the PSBT is encoded in
+// base64, and all other binary data is hex encoded.
+func marshallRecoverySweep(
+	sweep *contractcourt.RecoverySweep) (*lnrpc.RecoverySweep, error) {
+
+	signDesc := &sweep.SignDesc
+	rpcSweep := &lnrpc.RecoverySweep{
+		Outpoint:      sweep.Outpoint.String(),
+		ChanPoint:     sweep.ChanPoint.String(),
+		WitnessType:   sweep.WitnessType.String(),
+		Value:         signDesc.Output.Value,
+		KeyFamily:     uint32(signDesc.KeyDesc.Family),
+		KeyIndex:      signDesc.KeyDesc.Index,
+		SingleTweak:   hex.EncodeToString(signDesc.SingleTweak),
+		WitnessScript: hex.EncodeToString(signDesc.WitnessScript),
+		CsvDelay:      sweep.CsvDelay,
+		LockTime:      sweep.LockTime,
+		Preimage:      hex.EncodeToString(sweep.Preimage),
+		Psbt:          base64.StdEncoding.EncodeToString(sweep.Psbt),
+		Fee:           int64(sweep.Fee),
+	}
+	if signDesc.KeyDesc.PubKey != nil {
+		rpcSweep.PubKey = hex.EncodeToString(
+			signDesc.KeyDesc.PubKey.SerializeCompressed(),
+		)
+	}
+	if sweep.ParentTx != nil {
+		var b bytes.Buffer
+		if err := sweep.ParentTx.Serialize(&b); err != nil {
+			return nil, err
+		}
+		rpcSweep.ParentTx = hex.EncodeToString(b.Bytes())
+	}
+
+	return rpcSweep, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -0,0 +1,96 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// TestMergeResolutionReport tests that the chain arbitrator's resolution
+// report is merged into the force close details reported by the nursery,
+// without accounting for outputs twice.
+func TestMergeResolutionReport(t *testing.T) {
+	t.Parallel()
+
+	commitOp := wire.OutPoint{Index: 0}
+	nurseryOp := wire.OutPoint{Index: 1}
+	pendingOp := wire.OutPoint{Index: 2}
+	resolvedOp := wire.OutPoint{Index: 3}
+
+	arbReport := &contractcourt.ResolutionReport{
+		Contracts: []*contractcourt.ContractReport{
+			{
+				Outpoint:     commitOp,
+				Type:         contractcourt.ReportOutputCommit,
+				LimboBalance: 1000,
+			},
+			{
+				Outpoint:     nurseryOp,
+				Type:         contractcourt.ReportOutputIncomingHtlc,
+				LimboBalance: 200,
+			},
+			{
+				Outpoint:       pendingOp,
+				Type:           contractcourt.ReportOutputOutgoingHtlc,
+				Amount:         300,
+				MaturityHeight: 110,
+				LimboBalance:   300,
+			},
+			{
+				Outpoint:         resolvedOp,
+				Type:             contractcourt.ReportOutputIncomingHtlc,
+				Amount:           400,
+				RecoveredBalance: 400,
+			},
+		},
+	}
+
+	// If the nursery doesn't know of the channel, the commitment output
+	// is accounted for as well.
+	forceClose := &lnrpc.PendingChannelsResponse_ForceClosedChannel{}
+	limbo := mergeResolutionReport(forceClose, arbReport, 100)
+	if limbo != 1000+200+300 {
+		t.Fatalf("unexpected limbo balance: %v", limbo)
+	}
+	if forceClose.RecoveredBalance != 400 {
+		t.Fatalf("unexpected recovered balance: %v",
+			forceClose.RecoveredBalance)
+	}
+
+	// Otherwise, the nursery's commitment output and HTLCs are kept, and
+	// only the arbitrator's own outputs are added. The resolved HTLC
+	// shouldn't be reported as pending.
+	forceClose = &lnrpc.PendingChannelsResponse_ForceClosedChannel{
+		LimboBalance: 1200,
+		PendingHtlcs: []*lnrpc.PendingHTLC{
+			{Outpoint: nurseryOp.String()},
+		},
+	}
+	limbo = mergeResolutionReport(forceClose, arbReport, 100)
+	if limbo != 300 {
+		t.Fatalf("expected limbo balance of 300, got %v", limbo)
+	}
+	if forceClose.LimboBalance != 1500 {
+		t.Fatalf("expected channel limbo balance of 1500, got %v",
+			forceClose.LimboBalance)
+	}
+	if forceClose.RecoveredBalance != 400 {
+		t.Fatalf("expected recovered balance of 400, got %v",
+			forceClose.RecoveredBalance)
+	}
+	if !forceClose.PendingHtlcs[0].Incoming {
+		t.Fatalf("nursery htlc not marked incoming")
+	}
+	if len(forceClose.PendingHtlcs) != 2 {
+		t.Fatalf("expected 2 pending htlcs, got %v",
+			len(forceClose.PendingHtlcs))
+	}
+	htlc := forceClose.PendingHtlcs[1]
+	if htlc.Outpoint != pendingOp.String() || htlc.BlocksTilMaturity != 10 {
+		t.Fatalf("unexpected pending htlc: %v", htlc)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -152,6 +153,10 @@ type server struct {
 
 	utxoNursery *utxoNursery
 
+	// bestBlockCache serves the tip of the chain to the subsystems that
+	// frequently query it, sparing them a round trip to the backend.
+	bestBlockCache *lnwallet.BestBlockCache
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *htlcswitch.OnionProcessor
@@ -581,14 +586,22 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	s.bestBlockCache = lnwallet.NewBestBlockCache(
+		cc.chainIO, cc.chainNotifier,
+	)
+
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
-		ChainIO:   cc.chainIO,
-		ConfDepth: 1,
-		DB:        chanDB,
-		Estimator: cc.feeEstimator,
+		ChainIO:      s.bestBlockCache,
+		ConfDepth:    1,
+		ConfirmHints: cc.hintCache,
+		DB:           chanDB,
+		Estimator:    cc.feeEstimator,
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
+		SweepFeePreference: sweepFeePreference(),
+		MaxSweepFeeRate:    maxSweepFeeRate(),
+		MaxHtlcSweepFee:    maxHtlcSweepFee(),
 		Notifier:           cc.chainNotifier,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		Signer:             cc.wallet.Cfg.Signer,
@@ -607,11 +620,17 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	// breach events from the ChannelArbitrator to the breachArbiter,
 	contractBreaches := make(chan *ContractBreachEvent, 1)
 
+	nurseryChans, err := parseNurseryChannels(cfg.NurseryChan)
+	if err != nil {
+		return nil, err
+	}
+
 	s.chainArb = contractcourt.NewChainArbitrator(contractcourt.ChainArbitratorConfig{
 		ChainHash: *activeNetParams.GenesisHash,
 		// TODO(roasbeef): properly configure
 		//  * needs to be << or specified final hop time delta
-		BroadcastDelta: defaultBroadcastDelta,
+		BroadcastDelta:         defaultBroadcastDelta,
+		IncomingBroadcastDelta: cfg.IncomingBroadcastDelta,
 		NewSweepAddr: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
@@ -645,11 +664,17 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				chanPoint, commitRes, outRes, inRes,
 			)
 		},
-		PreimageDB:   s.witnessBeacon,
-		Notifier:     cc.chainNotifier,
-		Signer:       cc.wallet.Cfg.Signer,
-		FeeEstimator: cc.feeEstimator,
-		ChainIO:      cc.chainIO,
+		RemoveIncubatingOutput: func(chanPoint, op wire.OutPoint) error {
+			return s.utxoNursery.RemoveOutput(chanPoint, op)
+		},
+		PreimageDB:         s.witnessBeacon,
+		Notifier:           cc.chainNotifier,
+		Signer:             cc.wallet.Cfg.Signer,
+		FeeEstimator:       cc.feeEstimator,
+		SweepFeePreference: sweepFeePreference(),
+		MaxSweepFeeRate:    maxSweepFeeRate(),
+		ChainIO:            s.bestBlockCache,
+		ConfirmHints:       cc.hintCache,
 		MarkLinkInactive: func(chanPoint wire.OutPoint) error {
 			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			s.htlcSwitch.RemoveLink(chanID)
@@ -685,6 +710,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
+		ResolutionStrategy: newResolutionStrategy(),
+		ResolverPolicies:   newResolverPolicies(),
+		SweepViaNursery:    cfg.SweepViaNursery,
+		NurseryChannels:    nurseryChans,
+		ContractResolved:   logContractResolved,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -933,6 +963,9 @@ func (s *server) Start() error {
 	if err := s.cc.chainNotifier.Start(); err != nil {
 		return err
 	}
+	if err := s.bestBlockCache.Start(); err != nil {
+		return err
+	}
 	if err := s.sphinx.Start(); err != nil {
 		return err
 	}
@@ -1018,6 +1051,7 @@ func (s *server) Stop() error {
 
 	// Shutdown the wallet, funding manager, and the rpc server.
 	s.cc.chainNotifier.Stop()
+	s.bestBlockCache.Stop()
 	s.chanRouter.Stop()
 	s.htlcSwitch.Stop()
 	s.sphinx.Stop()
@@ -3124,3 +3158,124 @@ func (s *server) watchChannelStatus() {
 		}
 	}
 }
+
+// newResolutionStrategy returns the strategy the chain arbitrator will use to
+// decide which outputs are worth resolving on-chain, based on the current
+// config.
+func newResolutionStrategy() contractcourt.ResolutionStrategy {
+	if cfg.MinChainHtlc <= 0 {
+		return nil
+	}
+
+	// HTLCs below the configured value won't cause us to go on-chain,
+	// but will still be resolved if the channel is closed on-chain.
+	minHtlc := &contractcourt.MinValueResolutionStrategy{
+		MinValue: btcutil.Amount(cfg.MinChainHtlc),
+		BelowMin: contractcourt.ResolveWaitForRemote,
+	}
+
+	return contractcourt.PerClassResolutionStrategy{
+		contractcourt.ReportOutputIncomingHtlc: minHtlc,
+		contractcourt.ReportOutputOutgoingHtlc: minHtlc,
+	}
+}
+
+// logContractResolved logs the outcome of the resolution of an output of a
+// channel closed on-chain, once it has concluded, such that it can be booked.
+func logContractResolved(c *contractcourt.ResolvedContract) {
+	sweepTxid := "none"
+	if c.SweepTxid != nil {
+		sweepTxid = c.SweepTxid.String()
+	}
+
+	srvrLog.Infof("Resolved %v output %v of ChannelPoint(%v): "+
+		"outcome=%v, amount=%v, claimed=%v, fee=%v, sweep_txid=%v",
+		c.Type, c.Outpoint, c.ChanPoint, c.Outcome, c.Amount,
+		c.ClaimedAmount, c.Fee, sweepTxid)
+}
+
+// newResolverPolicies returns the limits the chain arbitrator will apply when
+// resolving each class of outputs on-chain, based on the current config.
+func newResolverPolicies() map[contractcourt.ReportOutputType]contractcourt.ResolverPolicy {
+	if cfg.MaxHtlcSweepFee <= 0 && cfg.MaxHtlcResolveBlocks == 0 {
+		return nil
+	}
+
+	htlcPolicy := contractcourt.ResolverPolicy{
+		MaxFee:        maxHtlcSweepFee(),
+		MaxWaitBlocks: cfg.MaxHtlcResolveBlocks,
+	}
+
+	return map[contractcourt.ReportOutputType]contractcourt.ResolverPolicy{
+		contractcourt.ReportOutputIncomingHtlc: htlcPolicy,
+		contractcourt.ReportOutputOutgoingHtlc: htlcPolicy,
+	}
+}
+
+// maxHtlcSweepFee returns the largest fee we'll pay to sweep a single HTLC
+// output, as configured, or zero if the fee shouldn't be capped.
+func maxHtlcSweepFee() btcutil.Amount {
+	if cfg.MaxHtlcSweepFee <= 0 {
+		return 0
+	}
+
+	return btcutil.Amount(cfg.MaxHtlcSweepFee)
+}
+
+// sweepFeePreference returns the fee preference outputs are swept with, as
+// configured. An empty preference is returned if none is configured, which
+// selects the default of each sweeping subsystem.
+func sweepFeePreference() lnwallet.FeePreference {
+	feePref := lnwallet.FeePreference{
+		ConfTarget: cfg.SweepConfTarget,
+	}
+	if cfg.SweepFeeRate > 0 {
+		feePref.FeeRate = lnwallet.SatPerKVByte(
+			cfg.SweepFeeRate * 1000,
+		).FeePerKWeight()
+	}
+
+	return feePref
+}
+
+// maxSweepFeeRate returns the highest fee rate outputs are swept with, as
+// configured in sat/vbyte, or zero if the fee rate shouldn't be capped.
+func maxSweepFeeRate() lnwallet.SatPerKWeight {
+	if cfg.MaxSweepFeeRate <= 0 {
+		return 0
+	}
+
+	return lnwallet.SatPerKVByte(cfg.MaxSweepFeeRate * 1000).FeePerKWeight()
+}
+
+// parseNurseryChannels parses the channel points, given as txid:index, of the
+// channels whose outputs should only be swept by the utxo nursery.
+func parseNurseryChannels(chanPoints []string) (map[wire.OutPoint]struct{}, error) {
+	if len(chanPoints) == 0 {
+		return nil, nil
+	}
+
+	nurseryChans := make(map[wire.OutPoint]struct{}, len(chanPoints))
+	for _, chanPoint := range chanPoints {
+		parts := strings.Split(chanPoint, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid channel point %q, "+
+				"expected txid:index", chanPoint)
+		}
+
+		txid, err := chainhash.NewHashFromStr(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel point %q: %v",
+				chanPoint, err)
+		}
+		index, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel point %q: %v",
+				chanPoint, err)
+		}
+
+		nurseryChans[*wire.NewOutPoint(txid, uint32(index))] = struct{}{}
+	}
+
+	return nurseryChans, nil
+}
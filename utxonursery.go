@@ -15,6 +15,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -168,19 +169,36 @@ var (
 	// ErrContractNotFound is returned when the nursery is unable to
 	// retrieve information about a queried contract.
 	ErrContractNotFound = fmt.Errorf("unable to locate contract")
+
+	// defaultNurseryFeePreference is the fee preference used to sweep
+	// matured outputs if none has been configured. The outputs are
+	// already ours alone, so there's no rush to confirm the sweep.
+	defaultNurseryFeePreference = lnwallet.FeePreference{
+		ConfTarget: 6,
+	}
 )
 
 // NurseryConfig abstracts the required subsystems used by the utxo nursery. An
 // instance of NurseryConfig is passed to newUtxoNursery during instantiation.
 type NurseryConfig struct {
 	// ChainIO is used by the utxo nursery to determine the current block
-	// height, which drives the incubation of the nursery's outputs.
+	// height, which drives the incubation of the nursery's outputs. The
+	// height is queried upon startup and each time outputs are incubated,
+	// so this should preferably be a lnwallet.BestBlockCache, which serves
+	// it from memory.
 	ChainIO lnwallet.BlockChainIO
 
 	// ConfDepth is the number of blocks the nursery store waits before
 	// determining outputs in the chain as confirmed.
 	ConfDepth uint32
 
+	// ConfirmHints is the cache of best-known height hints for
+	// transactions watched by the subsystems resolving closed channels.
+	// The nursery commits the hints of preschool outputs, and consults
+	// them upon startup to resume watching for their confirmation, should
+	// the close summary of their channel be unavailable.
+	ConfirmHints chainntnfs.ConfirmHintCache
+
 	// DB provides access to a user's channels, such that they can be marked
 	// fully closed after incubation has concluded.
 	DB *channeldb.DB
@@ -189,6 +207,21 @@ type NurseryConfig struct {
 	// necessary fee relative to the expected size of the sweep transaction.
 	Estimator lnwallet.FeeEstimator
 
+	// SweepFeePreference is the fee preference used when sweeping matured
+	// outputs. If unset, a default confirmation target is used.
+	SweepFeePreference lnwallet.FeePreference
+
+	// MaxSweepFeeRate, if non-zero, is the highest fee rate matured
+	// outputs are swept with, overriding the MaxFeeRate of
+	// SweepFeePreference.
+	MaxSweepFeeRate lnwallet.SatPerKWeight
+
+	// MaxHtlcSweepFee, if non-zero, is the largest portion of a sweep's
+	// fee attributable to the input spending a single HTLC output. Sweeps
+	// that would exceed it are retried each block, as they are for HTLCs
+	// swept by the contract court.
+	MaxHtlcSweepFee btcutil.Amount
+
 	// GenSweepScript generates a P2WKH script belonging to the wallet where
 	// funds can be swept.
 	GenSweepScript func() ([]byte, error)
@@ -228,6 +261,12 @@ type utxoNursery struct {
 	mu         sync.Mutex
 	bestHeight uint32
 
+	// deferredClasses is the set of heights whose kindergarten outputs
+	// have no finalized sweep, as the fee of their sweep exceeded our max
+	// fee, or the sweep was invalidated by the removal of one of them.
+	// The sweep of each is retried as new blocks arrive, until it fits.
+	deferredClasses map[uint32]struct{}
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -236,8 +275,9 @@ type utxoNursery struct {
 // ChainNotifier and LightningWallet instance.
 func newUtxoNursery(cfg *NurseryConfig) *utxoNursery {
 	return &utxoNursery{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:             cfg,
+		deferredClasses: make(map[uint32]struct{}),
+		quit:            make(chan struct{}),
 	}
 }
 
@@ -364,11 +404,19 @@ func (u *utxoNursery) IncubateOutputs(chanPoint wire.OutPoint,
 	// that output to incubate.
 	if commitResolution != nil {
 		hasCommit = true
+
+		// An output without a maturity delay is on the remote party's
+		// commitment, and pays directly to us.
+		witnessType := lnwallet.CommitmentTimeLock
+		if commitResolution.MaturityDelay == 0 {
+			witnessType = lnwallet.CommitmentNoDelay
+		}
+
 		selfOutput := makeKidOutput(
 			&commitResolution.SelfOutPoint,
 			&chanPoint,
 			commitResolution.MaturityDelay,
-			lnwallet.CommitmentTimeLock,
+			witnessType,
 			&commitResolution.SelfOutputSignDesc,
 			0,
 		)
@@ -468,17 +516,49 @@ func (u *utxoNursery) IncubateOutputs(chanPoint wire.OutPoint,
 	// confirmation notification that will transition it to the
 	// kindergarten bucket.
 	if len(kidOutputs) != 0 {
-		for _, kidOutput := range kidOutputs {
-			err := u.registerPreschoolConf(&kidOutput, u.bestHeight)
-			if err != nil {
-				return err
-			}
+		kids := make([]*kidOutput, 0, len(kidOutputs))
+		heightHints := make([]uint32, 0, len(kidOutputs))
+		for i := range kidOutputs {
+			kids = append(kids, &kidOutputs[i])
+			heightHints = append(heightHints, u.bestHeight)
+		}
+
+		err := u.registerPreschoolConfs(kids, heightHints)
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// RemoveOutput stops the incubation of an output of the passed channel, as it
+// has been spent by the remote party, and so can no longer be swept by the
+// nursery. If a sweep txn spending the output along with the rest of its class
+// was already finalized, then the sweep of the remaining outputs is crafted
+// anew as new blocks arrive. It's a no-op if the output isn't being incubated.
+func (u *utxoNursery) RemoveOutput(chanPoint, outpoint wire.OutPoint) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	classHeight, err := u.cfg.Store.RemoveOutput(&chanPoint, &outpoint)
+	if err != nil {
+		return err
+	}
+
+	utxnLog.Infof("Removed output %v of ChannelPoint(%v) from the "+
+		"nursery", outpoint, chanPoint)
+
+	if classHeight != 0 {
+		utxnLog.Infof("Re-sweeping kindergarten at height=%d without "+
+			"output %v", classHeight, outpoint)
+
+		u.deferredClasses[classHeight] = struct{}{}
+	}
+
+	return u.closeAndRemoveIfMature(&chanPoint)
+}
+
 // NurseryReport attempts to return a nursery report stored for the target
 // outpoint. A nursery report details the maturity/sweeping progress for a
 // contract that was previously force closed. If a report entry for the target
@@ -501,23 +581,21 @@ func (u *utxoNursery) NurseryReport(
 		case bytes.HasPrefix(k, cribPrefix):
 			// Cribs outputs are the only kind currently stored as
 			// baby outputs.
-			var baby babyOutput
-			err := baby.Decode(bytes.NewReader(v))
+			baby, err := decodeBabyOutput(bytes.NewReader(v))
 			if err != nil {
 				return err
 			}
 
 			// Each crib output represents a stage one htlc, and
 			// will contribute towards the limbo balance.
-			report.AddLimboStage1TimeoutHtlc(&baby)
+			report.AddLimboStage1TimeoutHtlc(baby)
 
 		case bytes.HasPrefix(k, psclPrefix),
 			bytes.HasPrefix(k, kndrPrefix),
 			bytes.HasPrefix(k, gradPrefix):
 
 			// All others states can be deserialized as kid outputs.
-			var kid kidOutput
-			err := kid.Decode(bytes.NewReader(v))
+			kid, err := decodeKidOutput(bytes.NewReader(v))
 			if err != nil {
 				return err
 			}
@@ -531,14 +609,16 @@ func (u *utxoNursery) NurseryReport(
 				// Preschool outputs are awaiting the
 				// confirmation of the commitment transaction.
 				switch kid.WitnessType() {
-				case lnwallet.CommitmentTimeLock:
-					report.AddLimboCommitment(&kid)
+				case lnwallet.CommitmentTimeLock,
+					lnwallet.CommitmentNoDelay:
+
+					report.AddLimboCommitment(kid)
 
 				// An HTLC output on our commitment transaction
 				// where the second-layer transaction hasn't
 				// yet confirmed.
 				case lnwallet.HtlcAcceptedSuccessSecondLevel:
-					report.AddLimboStage1SuccessHtlc(&kid)
+					report.AddLimboStage1SuccessHtlc(kid)
 				}
 
 			case bytes.HasPrefix(k, kndrPrefix):
@@ -547,11 +627,13 @@ func (u *utxoNursery) NurseryReport(
 				// We can distinguish them via their witness
 				// types.
 				switch kid.WitnessType() {
-				case lnwallet.CommitmentTimeLock:
+				case lnwallet.CommitmentTimeLock,
+					lnwallet.CommitmentNoDelay:
+
 					// The commitment transaction has been
 					// confirmed, and we are waiting the CSV
 					// delay to expire.
-					report.AddLimboCommitment(&kid)
+					report.AddLimboCommitment(kid)
 
 				case lnwallet.HtlcOfferedRemoteTimeout:
 					// This is an HTLC output on the
@@ -559,7 +641,7 @@ func (u *utxoNursery) NurseryReport(
 					// party. The CLTV timelock has
 					// expired, and we only need to sweep
 					// it.
-					report.AddLimboDirectHtlc(&kid)
+					report.AddLimboDirectHtlc(kid)
 
 				case lnwallet.HtlcAcceptedSuccessSecondLevel:
 					fallthrough
@@ -567,7 +649,7 @@ func (u *utxoNursery) NurseryReport(
 					// The htlc timeout or success
 					// transaction has confirmed, and the
 					// CSV delay has begun ticking.
-					report.AddLimboStage2Htlc(&kid)
+					report.AddLimboStage2Htlc(kid)
 				}
 
 			case bytes.HasPrefix(k, gradPrefix):
@@ -576,11 +658,13 @@ func (u *utxoNursery) NurseryReport(
 				// will contribute towards the recovered
 				// balance.
 				switch kid.WitnessType() {
-				case lnwallet.CommitmentTimeLock:
+				case lnwallet.CommitmentTimeLock,
+					lnwallet.CommitmentNoDelay:
+
 					// The commitment output was
 					// successfully swept back into a
 					// regular p2wkh output.
-					report.AddRecoveredCommitment(&kid)
+					report.AddRecoveredCommitment(kid)
 
 				case lnwallet.HtlcAcceptedSuccessSecondLevel:
 					fallthrough
@@ -590,7 +674,7 @@ func (u *utxoNursery) NurseryReport(
 					// This htlc output successfully
 					// resides in a p2wkh output belonging
 					// to the user.
-					report.AddRecoveredHtlc(&kid)
+					report.AddRecoveredHtlc(kid)
 				}
 			}
 
@@ -605,6 +689,60 @@ func (u *utxoNursery) NurseryReport(
 	return report, nil
 }
 
+// RecoveryOutputs returns all outputs the nursery has yet to sweep, such that
+// they can be swept manually using external tools. Outputs of HTLCs on our
+// commitment whose timeout transaction is yet to confirm carry it as their
+// parent transaction.
+func (u *utxoNursery) RecoveryOutputs() ([]*contractcourt.RecoveryOutput,
+	error) {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	chanPoints, err := u.cfg.Store.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []*contractcourt.RecoveryOutput
+	for i := range chanPoints {
+		err := u.cfg.Store.ForChanOutputs(&chanPoints[i], func(k,
+			v []byte) error {
+
+			switch {
+			case bytes.HasPrefix(k, cribPrefix):
+				baby, err := decodeBabyOutput(bytes.NewReader(v))
+				if err != nil {
+					return err
+				}
+
+				output := baby.kidOutput.recoveryOutput()
+				output.ParentTx = baby.timeoutTx
+				outputs = append(outputs, output)
+
+			// Graduated outputs have already been swept, so only
+			// those still incubating are of interest.
+			case bytes.HasPrefix(k, psclPrefix),
+				bytes.HasPrefix(k, kndrPrefix):
+
+				kid, err := decodeKidOutput(bytes.NewReader(v))
+				if err != nil {
+					return err
+				}
+
+				outputs = append(outputs, kid.recoveryOutput())
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputs, nil
+}
+
 // reloadPreschool re-initializes the chain notifier with all of the outputs
 // that had been saved to the "preschool" database bucket prior to shutdown.
 func (u *utxoNursery) reloadPreschool() error {
@@ -613,39 +751,79 @@ func (u *utxoNursery) reloadPreschool() error {
 		return err
 	}
 
-	// For each of the preschool outputs stored in the nursery store, load
-	// its close summary from disk so that we can get an accurate height
-	// hint from which to start our range for spend notifications.
+	// For each of the preschool outputs stored in the nursery store,
+	// determine an accurate height hint from which to start our range for
+	// confirmation notifications.
+	kids := make([]*kidOutput, 0, len(psclOutputs))
+	heightHints := make([]uint32, 0, len(psclOutputs))
 	for i := range psclOutputs {
 		kid := &psclOutputs[i]
-		chanPoint := kid.OriginChanPoint()
-
-		// Load the close summary for this output's channel point.
-		closeSummary, err := u.cfg.DB.FetchClosedChannel(chanPoint)
-		if err == channeldb.ErrClosedChannelNotFound {
-			// This should never happen since the close summary
-			// should only be removed after the channel has been
-			// swept completely.
-			utxnLog.Warnf("Close summary not found for "+
-				"chan_point=%v, can't determine height hint"+
-				"to sweep commit txn", chanPoint)
-			continue
 
-		} else if err != nil {
+		heightHint, ok, err := u.preschoolHeightHint(kid)
+		if err != nil {
 			return err
 		}
+		if !ok {
+			continue
+		}
 
-		// Use the close height from the channel summary as our height
-		// hint to drive our spend notifications, with our confirmation
-		// depth as a buffer for reorgs.
-		heightHint := closeSummary.CloseHeight - u.cfg.ConfDepth
-		err = u.registerPreschoolConf(kid, heightHint)
-		if err != nil {
-			return err
+		kids = append(kids, kid)
+		heightHints = append(heightHints, heightHint)
+	}
+
+	// All outputs are registered as a single batch, as there may be
+	// hundreds of them after a restart.
+	return u.registerPreschoolConfs(kids, heightHints)
+}
+
+// preschoolHeightHint returns the height hint from which to watch for the
+// confirmation of the transaction creating the passed preschool output. The
+// hint is derived from the close height of the output's channel, and
+// committed to the height hint cache, such that the output can still be
+// watched for should the close summary be unavailable upon a later restart.
+// As we don't track reorgs of the transaction, a cached hint never moves us
+// past the close height. The returned boolean is false if neither is known.
+func (u *utxoNursery) preschoolHeightHint(kid *kidOutput) (uint32, bool, error) {
+	txID := kid.OutPoint().Hash
+	cachedHint, err := u.cfg.ConfirmHints.QueryConfirmHint(txID)
+	hintCached := err == nil
+	if err != nil && err != chainntnfs.ErrConfirmHintNotFound {
+		return 0, false, err
+	}
+
+	// Load the close summary for this output's channel point.
+	chanPoint := kid.OriginChanPoint()
+	closeSummary, err := u.cfg.DB.FetchClosedChannel(chanPoint)
+	if err == channeldb.ErrClosedChannelNotFound {
+		if hintCached {
+			return cachedHint, true, nil
 		}
+
+		// This should never happen since the close summary should only
+		// be removed after the channel has been swept completely.
+		utxnLog.Warnf("Close summary not found for chan_point=%v, "+
+			"can't determine height hint to sweep commit txn",
+			chanPoint)
+		return 0, false, nil
+
+	} else if err != nil {
+		return 0, false, err
 	}
 
-	return nil
+	// Use the close height from the channel summary as our height hint to
+	// drive our confirmation notifications, with our confirmation depth as
+	// a buffer for reorgs.
+	heightHint := closeSummary.CloseHeight - u.cfg.ConfDepth
+	if hintCached && cachedHint < heightHint {
+		return cachedHint, true, nil
+	}
+
+	err = u.cfg.ConfirmHints.CommitConfirmHint(heightHint, txID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return heightHint, true, nil
 }
 
 // reloadClasses reinitializes any height-dependent state transitions for which
@@ -732,6 +910,19 @@ func (u *utxoNursery) regraduateClass(classHeight uint32) error {
 		return err
 	}
 
+	// If the class has kindergarten outputs, yet no sweep was finalized
+	// for them, then their sweep was deferred as its fee exceeded our max
+	// fee, or invalidated by the removal of one of them. We'll retry it as
+	// new blocks arrive.
+	if finalTx == nil && len(kgtnOutputs) > 0 {
+		utxnLog.Infof("Deferring sweep of kindergarten at height=%d",
+			classHeight)
+
+		u.mu.Lock()
+		u.deferredClasses[classHeight] = struct{}{}
+		u.mu.Unlock()
+	}
+
 	if finalTx != nil {
 		utxnLog.Infof("Re-registering confirmation for kindergarten "+
 			"sweep transaction at height=%d ", classHeight)
@@ -823,6 +1014,12 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 
 	u.bestHeight = classHeight
 
+	// Before graduating this height, retry the sweeps of any classes we
+	// deferred as their fee exceeded our max fee.
+	if err := u.retryDeferredClasses(classHeight); err != nil {
+		return err
+	}
+
 	// Fetch all information about the crib and kindergarten outputs at
 	// this height. In addition to the outputs, we also retrieve the
 	// finalized kindergarten sweep txn, which will be nil if we have not
@@ -855,7 +1052,21 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 		// are kindergarten outputs or cltv crib outputs to be spent.
 		if len(kgtnOutputs) > 0 {
 			finalTx, err = u.createSweepTx(kgtnOutputs, classHeight)
-			if err != nil {
+			switch {
+			// If the sweep would exceed our max fee, then we'll
+			// defer it, and retry it as new blocks arrive in the
+			// hope that fees will have dropped. The height is still
+			// finalized without a sweep txn, so the crib outputs
+			// at this height can graduate in the meantime.
+			case err == lnwallet.ErrFeeExceedsMax:
+				utxnLog.Infof("Deferring sweep of kindergarten "+
+					"at height=%d until its fee fits "+
+					"within the max fee", classHeight)
+
+				u.deferredClasses[classHeight] = struct{}{}
+				finalTx = nil
+
+			case err != nil:
 				utxnLog.Errorf("Failed to create sweep txn at "+
 					"height=%d", classHeight)
 				return err
@@ -910,6 +1121,67 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	return u.cfg.Store.GraduateHeight(classHeight)
 }
 
+// retryDeferredClasses retries the sweeps of the kindergarten outputs of the
+// classes that were deferred, estimating the fee at the passed height. Any
+// class whose sweep exceeds our max fee remains deferred until the next block.
+//
+// NOTE: This method MUST be called with the nursery's mutex held.
+func (u *utxoNursery) retryDeferredClasses(height uint32) error {
+	for classHeight := range u.deferredClasses {
+		finalTx, kgtnOutputs, _, err := u.cfg.Store.FetchClass(
+			classHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If a sweep has since been finalized for the class, or its
+		// outputs are gone, then there's nothing left to retry.
+		if finalTx != nil || len(kgtnOutputs) == 0 {
+			delete(u.deferredClasses, classHeight)
+			continue
+		}
+
+		finalTx, err = u.createSweepTx(kgtnOutputs, height)
+		switch {
+		case err == lnwallet.ErrFeeExceedsMax:
+			utxnLog.Debugf("Sweep of kindergarten at height=%d "+
+				"exceeds the max fee", classHeight)
+			continue
+
+		case err != nil:
+			utxnLog.Errorf("Failed to create sweep txn for "+
+				"deferred kindergarten at height=%d",
+				classHeight)
+			return err
+		}
+
+		// As with any other class, the sweep txn is persisted before
+		// it's broadcast, such that we never broadcast a different txn
+		// for the same class.
+		err = u.cfg.Store.FinalizeKinder(classHeight, finalTx)
+		if err != nil {
+			utxnLog.Errorf("Failed to finalize deferred "+
+				"kindergarten at height=%d", classHeight)
+			return err
+		}
+		delete(u.deferredClasses, classHeight)
+
+		utxnLog.Infof("Finalized deferred kindergarten at height=%d",
+			classHeight)
+
+		err = u.sweepMatureOutputs(classHeight, finalTx, kgtnOutputs)
+		if err != nil {
+			utxnLog.Errorf("Failed to sweep %d deferred "+
+				"kindergarten outputs at height=%d: %v",
+				len(kgtnOutputs), classHeight, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 // craftSweepTx accepts a list of kindergarten outputs, and baby
 // outputs which don't require a second-layer claim, and signs and generates a
 // signed txn that spends from them. This method also makes an accurate fee
@@ -929,21 +1201,25 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 	// expire.
 	var (
 		csvOutputs     []CsvSpendableOutput
-		cltvOutputs    []SpendableOutput
+		cltvOutputs    []lnwallet.SpendableOutput
 		weightEstimate lnwallet.TxWeightEstimator
+
+		// htlcWitnessSizes holds the witness size of each HTLC
+		// output, whose attributed fee is bound by MaxHtlcSweepFee.
+		htlcWitnessSizes []int
 	)
 
 	// Allocate enough room for both types of kindergarten outputs.
 	csvOutputs = make([]CsvSpendableOutput, 0, len(kgtnOutputs))
-	cltvOutputs = make([]SpendableOutput, 0, len(kgtnOutputs))
+	cltvOutputs = make([]lnwallet.SpendableOutput, 0, len(kgtnOutputs))
 
 	// Our sweep transaction will pay to a single segwit p2wkh address,
 	// ensure it contributes to our weight estimate.
 	weightEstimate.AddP2WKHOutput()
 
 	// For each kindergarten output, use its witness type to determine the
-	// estimate weight of its witness, and add it to the proper set of
-	// spendable outputs.
+	// estimate weight of its witness, and its timelock to add it to the
+	// proper set of spendable outputs.
 	for i := range kgtnOutputs {
 		input := &kgtnOutputs[i]
 
@@ -955,7 +1231,12 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 			weightEstimate.AddWitnessInput(
 				lnwallet.ToLocalTimeoutWitnessSize,
 			)
-			csvOutputs = append(csvOutputs, input)
+
+		// Outputs on the remote party's commitment transaction that
+		// pay directly to us, which can be swept as soon as the
+		// commitment confirms.
+		case lnwallet.CommitmentNoDelay:
+			weightEstimate.AddWitnessInput(lnwallet.P2WKHWitnessSize)
 
 		// Outgoing second layer HTLC's that have confirmed within the
 		// chain, and the output they produced is now mature enough to
@@ -964,7 +1245,10 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 			weightEstimate.AddWitnessInput(
 				lnwallet.ToLocalTimeoutWitnessSize,
 			)
-			csvOutputs = append(csvOutputs, input)
+			htlcWitnessSizes = append(
+				htlcWitnessSizes,
+				lnwallet.ToLocalTimeoutWitnessSize,
+			)
 
 		// Incoming second layer HTLC's that have confirmed within the
 		// chain, and the output they produced is now mature enough to
@@ -973,7 +1257,10 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 			weightEstimate.AddWitnessInput(
 				lnwallet.ToLocalTimeoutWitnessSize,
 			)
-			csvOutputs = append(csvOutputs, input)
+			htlcWitnessSizes = append(
+				htlcWitnessSizes,
+				lnwallet.ToLocalTimeoutWitnessSize,
+			)
 
 		// An HTLC on the commitment transaction of the remote party,
 		// that has had its absolute timelock expire.
@@ -981,7 +1268,10 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 			weightEstimate.AddWitnessInput(
 				lnwallet.AcceptedHtlcTimeoutWitnessSize,
 			)
-			cltvOutputs = append(cltvOutputs, input)
+			htlcWitnessSizes = append(
+				htlcWitnessSizes,
+				lnwallet.AcceptedHtlcTimeoutWitnessSize,
+			)
 
 		default:
 			utxnLog.Warnf("kindergarten output in nursery store "+
@@ -989,22 +1279,35 @@ func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
 				input.WitnessType())
 			continue
 		}
+
+		// Outputs with an absolute timelock are swept as CLTV
+		// inputs, while all others are swept as CSV inputs.
+		if _, ok := input.RequiredLockTime(); ok {
+			cltvOutputs = append(cltvOutputs, input)
+		} else {
+			csvOutputs = append(csvOutputs, input)
+		}
 	}
 
 	utxnLog.Infof("Creating sweep transaction for %v CSV inputs, %v CLTV "+
 		"inputs", len(csvOutputs), len(cltvOutputs))
 
 	txWeight := int64(weightEstimate.Weight())
-	return u.populateSweepTx(txWeight, classHeight, csvOutputs, cltvOutputs)
+	return u.populateSweepTx(
+		txWeight, classHeight, csvOutputs, cltvOutputs,
+		htlcWitnessSizes,
+	)
 }
 
 // populateSweepTx populate the final sweeping transaction with all witnesses
 // in place for all inputs using the provided txn fee. The created transaction
 // has a single output sending all the funds back to the source wallet, after
-// accounting for the fee estimate.
+// accounting for the fee estimate. If the fee attributable to the input
+// spending any HTLC output, given the witness sizes of the HTLC inputs,
+// exceeds MaxHtlcSweepFee, then lnwallet.ErrFeeExceedsMax is returned.
 func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
-	csvInputs []CsvSpendableOutput,
-	cltvInputs []SpendableOutput) (*wire.MsgTx, error) {
+	csvInputs []CsvSpendableOutput, cltvInputs []lnwallet.SpendableOutput,
+	htlcWitnessSizes []int) (*wire.MsgTx, error) {
 
 	// Generate the receiving script to which the funds will be swept.
 	pkScript, err := u.cfg.GenSweepScript()
@@ -1014,19 +1317,53 @@ func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
 
 	// Sum up the total value contained in the inputs.
 	var totalSum btcutil.Amount
+	inputs := make(
+		[]lnwallet.SpendableOutput, 0, len(csvInputs)+len(cltvInputs),
+	)
 	for _, o := range csvInputs {
 		totalSum += o.Amount()
+		inputs = append(inputs, o)
 	}
 	for _, o := range cltvInputs {
 		totalSum += o.Amount()
+		inputs = append(inputs, o)
 	}
 
 	// Using the txn weight estimate, compute the required txn fee.
-	feePerKw, err := u.cfg.Estimator.EstimateFeePerKW(6)
+	feePref := u.cfg.SweepFeePreference
+	if feePref == (lnwallet.FeePreference{}) {
+		feePref = defaultNurseryFeePreference
+	}
+	if u.cfg.MaxSweepFeeRate != 0 {
+		feePref.MaxFeeRate = u.cfg.MaxSweepFeeRate
+	}
+	feePref = feePref.ForOutputs(inputs...)
+	feePerKw, err := lnwallet.DetermineFeePerKw(
+		u.cfg.Estimator, feePref, classHeight,
+	)
 	if err != nil {
 		return nil, err
 	}
 	txFee := feePerKw.FeeForWeight(txWeight)
+	if err := feePref.CheckFee(txFee); err != nil {
+		return nil, err
+	}
+	for _, witnessSize := range htlcWitnessSizes {
+		if u.cfg.MaxHtlcSweepFee == 0 {
+			break
+		}
+
+		inputWeight := lnwallet.InputSize*blockchain.WitnessScaleFactor +
+			witnessSize
+		inputFee := feePerKw.FeeForWeight(int64(inputWeight))
+		if inputFee > u.cfg.MaxHtlcSweepFee {
+			utxnLog.Infof("Sweep of HTLC output at height=%v would "+
+				"pay %v in fees, exceeding max of %v",
+				classHeight, inputFee, u.cfg.MaxHtlcSweepFee)
+
+			return nil, lnwallet.ErrFeeExceedsMax
+		}
+	}
 
 	// Sweep as much possible, after subtracting txn fees.
 	sweepAmt := int64(totalSum - txFee)
@@ -1040,24 +1377,20 @@ func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
 		Value:    sweepAmt,
 	})
 
-	// We'll also ensure that the transaction has the required lock time if
-	// we're sweeping any cltvInputs.
-	if len(cltvInputs) > 0 {
-		sweepTx.LockTime = classHeight
-	}
-
-	// Add all inputs to the sweep transaction. Ensure that for each
-	// csvInput, we set the sequence number properly.
-	for _, input := range csvInputs {
-		sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *input.OutPoint(),
-			Sequence:         input.BlocksToMaturity(),
-		})
-	}
-	for _, input := range cltvInputs {
+	// Add all inputs to the sweep transaction, ensuring that each input
+	// carries the sequence number its relative timelock requires, and that
+	// the transaction's lock time satisfies the absolute timelock of each
+	// cltvInput.
+	for _, input := range inputs {
 		sweepTx.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.RequiredSequence(),
 		})
+
+		lockTime, ok := input.RequiredLockTime()
+		if ok && lockTime > sweepTx.LockTime {
+			sweepTx.LockTime = lockTime
+		}
 	}
 
 	// Before signing the transaction, check to ensure that it meets some
@@ -1074,7 +1407,7 @@ func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
 
 	// With all the inputs in place, use each output's unique witness
 	// function to generate the final witness required for spending.
-	addWitness := func(idx int, tso SpendableOutput) error {
+	addWitness := func(idx int, tso lnwallet.SpendableOutput) error {
 		witness, err := tso.BuildWitness(
 			u.cfg.Signer, sweepTx, hashCache, idx,
 		)
@@ -1089,21 +1422,12 @@ func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
 
 	// Finally we'll attach a valid witness to each csv and cltv input
 	// within the sweeping transaction.
-	for i, input := range csvInputs {
+	for i, input := range inputs {
 		if err := addWitness(i, input); err != nil {
 			return nil, err
 		}
 	}
 
-	// Add offset to relative indexes so cltv witnesses don't overwrite csv
-	// witnesses.
-	offset := len(csvInputs)
-	for i, input := range cltvInputs {
-		if err := addWitness(offset+i, input); err != nil {
-			return nil, err
-		}
-	}
-
 	return sweepTx, nil
 }
 
@@ -1308,38 +1632,53 @@ func (u *utxoNursery) waitForTimeoutConf(baby *babyOutput,
 		"kindergarten", baby.OutPoint())
 }
 
-// registerPreschoolConf is responsible for subscribing to the confirmation of
-// a commitment transaction, or an htlc success transaction for an incoming
-// HTLC on our commitment transaction.. If successful, the provided preschool
-// output will be moved persistently into the kindergarten state within the
-// nursery store.
-func (u *utxoNursery) registerPreschoolConf(kid *kidOutput, heightHint uint32) error {
-	txID := kid.OutPoint().Hash
+// registerPreschoolConfs is responsible for subscribing to the confirmations
+// of commitment transactions, or htlc success transactions for incoming HTLCs
+// on our commitment transaction, starting at the corresponding height hints.
+// The subscriptions are registered with the chain notifier as a single batch.
+// If successful, each of the provided preschool outputs will be moved
+// persistently into the kindergarten state within the nursery store once
+// confirmed.
+func (u *utxoNursery) registerPreschoolConfs(kids []*kidOutput,
+	heightHints []uint32) error {
 
 	// TODO(roasbeef): ensure we don't already have one waiting, need to
 	// de-duplicate
 	//  * need to do above?
 
-	pkScript := kid.signDesc.Output.PkScript
-	confChan, err := u.cfg.Notifier.RegisterConfirmationsNtfn(
-		&txID, pkScript, u.cfg.ConfDepth, heightHint,
+	reqs := make([]chainntnfs.ConfRequest, 0, len(kids))
+	for i, kid := range kids {
+		txID := kid.OutPoint().Hash
+		reqs = append(reqs, chainntnfs.ConfRequest{
+			TxID:       &txID,
+			PkScript:   kid.signDesc.Output.PkScript,
+			NumConfs:   u.cfg.ConfDepth,
+			HeightHint: heightHints[i],
+		})
+	}
+
+	confChans, err := chainntnfs.RegisterConfirmationsBatch(
+		u.cfg.Notifier, reqs,
 	)
 	if err != nil {
 		return err
 	}
 
-	var outputType string
-	if kid.isHtlc {
-		outputType = "HTLC"
-	} else {
-		outputType = "Commitment"
-	}
+	for i, kid := range kids {
+		var outputType string
+		if kid.isHtlc {
+			outputType = "HTLC"
+		} else {
+			outputType = "Commitment"
+		}
 
-	utxnLog.Infof("%v outpoint %v registered for "+
-		"confirmation notification.", outputType, kid.OutPoint())
+		utxnLog.Infof("%v outpoint %v registered for "+
+			"confirmation notification.", outputType,
+			kid.OutPoint())
 
-	u.wg.Add(1)
-	go u.waitForPreschoolConf(kid, confChan)
+		u.wg.Add(1)
+		go u.waitForPreschoolConf(kid, confChans[i])
+	}
 
 	return nil
 }
@@ -1390,6 +1729,14 @@ func (u *utxoNursery) waitForPreschoolConf(kid *kidOutput,
 			outputType, err)
 		return
 	}
+
+	// With the output graduated from preschool, its height hint is no
+	// longer needed.
+	err = u.cfg.ConfirmHints.PurgeConfirmHint(kid.OutPoint().Hash)
+	if err != nil {
+		utxnLog.Errorf("Unable to purge height hint of %v: %v",
+			kid.OutPoint(), err)
+	}
 }
 
 // contractMaturityReport is a report that details the maturity progress of a
@@ -1613,7 +1960,7 @@ func newSweepPkScript(wallet lnwallet.WalletController) ([]byte, error) {
 // CsvSpendableOutput is a SpendableOutput that contains all of the information
 // necessary to construct, sign, and sweep an output locked with a CSV delay.
 type CsvSpendableOutput interface {
-	SpendableOutput
+	lnwallet.SpendableOutput
 
 	// ConfHeight returns the height at which this output was confirmed.
 	// A zero value indicates that the output has not been confirmed.
@@ -1682,6 +2029,14 @@ func makeBabyOutput(chanPoint *wire.OutPoint,
 	}
 }
 
+// OutputType returns the tag identifying baby outputs within the lnwallet
+// output registry.
+//
+// NOTE: Part of the lnwallet.SerializableOutput interface.
+func (bo *babyOutput) OutputType() lnwallet.OutputType {
+	return lnwallet.OutputTypeBaby
+}
+
 // Encode writes the baby output to the given io.Writer.
 func (bo *babyOutput) Encode(w io.Writer) error {
 	var scratch [4]byte
@@ -1786,6 +2141,43 @@ func (k *kidOutput) ConfHeight() uint32 {
 	return k.confHeight
 }
 
+// RequiredSequence returns the relative timelock of the kid output, which is
+// zero for outputs that aren't CSV locked.
+//
+// NOTE: Part of the lnwallet.SpendableOutput interface.
+func (k *kidOutput) RequiredSequence() uint32 {
+	return k.blocksToMaturity
+}
+
+// RequiredLockTime returns the absolute timelock of the kid output, which is
+// only set for outgoing HTLCs on the commitment of the remote party.
+//
+// NOTE: Part of the lnwallet.SpendableOutput interface.
+func (k *kidOutput) RequiredLockTime() (uint32, bool) {
+	return k.absoluteMaturity, k.absoluteMaturity != 0
+}
+
+// recoveryOutput describes the kid output, such that it can be swept manually
+// using external tools.
+func (k *kidOutput) recoveryOutput() *contractcourt.RecoveryOutput {
+	return &contractcourt.RecoveryOutput{
+		Outpoint:    k.outpoint,
+		ChanPoint:   k.originChanPoint,
+		WitnessType: k.witnessType,
+		SignDesc:    k.signDesc,
+		CsvDelay:    k.blocksToMaturity,
+		LockTime:    k.absoluteMaturity,
+	}
+}
+
+// OutputType returns the tag identifying kid outputs within the lnwallet
+// output registry.
+//
+// NOTE: Part of the lnwallet.SerializableOutput interface.
+func (k *kidOutput) OutputType() lnwallet.OutputType {
+	return lnwallet.OutputTypeKid
+}
+
 // Encode converts a KidOutput struct into a form suitable for on-disk database
 // storage. Note that the signDescriptor struct field is included so that the
 // output's witness can be generated by createSweepTx() when the output becomes
@@ -1878,38 +2270,30 @@ func (k *kidOutput) Decode(r io.Reader) error {
 	return lnwallet.ReadSignDescriptor(r, &k.signDesc)
 }
 
-// TODO(bvu): copied from channeldb, remove repetition
+// writeOutpoint writes an outpoint to the passed writer. Outpoints persisted
+// by the nursery and breach arbiter have their txid prefixed by its length,
+// so we'll write that before the shared lnwallet encoding.
 func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
-	// TODO(roasbeef): make all scratch buffers on the stack
-	scratch := make([]byte, 4)
-
-	// TODO(roasbeef): write raw 32 bytes instead of wasting the extra
-	// byte.
-	if err := wire.WriteVarBytes(w, 0, o.Hash[:]); err != nil {
+	err := wire.WriteVarInt(w, 0, uint64(len(o.Hash)))
+	if err != nil {
 		return err
 	}
 
-	byteOrder.PutUint32(scratch, o.Index)
-	_, err := w.Write(scratch)
-	return err
+	return lnwallet.WriteOutPoint(w, o)
 }
 
-// TODO(bvu): copied from channeldb, remove repetition
+// readOutpoint reads an outpoint previously written using writeOutpoint from
+// the passed reader.
 func readOutpoint(r io.Reader, o *wire.OutPoint) error {
-	scratch := make([]byte, 4)
-
-	txid, err := wire.ReadVarBytes(r, 0, 32, "prevout")
+	hashLen, err := wire.ReadVarInt(r, 0)
 	if err != nil {
 		return err
 	}
-	copy(o.Hash[:], txid)
-
-	if _, err := r.Read(scratch); err != nil {
-		return err
+	if hashLen != uint64(len(o.Hash)) {
+		return fmt.Errorf("invalid outpoint txid length: %v", hashLen)
 	}
-	o.Index = byteOrder.Uint32(scratch)
 
-	return nil
+	return lnwallet.ReadOutPoint(r, o)
 }
 
 func writeTxOut(w io.Writer, txo *wire.TxOut) error {
@@ -1948,3 +2332,54 @@ func readTxOut(r io.Reader, txo *wire.TxOut) error {
 // CsvSpendableOutput interface.
 var _ CsvSpendableOutput = (*kidOutput)(nil)
 var _ CsvSpendableOutput = (*babyOutput)(nil)
+
+// Compile-time constraint to ensure kidOutput and babyOutput implement the
+// lnwallet.SerializableOutput interface.
+var _ lnwallet.SerializableOutput = (*kidOutput)(nil)
+var _ lnwallet.SerializableOutput = (*babyOutput)(nil)
+
+func init() {
+	err := lnwallet.RegisterOutputType(
+		lnwallet.OutputTypeKid,
+		func() lnwallet.SerializableOutput {
+			return &kidOutput{}
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = lnwallet.RegisterOutputType(
+		lnwallet.OutputTypeBaby,
+		func() lnwallet.SerializableOutput {
+			return &babyOutput{}
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// decodeKidOutput decodes a kidOutput from r, using the constructor
+// registered for its type within the lnwallet output registry.
+func decodeKidOutput(r io.Reader) (*kidOutput, error) {
+	output, err := lnwallet.DecodeSpendableOutput(lnwallet.OutputTypeKid, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.(*kidOutput), nil
+}
+
+// decodeBabyOutput decodes a babyOutput from r, using the constructor
+// registered for its type within the lnwallet output registry.
+func decodeBabyOutput(r io.Reader) (*babyOutput, error) {
+	output, err := lnwallet.DecodeSpendableOutput(
+		lnwallet.OutputTypeBaby, r,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.(*babyOutput), nil
+}
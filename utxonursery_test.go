@@ -13,6 +13,8 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/heighthint"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -379,3 +381,270 @@ func TestBabyOutputSerialization(t *testing.T) {
 
 	}
 }
+
+// TestNurseryOutputRegistry tests that kid and baby outputs can be encoded
+// and decoded back through the lnwallet output registry.
+func TestNurseryOutputRegistry(t *testing.T) {
+	var outputs []lnwallet.SerializableOutput
+	for i := range kidOutputs {
+		outputs = append(outputs, &kidOutputs[i])
+	}
+	for i := range babyOutputs {
+		outputs = append(outputs, &babyOutputs[i])
+	}
+
+	for i, output := range outputs {
+		var b bytes.Buffer
+		if err := output.Encode(&b); err != nil {
+			t.Fatalf("Encode #%d: unable to encode output: %v",
+				i, err)
+		}
+
+		decoded, err := lnwallet.DecodeSpendableOutput(
+			output.OutputType(), &b,
+		)
+		if err != nil {
+			t.Fatalf("DecodeSpendableOutput #%d: unable to decode "+
+				"output: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(output, decoded) {
+			t.Fatalf("DeepEqual #%d: unexpected output, "+
+				"want %+v, got %+v", i, output, decoded)
+		}
+	}
+}
+
+// TestKidOutputTimelocks tests that kid outputs expose the timelocks that
+// the sweep transaction must satisfy.
+func TestKidOutputTimelocks(t *testing.T) {
+	csvKid := makeKidOutput(
+		&outPoints[1], &outPoints[0], 144, lnwallet.CommitmentTimeLock,
+		&signDescriptors[0], 0,
+	)
+	if csvKid.RequiredSequence() != 144 {
+		t.Fatalf("expected sequence of 144, got %v",
+			csvKid.RequiredSequence())
+	}
+	if _, ok := csvKid.RequiredLockTime(); ok {
+		t.Fatalf("expected csv output to lack absolute timelock")
+	}
+
+	cltvKid := makeKidOutput(
+		&outPoints[2], &outPoints[0], 0,
+		lnwallet.HtlcOfferedRemoteTimeout, &signDescriptors[0], 500,
+	)
+	if cltvKid.RequiredSequence() != 0 {
+		t.Fatalf("expected sequence of 0, got %v",
+			cltvKid.RequiredSequence())
+	}
+	lockTime, ok := cltvKid.RequiredLockTime()
+	if !ok || lockTime != 500 {
+		t.Fatalf("expected absolute timelock of 500, got %v", lockTime)
+	}
+}
+
+// TestPreschoolHeightHint tests that the height hint of a preschool output is
+// retrieved from the height hint cache if the close summary of its channel is
+// unavailable.
+func TestPreschoolHeightHint(t *testing.T) {
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cleanUp()
+
+	hintCache, err := heighthint.New(db)
+	if err != nil {
+		t.Fatalf("unable to create height hint cache: %v", err)
+	}
+
+	nursery := &utxoNursery{
+		cfg: &NurseryConfig{
+			ConfDepth:    1,
+			ConfirmHints: hintCache,
+			DB:           db,
+		},
+	}
+
+	cachedKid := makeKidOutput(
+		&outPoints[1], &outPoints[0], 144, lnwallet.CommitmentTimeLock,
+		&signDescriptors[0], 0,
+	)
+	err = hintCache.CommitConfirmHint(500, cachedKid.OutPoint().Hash)
+	if err != nil {
+		t.Fatalf("unable to commit hint: %v", err)
+	}
+
+	heightHint, ok, err := nursery.preschoolHeightHint(&cachedKid)
+	if err != nil {
+		t.Fatalf("unable to get height hint: %v", err)
+	}
+	if !ok || heightHint != 500 {
+		t.Fatalf("expected cached height hint of 500, got %v (ok=%v)",
+			heightHint, ok)
+	}
+
+	// Without a cached hint, the close summary of the channel is needed,
+	// which is missing.
+	uncachedKid := makeKidOutput(
+		&outPoints[2], &outPoints[0], 144, lnwallet.CommitmentTimeLock,
+		&signDescriptors[0], 0,
+	)
+	_, ok, err = nursery.preschoolHeightHint(&uncachedKid)
+	if err != nil {
+		t.Fatalf("unable to get height hint: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected height hint to be unknown")
+	}
+}
+
+// TestCreateSweepTxMaxHtlcFee tests that the nursery refuses to sweep an HTLC
+// output whose input would pay more than MaxHtlcSweepFee in fees.
+func TestCreateSweepTxMaxHtlcFee(t *testing.T) {
+	nursery := &utxoNursery{
+		cfg: &NurseryConfig{
+			Estimator: &lnwallet.StaticFeeEstimator{FeePerKW: 12500},
+			GenSweepScript: func() ([]byte, error) {
+				return signDescriptors[0].Output.PkScript, nil
+			},
+			MaxHtlcSweepFee: 1000,
+		},
+	}
+
+	htlcKid := makeKidOutput(
+		&outPoints[2], &outPoints[0], 0,
+		lnwallet.HtlcOfferedRemoteTimeout, &signDescriptors[0], 500,
+	)
+	_, err := nursery.createSweepTx([]kidOutput{htlcKid}, 500)
+	if err != lnwallet.ErrFeeExceedsMax {
+		t.Fatalf("expected ErrFeeExceedsMax, got %v", err)
+	}
+}
+
+// nopSigner is a lnwallet.Signer producing dummy signatures, for tests that
+// don't validate the sweeps they craft.
+type nopSigner struct{}
+
+func (s *nopSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	return []byte{0x01}, nil
+}
+
+func (s *nopSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{}, nil
+}
+
+// TestGraduateClassDeferred tests that the sweep of a class whose fee exceeds
+// the max fee is deferred, rather than dropped, and retried on each block
+// until it fits, including after a restart.
+func TestGraduateClassDeferred(t *testing.T) {
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cleanUp()
+
+	store, err := newNurseryStore(&bitcoinTestnetGenesis, db)
+	if err != nil {
+		t.Fatalf("unable to open nursery store: %v", err)
+	}
+
+	estimator := &lnwallet.StaticFeeEstimator{FeePerKW: 12500}
+	var published []*wire.MsgTx
+	newNursery := func() *utxoNursery {
+		return newUtxoNursery(&NurseryConfig{
+			ConfDepth: 1,
+			Estimator: estimator,
+			GenSweepScript: func() ([]byte, error) {
+				return signDescriptors[0].Output.PkScript, nil
+			},
+			SweepFeePreference: lnwallet.FeePreference{
+				ConfTarget: 6,
+				MaxFee:     1000,
+			},
+			Notifier: &mockNotfier{
+				confChannel: make(chan *chainntnfs.TxConfirmation),
+			},
+			PublishTransaction: func(tx *wire.MsgTx) error {
+				published = append(published, tx)
+				return nil
+			},
+			Signer: &nopSigner{},
+			Store:  store,
+		})
+	}
+	nursery := newNursery()
+	defer func() {
+		close(nursery.quit)
+		nursery.wg.Wait()
+	}()
+
+	// Enter an HTLC output maturing at height 500 into kindergarten.
+	htlcKid := makeKidOutput(
+		&outPoints[2], &outPoints[0], 0,
+		lnwallet.HtlcOfferedRemoteTimeout, &signDescriptors[0], 500,
+	)
+	if err := store.Incubate([]kidOutput{htlcKid}, nil); err != nil {
+		t.Fatalf("unable to incubate output: %v", err)
+	}
+	if err := store.PreschoolToKinder(&htlcKid); err != nil {
+		t.Fatalf("unable to move output to kindergarten: %v", err)
+	}
+
+	assertDeferred := func(n *utxoNursery, deferred bool) {
+		t.Helper()
+
+		if _, ok := n.deferredClasses[500]; ok != deferred {
+			t.Fatalf("expected class deferred=%v, got %v",
+				deferred, ok)
+		}
+	}
+
+	// At the current fee rate, the sweep exceeds the max fee, so the class
+	// should be deferred without a sweep being finalized, while the
+	// heights keep graduating.
+	for height := uint32(500); height <= 501; height++ {
+		if err := nursery.graduateClass(height); err != nil {
+			t.Fatalf("unable to graduate class at height=%d: %v",
+				height, err)
+		}
+		assertDeferred(nursery, true)
+		assertFinalizedTxn(t, store, 500, nil)
+		assertLastGraduatedHeight(t, store, height)
+	}
+	if len(published) != 0 {
+		t.Fatalf("expected no sweep, got %v", len(published))
+	}
+
+	// After a restart, the class should be deferred once more.
+	restarted := newNursery()
+	if err := restarted.regraduateClass(500); err != nil {
+		t.Fatalf("unable to regraduate class: %v", err)
+	}
+	assertDeferred(restarted, true)
+
+	// Once the fee rate drops, the sweep of the class should be finalized
+	// and broadcast on the next block, without lowering the last finalized
+	// height.
+	estimator.FeePerKW = 253
+	if err := nursery.graduateClass(502); err != nil {
+		t.Fatalf("unable to graduate class at height=%d: %v", 502, err)
+	}
+	assertDeferred(nursery, false)
+	if len(published) != 1 {
+		t.Fatalf("expected a single sweep, got %v", len(published))
+	}
+	finalTx, _, _, err := store.FetchClass(500)
+	if err != nil {
+		t.Fatalf("unable to fetch class: %v", err)
+	}
+	if finalTx == nil || finalTx.TxHash() != published[0].TxHash() {
+		t.Fatalf("expected broadcast sweep to be finalized")
+	}
+	assertLastFinalizedHeight(t, store, 502)
+}